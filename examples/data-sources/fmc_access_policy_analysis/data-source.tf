@@ -0,0 +1,3 @@
+data "fmc_access_policy_analysis" "example" {
+  access_control_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+}
@@ -0,0 +1,8 @@
+data "fmc_access_rule_hit_counts" "example" {
+  access_control_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  device_id                = "2fe9063e-8bd5-11ef-9475-e4aeac78cf37"
+  items = {
+    "0050568A-7F57-0ed3-0000-004294975576" = {
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "fmc_device_group" "example" {
+  id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+}
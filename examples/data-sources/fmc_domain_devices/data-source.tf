@@ -0,0 +1,6 @@
+data "fmc_domain_devices" "example" {
+  items = {
+    "my_ftd" = {
+    }
+  }
+}
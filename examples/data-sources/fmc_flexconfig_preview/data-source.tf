@@ -0,0 +1,4 @@
+data "fmc_flexconfig_preview" "example" {
+  flexconfig_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  device_id            = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+}
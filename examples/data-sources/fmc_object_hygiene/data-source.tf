@@ -0,0 +1,2 @@
+data "fmc_object_hygiene" "example" {
+}
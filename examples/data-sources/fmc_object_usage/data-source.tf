@@ -0,0 +1,4 @@
+data "fmc_object_usage" "example" {
+  object_id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  object_type = "Host"
+}
@@ -0,0 +1,3 @@
+ephemeral "fmc_access_token" "example" {
+  domain = "Global"
+}
@@ -0,0 +1,11 @@
+variable "new_network" {
+  type    = string
+  default = "10.10.0.0/16"
+}
+
+check "no_overlap" {
+  assert {
+    condition     = !anytrue([for item in fmc_networks.example.items : provider::fmc::cidr_overlaps(var.new_network, item.prefix)])
+    error_message = "Network ${var.new_network} overlaps an existing network."
+  }
+}
@@ -0,0 +1,4 @@
+output "address" {
+  # "2001:db8::1"
+  value = provider::fmc::normalize_ip("2001:DB8:0:0::1")
+}
@@ -0,0 +1,9 @@
+locals {
+  port_specs = ["tcp/443", "udp/1024-65535"]
+}
+
+resource "fmc_ports" "example" {
+  items = {
+    for spec in local.port_specs : replace(spec, "/", "_") => provider::fmc::parse_port_spec(spec)
+  }
+}
@@ -0,0 +1,4 @@
+output "cidrs" {
+  # ["10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/30", "10.0.0.8/29", "10.0.0.16/30", "10.0.0.20/32"]
+  value = provider::fmc::range_to_cidrs("10.0.0.1-10.0.0.20")
+}
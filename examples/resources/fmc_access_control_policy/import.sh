@@ -1,2 +1,2 @@
-# <domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.
+# <domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.
 terraform import fmc_access_control_policy.example "<domain>,<id>"
@@ -10,6 +10,9 @@ resource "fmc_access_control_policy" "example" {
   default_action_syslog_severity      = "DEBUG"
   default_action_snmp_alert_id        = "76d24097-41c4-4558-a4d0-a8c07ac08470"
   prefilter_policy_id                 = "35e197ca-33a8-11ef-b2d1-d98ae17766e7"
+  decryption_policy_id                = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  dns_policy_id                       = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  umbrella_dns_policy_id              = "76d24097-41c4-4558-a4d0-a8c07ac08470"
   manage_categories                   = true
   categories = [
     {
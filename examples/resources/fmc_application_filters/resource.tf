@@ -38,4 +38,5 @@ resource "fmc_application_filters" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -10,4 +10,5 @@ resource "fmc_as_paths" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -13,4 +13,5 @@ resource "fmc_bfd_templates" "example" {
       authentication_key_id              = 1
     }
   }
+  adopt_existing = true
 }
@@ -11,4 +11,5 @@ resource "fmc_certificate_maps" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
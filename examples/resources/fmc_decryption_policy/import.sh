@@ -0,0 +1,2 @@
+# <domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.
+terraform import fmc_decryption_policy.example "<domain>,<id>"
@@ -0,0 +1,15 @@
+resource "fmc_decryption_policy" "example" {
+  name                                          = "my_decryption_policy"
+  description                                   = "My decryption policy"
+  default_action                                = "DO_NOT_DECRYPT"
+  default_action_log_connection_end             = true
+  default_action_send_events_to_fmc             = true
+  default_action_syslog_alert_id                = "35e197ca-33a8-11ef-b2d1-d98ae17766e7"
+  undecryptable_compressed_session_action       = "INHERIT_DEFAULT_ACTION"
+  undecryptable_sslv2_session_action            = "INHERIT_DEFAULT_ACTION"
+  undecryptable_unknown_cipher_suite_action     = "INHERIT_DEFAULT_ACTION"
+  undecryptable_unsupported_cipher_suite_action = "INHERIT_DEFAULT_ACTION"
+  undecryptable_session_not_cached_action       = "INHERIT_DEFAULT_ACTION"
+  undecryptable_handshake_errors_action         = "INHERIT_DEFAULT_ACTION"
+  undecryptable_decryption_errors_action        = "BLOCK"
+}
@@ -0,0 +1,50 @@
+resource "fmc_decryption_rules" "example" {
+  decryption_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  items = [
+    {
+      name                     = "rule_1"
+      action                   = "DECRYPT_RESIGN"
+      certificate_authority_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+      source_zones = [
+        {
+          id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+        }
+      ]
+      destination_zones = [
+        {
+          id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+        }
+      ]
+      source_network_literals = [
+        {
+          value = "10.1.1.0/24"
+        }
+      ]
+      destination_network_literals = [
+        {
+          value = "10.2.2.0/24"
+        }
+      ]
+      source_network_objects = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "Network"
+        }
+      ]
+      destination_network_objects = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "Host"
+        }
+      ]
+      destination_port_objects = [
+        {
+          id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+        }
+      ]
+      log_connection_end = true
+      send_events_to_fmc = true
+      syslog_alert_id    = "35e197ca-33a8-11ef-b2d1-d98ae17766e7"
+    }
+  ]
+}
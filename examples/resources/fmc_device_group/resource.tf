@@ -0,0 +1,3 @@
+resource "fmc_device_group" "example" {
+  name = "my_device_group"
+}
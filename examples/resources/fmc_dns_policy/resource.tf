@@ -0,0 +1,4 @@
+resource "fmc_dns_policy" "example" {
+  name        = "my_dns_policy"
+  description = "My DNS policy"
+}
@@ -0,0 +1,32 @@
+resource "fmc_dns_rules" "example" {
+  dns_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  items = [
+    {
+      name        = "rule_1"
+      action      = "SINKHOLE"
+      sinkhole_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+      dns_lists = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "SIDNSList"
+        }
+      ]
+      source_zones = [
+        {
+          id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+        }
+      ]
+      source_network_literals = [
+        {
+          value = "10.1.1.0/24"
+        }
+      ]
+      source_network_objects = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "Network"
+        }
+      ]
+    }
+  ]
+}
@@ -11,4 +11,5 @@ resource "fmc_dns_server_groups" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
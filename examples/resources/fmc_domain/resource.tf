@@ -0,0 +1,4 @@
+resource "fmc_domain" "example" {
+  name        = "my_domain"
+  description = "My Domain"
+}
@@ -9,4 +9,5 @@ resource "fmc_expanded_community_lists" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -10,4 +10,5 @@ resource "fmc_extended_community_lists" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -0,0 +1,14 @@
+resource "fmc_flexconfig_object" "example" {
+  name            = "my_flexconfig_object"
+  description     = "My FlexConfig object"
+  deployment      = "EVERYTIME"
+  flexconfig_type = "APPEND"
+  body            = "snmp-server location $location"
+  variables = [
+    {
+      name        = "location"
+      object_id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+      object_type = "TextObject"
+    }
+  ]
+}
@@ -0,0 +1,14 @@
+resource "fmc_flexconfig_policy" "example" {
+  name        = "my_flexconfig_policy"
+  description = "My FlexConfig policy"
+  prepend_objects = [
+    {
+      id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+    }
+  ]
+  append_objects = [
+    {
+      id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+    }
+  ]
+}
@@ -0,0 +1,7 @@
+resource "fmc_flexconfig_text_object" "example" {
+  name          = "my_text_object"
+  description   = "My text object"
+  variable_type = "SINGLE"
+  values        = ["Building 1"]
+  overridable   = true
+}
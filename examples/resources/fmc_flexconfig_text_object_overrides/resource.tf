@@ -0,0 +1,12 @@
+resource "fmc_flexconfig_text_object_overrides" "example" {
+  parent_name = "my_text_object"
+  parent_id   = "12345678-90ab-cdef-1234-567890abcdef"
+  overrides = [
+    {
+      target_id   = "12345678-90ab-cdef-1234-567890abcdef"
+      target_type = "Device"
+      description = "My text object"
+      values      = ["Building 2"]
+    }
+  ]
+}
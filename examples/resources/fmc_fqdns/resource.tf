@@ -6,4 +6,5 @@ resource "fmc_fqdns" "example" {
       dns_resolution = "IPV4_AND_IPV6"
     }
   }
+  adopt_existing = true
 }
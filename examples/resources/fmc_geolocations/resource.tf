@@ -13,4 +13,5 @@ resource "fmc_geolocations" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
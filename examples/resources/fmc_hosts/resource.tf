@@ -5,4 +5,5 @@ resource "fmc_hosts" "example" {
       ip          = "10.1.1.1"
     }
   }
+  adopt_existing = true
 }
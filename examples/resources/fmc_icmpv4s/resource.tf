@@ -6,4 +6,5 @@ resource "fmc_icmpv4s" "example" {
       code        = 0
     }
   }
+  adopt_existing = true
 }
@@ -6,4 +6,5 @@ resource "fmc_icmpv6s" "example" {
       code        = 3
     }
   }
+  adopt_existing = true
 }
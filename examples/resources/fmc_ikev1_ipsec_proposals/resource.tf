@@ -6,4 +6,5 @@ resource "fmc_ikev1_ipsec_proposals" "example" {
       esp_hash       = "SHA"
     }
   }
+  adopt_existing = true
 }
@@ -10,4 +10,5 @@ resource "fmc_ikev1_policies" "example" {
       authentication_method = "Preshared Key"
     }
   }
+  adopt_existing = true
 }
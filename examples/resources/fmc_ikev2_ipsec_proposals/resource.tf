@@ -6,4 +6,5 @@ resource "fmc_ikev2_ipsec_proposals" "example" {
       esp_hashes      = ["SHA-256"]
     }
   }
+  adopt_existing = true
 }
@@ -10,4 +10,5 @@ resource "fmc_ikev2_policies" "example" {
       dh_groups             = ["14"]
     }
   }
+  adopt_existing = true
 }
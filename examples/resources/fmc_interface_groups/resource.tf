@@ -9,4 +9,5 @@ resource "fmc_interface_groups" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
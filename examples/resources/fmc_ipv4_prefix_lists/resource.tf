@@ -11,4 +11,5 @@ resource "fmc_ipv4_prefix_lists" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -7,4 +7,5 @@ resource "fmc_ipv6_address_pools" "example" {
       overridable         = true
     }
   }
+  adopt_existing = true
 }
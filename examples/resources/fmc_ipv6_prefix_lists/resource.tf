@@ -11,4 +11,5 @@ resource "fmc_ipv6_prefix_lists" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
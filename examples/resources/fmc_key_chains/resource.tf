@@ -16,4 +16,5 @@ resource "fmc_key_chains" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -0,0 +1,47 @@
+resource "fmc_network_discovery_policy" "example" {
+  rules = [
+    {
+      action = "DISCOVER"
+      network_literals = [
+        {
+          value = "10.0.0.0/8"
+        }
+      ]
+      network_objects = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "Network"
+        }
+      ]
+      zones = [
+        {
+          id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+        }
+      ]
+      source_port_exclusions = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "ProtocolPortObject"
+        }
+      ]
+      destination_port_exclusions = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "ProtocolPortObject"
+        }
+      ]
+      discover_hosts        = true
+      discover_users        = true
+      discover_applications = true
+    }
+  ]
+  capture_banners                         = false
+  update_interval                         = 3600
+  identity_conflict_generate_event        = false
+  identity_conflict_automatic_resolution  = "DISABLED"
+  data_storage_host_limit_action          = "DROP_HOSTS"
+  data_storage_host_timeout               = 10080
+  data_storage_server_timeout             = 10080
+  data_storage_client_application_timeout = 10080
+  logged_events                           = ["NEW_HOST"]
+}
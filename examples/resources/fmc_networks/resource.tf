@@ -5,4 +5,5 @@ resource "fmc_networks" "example" {
       prefix      = "10.1.1.0/24"
     }
   }
+  adopt_existing = true
 }
@@ -38,4 +38,5 @@ resource "fmc_policy_lists" "example" {
       tag                     = 100
     }
   }
+  adopt_existing = true
 }
@@ -10,4 +10,5 @@ resource "fmc_port_groups" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -6,4 +6,5 @@ resource "fmc_ports" "example" {
       description = "Port TCP/443 (HTTPS)"
     }
   }
+  adopt_existing = true
 }
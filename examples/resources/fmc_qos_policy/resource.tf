@@ -0,0 +1,4 @@
+resource "fmc_qos_policy" "example" {
+  name        = "my_qos_policy"
+  description = "My QoS policy"
+}
@@ -0,0 +1,62 @@
+resource "fmc_qos_rules" "example" {
+  qos_policy_id = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+  items = [
+    {
+      name        = "rule_1"
+      description = "Rate-limit guest traffic"
+      source_interfaces = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "SecurityZone"
+        }
+      ]
+      destination_interfaces = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "InterfaceGroup"
+        }
+      ]
+      source_network_literals = [
+        {
+          value = "10.1.1.0/24"
+        }
+      ]
+      destination_network_literals = [
+        {
+          value = "10.2.2.0/24"
+        }
+      ]
+      source_network_objects = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "Network"
+        }
+      ]
+      destination_network_objects = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "Host"
+        }
+      ]
+      source_port_objects = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "ProtocolPortObject"
+        }
+      ]
+      destination_port_objects = [
+        {
+          id   = "76d24097-41c4-4558-a4d0-a8c07ac08470"
+          type = "ProtocolPortObject"
+        }
+      ]
+      applications = [
+        {
+          id = "7967"
+        }
+      ]
+      upload_limit   = 10.0
+      download_limit = 50.0
+    }
+  ]
+}
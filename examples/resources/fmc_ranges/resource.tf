@@ -5,4 +5,5 @@ resource "fmc_ranges" "example" {
       ip_range    = "10.0.0.1-10.0.0.9"
     }
   }
+  adopt_existing = true
 }
@@ -5,4 +5,5 @@ resource "fmc_resource_profiles" "example" {
       number_of_cpus = 10
     }
   }
+  adopt_existing = true
 }
@@ -6,4 +6,5 @@ resource "fmc_security_intelligence_network_feeds" "example" {
       update_frequency = 120
     }
   }
+  adopt_existing = true
 }
@@ -4,4 +4,5 @@ resource "fmc_security_zones" "example" {
       interface_type = "ROUTED"
     }
   }
+  adopt_existing = true
 }
@@ -5,4 +5,5 @@ resource "fmc_sgts" "example" {
       tag         = "11"
     }
   }
+  adopt_existing = true
 }
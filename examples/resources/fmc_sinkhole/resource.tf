@@ -0,0 +1,6 @@
+resource "fmc_sinkhole" "example" {
+  name         = "my_sinkhole"
+  description  = "My Sinkhole object"
+  ipv4_address = "10.10.10.10"
+  ipv6_address = "2001:db8::10"
+}
@@ -17,4 +17,5 @@ resource "fmc_sla_monitors" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -12,4 +12,5 @@ resource "fmc_standard_community_lists" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
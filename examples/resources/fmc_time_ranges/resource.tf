@@ -15,4 +15,5 @@ resource "fmc_time_ranges" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -4,4 +4,5 @@ resource "fmc_tunnel_zones" "example" {
       description = "My Tunnel Zone object"
     }
   }
+  adopt_existing = true
 }
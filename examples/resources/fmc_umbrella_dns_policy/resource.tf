@@ -0,0 +1,8 @@
+resource "fmc_umbrella_dns_policy" "example" {
+  name                       = "my_umbrella_dns_policy"
+  description                = "My Umbrella DNS policy"
+  umbrella_protection_policy = "Default Policy"
+  dnscrypt                   = true
+  idle_timeout               = 20
+  local_domain_bypass        = ["example.com"]
+}
@@ -14,4 +14,5 @@ resource "fmc_url_groups" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
@@ -5,4 +5,5 @@ resource "fmc_urls" "example" {
       url         = "https://www.example.com/app"
     }
   }
+  adopt_existing = true
 }
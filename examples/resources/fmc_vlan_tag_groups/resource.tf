@@ -16,4 +16,5 @@ resource "fmc_vlan_tag_groups" "example" {
       ]
     }
   }
+  adopt_existing = true
 }
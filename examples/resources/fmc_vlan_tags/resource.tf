@@ -7,4 +7,5 @@ resource "fmc_vlan_tags" "example" {
       end_tag     = "15"
     }
   }
+  adopt_existing = true
 }
@@ -1,3 +1,3 @@
-# <domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.
+# <domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.
 terraform import fmc_vpn_s2s_endpoints.example "<domain>,<vpn_s2s_id>,[<item1_name>,<item2_name>,...]"
 
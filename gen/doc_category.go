@@ -41,7 +41,11 @@ type YamlConfig struct {
 
 var docPaths = []string{"./docs/data-sources/", "./docs/resources/"}
 
-var extraDocs = map[string]string{}
+var extraDocPaths = []string{"./docs/data-sources/", "./docs/resources/", "./docs/ephemeral-resources/"}
+
+var extraDocs = map[string]string{
+	"access_token": "System information",
+}
 
 func SnakeCase(s string) string {
 	var g []string
@@ -96,7 +100,7 @@ func main() {
 
 	// Update extra doc categories
 	for doc, cat := range extraDocs {
-		for _, path := range docPaths {
+		for _, path := range extraDocPaths {
 			filename := path + doc + ".md"
 			content, err := os.ReadFile(filename)
 			if err == nil {
@@ -30,6 +30,7 @@ import (
 	"regexp"
 	"strings"
 	"text/template"
+	"time"
 	"unicode"
 
 	"gopkg.in/yaml.v3"
@@ -110,6 +111,7 @@ type YamlConfig struct {
 	MinimumVersionCreate     string                `yaml:"minimum_version_create"`
 	MinimumVersionBulkCreate string                `yaml:"minimum_version_bulk_create"`
 	MinimumVersionBulkDelete string                `yaml:"minimum_version_bulk_delete"`
+	MinimumVersionBulkUpdate string                `yaml:"minimum_version_bulk_update"`
 	DsDescription            string                `yaml:"ds_description"`
 	ResDescription           string                `yaml:"res_description"`
 	DocCategory              string                `yaml:"doc_category"`
@@ -127,6 +129,34 @@ type YamlConfig struct {
 	AdjustBody               bool                  `yaml:"adjust_body"`
 	DeprecationMessage       string                `yaml:"deprecation_message"`
 	NoId                     bool                  `yaml:"no_id"`
+	Timeouts                 bool                  `yaml:"timeouts"`
+	DefaultTimeout           string                `yaml:"default_timeout"`
+	SchemaVersion            int                   `yaml:"schema_version"`
+	StateMigrations          []YamlStateMigration  `yaml:"state_migrations"`
+	MoveStateFrom            []string              `yaml:"move_state_from"`
+	AdoptExisting            bool                  `yaml:"adopt_existing"`
+	StateMovers              []YamlStateMover      `yaml:"-"`
+}
+
+type YamlStateMover struct {
+	SourceName          string
+	SourceSchemaVersion int
+}
+
+type YamlStateMigration struct {
+	Version           int                      `yaml:"version"`
+	Rules             []YamlStateMigrationRule `yaml:"rules"`
+	TestPriorState    string                   `yaml:"test_prior_state"`
+	TestUpgradedState string                   `yaml:"test_upgraded_state"`
+}
+
+type YamlStateMigrationRule struct {
+	RenameAttribute string   `yaml:"rename_attribute"`
+	To              string   `yaml:"to"`
+	ListToSet       string   `yaml:"list_to_set"`
+	SplitAttribute  string   `yaml:"split_attribute"`
+	Separator       string   `yaml:"separator"`
+	Into            []string `yaml:"into"`
 }
 
 type YamlConfigAttribute struct {
@@ -160,6 +190,7 @@ type YamlConfigAttribute struct {
 	StringMaxLength                     int64                 `yaml:"string_max_length"`
 	Computed                            bool                  `yaml:"computed"`
 	ComputedRefreshValue                bool                  `yaml:"computed_refresh_value"`
+	ComputedRefreshOnChange             []string              `yaml:"computed_refresh_on_change"`
 	ComputedBodyParam                   bool                  `yaml:"computed_body_param"`
 	DefaultValue                        string                `yaml:"default_value"`
 	Value                               string                `yaml:"value"`
@@ -173,7 +204,13 @@ type YamlConfigAttribute struct {
 	MissingInResponseIfSetToEmptyString bool                  `yaml:"missing_in_response_if_set_to_empty_string"`
 	Attributes                          []YamlConfigAttribute `yaml:"attributes"`
 	TfOnly                              bool                  `yaml:"tf_only"`
+	WriteOnlyArgument                   bool                  `yaml:"write_only_argument"`
 	GoTypeName                          string
+	TfWriteOnly                         bool   `yaml:"-"`
+	ResourceOnly                        bool   `yaml:"-"`
+	ConflictsWith                       string `yaml:"-"`
+	ExactlyOneOf                        string `yaml:"-"`
+	AlsoRequires                        string `yaml:"-"`
 }
 
 // Templating helper function to convert TF name to GO name
@@ -337,6 +374,85 @@ func HasRequiresReplace(attributes []YamlConfigAttribute) bool {
 	return false
 }
 
+// Templating helper function to return true if any attribute (or sub-attribute) is a Terraform write-only attribute
+func HasWriteOnlyArguments(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.TfWriteOnly {
+			return true
+		}
+		if len(attr.Attributes) > 0 {
+			if HasWriteOnlyArguments(attr.Attributes) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Templating helper function to return true if the resource model has attributes which are not part of the data source schema
+func HasResourceOnlyAttributes(config YamlConfig) bool {
+	return config.Timeouts || hasResourceOnlyAttributes(config.Attributes)
+}
+
+func hasResourceOnlyAttributes(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.ResourceOnly || hasResourceOnlyAttributes(attr.Attributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// Templating helper function to return the reason why the resource has no unit test against the mock FMC,
+// or an empty string if it has one
+func UnitTestSkipReason(config YamlConfig) string {
+	switch {
+	case config.TestPrerequisites != "":
+		return "the test configuration needs prerequisite objects (test_prerequisites)"
+	case HasReference(config.Attributes):
+		return "the test configuration references parent objects, which must exist on FMC"
+	case config.PutCreate:
+		return "the object must already exist on FMC (put_create)"
+	case len(config.TestTags) > 0:
+		return "the test configuration needs environment specific test data (" + strings.Join(config.TestTags, ", ") + ")"
+	case config.RestEndpointVrf != "":
+		return "the object belongs to a VRF of a device"
+	}
+	return ""
+}
+
+// Templating helper function to return attributes which are not read from FMC and therefore cannot be
+// verified after import, e.g. write-only and Terraform-only attributes
+func ImportStateVerifyIgnore(config YamlConfig) []string {
+	var ignore []string
+	if config.Timeouts {
+		ignore = append(ignore, "timeouts")
+	}
+	return append(ignore, importStateVerifyIgnore(config.Attributes, "")...)
+}
+
+func importStateVerifyIgnore(attributes []YamlConfigAttribute, prefix string) []string {
+	var ignore []string
+	for _, attr := range attributes {
+		if attr.Value != "" {
+			continue
+		}
+		if attr.WriteOnly || (attr.TfOnly && !attr.Computed) {
+			ignore = append(ignore, prefix+attr.TfName)
+			continue
+		}
+		if len(attr.Attributes) > 0 {
+			// Test configurations have a single element, keyed by the map key example in case of maps
+			key := "0"
+			if attr.Type == "Map" {
+				key = attr.MapKeyExample
+			}
+			ignore = append(ignore, importStateVerifyIgnore(attr.Attributes, prefix+attr.TfName+"."+key+".")...)
+		}
+	}
+	return ignore
+}
+
 // Templating helper function to return true if type is a list or set without nested elements
 func IsListSet(attribute YamlConfigAttribute) bool {
 	if (attribute.Type == "List" || attribute.Type == "Set") && attribute.ElementType != "" {
@@ -440,6 +556,39 @@ func Subtract(a, b int) int {
 	return a - b
 }
 
+// Templating helper function to return the `type` attribute of items of a bulk resource, or nil if there is none
+func BulkItemType(attributes []YamlConfigAttribute) *YamlConfigAttribute {
+	for _, attr := range attributes {
+		if attr.TfName != "items" {
+			continue
+		}
+		for i := range attr.Attributes {
+			if attr.Attributes[i].ModelName == "type" {
+				return &attr.Attributes[i]
+			}
+		}
+	}
+	return nil
+}
+
+// Templating helper function to return a duration string (e.g. "30m") as Go expression (e.g. "30 * time.Minute")
+func GoDuration(s string) string {
+	d, _ := time.ParseDuration(s)
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	default:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	}
+}
+
+// Templating helper function to add two numbers
+func Add(a, b int) int {
+	return a + b
+}
+
 // Map of templating functions
 var functions = template.FuncMap{
 	"toGoName":                       ToGoName,
@@ -457,8 +606,12 @@ var functions = template.FuncMap{
 	"hasId":                          HasId,
 	"hasComputedRefreshValue":        HasComputedRefreshValue,
 	"hasReference":                   HasReference,
+	"unitTestSkipReason":             UnitTestSkipReason,
+	"importStateVerifyIgnore":        ImportStateVerifyIgnore,
 	"hasResourceId":                  HasResourceId,
 	"hasRequiresReplace":             HasRequiresReplace,
+	"hasWriteOnlyArguments":          HasWriteOnlyArguments,
+	"hasResourceOnlyAttributes":      HasResourceOnlyAttributes,
 	"isListSet":                      IsListSet,
 	"isList":                         IsList,
 	"isSet":                          IsSet,
@@ -472,21 +625,29 @@ var functions = template.FuncMap{
 	"isDomainDependent":              IsDomainDependent,
 	"importParts":                    ImportParts,
 	"subtract":                       Subtract,
+	"add":                            Add,
+	"goDuration":                     GoDuration,
+	"bulkItemType":                   BulkItemType,
+}
+
+// Convert model name (camelCase) to TF name (snake_case)
+func TfNameFromModelName(modelName string) string {
+	var words []string
+	l := 0
+	for s := modelName; s != ""; s = s[l:] {
+		l = strings.IndexFunc(s[1:], unicode.IsUpper) + 1
+		if l <= 0 {
+			l = len(s)
+		}
+		words = append(words, strings.ToLower(s[:l]))
+	}
+	return strings.Join(words, "_")
 }
 
 func (attr *YamlConfigAttribute) init(parentGoTypeName string) error {
 	// Augument
 	if attr.TfName == "" {
-		var words []string
-		l := 0
-		for s := attr.ModelName; s != ""; s = s[l:] {
-			l = strings.IndexFunc(s[1:], unicode.IsUpper) + 1
-			if l <= 0 {
-				l = len(s)
-			}
-			words = append(words, strings.ToLower(s[:l]))
-		}
-		attr.TfName = strings.Join(words, "_")
+		attr.TfName = TfNameFromModelName(attr.ModelName)
 	}
 
 	attr.GoTypeName = parentGoTypeName + ToGoName(attr.TfName)
@@ -525,6 +686,10 @@ func (attr *YamlConfigAttribute) init(parentGoTypeName string) error {
 		return fmt.Errorf("%q: `computed_refresh_value: true` can only be used with `computed: true`", attr.TfName)
 	}
 
+	if len(attr.ComputedRefreshOnChange) > 0 && (!attr.Computed || attr.ComputedRefreshValue || attr.Type != "String") {
+		return fmt.Errorf("%q: `computed_refresh_on_change` can only be used with `computed: true` String attributes without `computed_refresh_value`", attr.TfName)
+	}
+
 	if attr.ComputedBodyParam && !attr.Computed {
 		return fmt.Errorf("%q: `computed_body_param: true` can only be used with `computed: true`", attr.TfName)
 	}
@@ -539,6 +704,153 @@ func (attr *YamlConfigAttribute) init(parentGoTypeName string) error {
 	return nil
 }
 
+// Expand attributes with `write_only_argument: true` into the Terraform write-only
+// `<tf_name>_wo` attribute and its `<tf_name>_wo_version` trigger attribute
+func expandWriteOnlyArguments(attributes []YamlConfigAttribute, nested bool) ([]YamlConfigAttribute, error) {
+	var result []YamlConfigAttribute
+	for _, attr := range attributes {
+		if len(attr.Attributes) > 0 {
+			if nested && HasWriteOnlyArgumentsYaml(attr.Attributes) {
+				return nil, fmt.Errorf("%q: `write_only_argument` is supported only on top level attributes and on attributes of a top level List", attr.TfName)
+			}
+			if attr.Type != "List" && HasWriteOnlyArgumentsYaml(attr.Attributes) {
+				return nil, fmt.Errorf("%q has type %q which cannot contain `write_only_argument` attributes: instead use type List", attr.TfName, attr.Type)
+			}
+			expanded, err := expandWriteOnlyArguments(attr.Attributes, true)
+			if err != nil {
+				return nil, err
+			}
+			attr.Attributes = expanded
+		}
+		if !attr.WriteOnlyArgument {
+			result = append(result, attr)
+			continue
+		}
+		if attr.Type != "String" {
+			return nil, fmt.Errorf("%q: `write_only_argument` is supported only on String attributes", attr.TfName)
+		}
+		if len(attr.EnumValues) > 0 || len(attr.StringPatterns) > 0 || attr.StringMinLength != 0 || attr.StringMaxLength != 0 {
+			return nil, fmt.Errorf("%q: `write_only_argument` cannot be combined with value validation", attr.TfName)
+		}
+		if attr.Computed || attr.DefaultValue != "" || attr.Reference || attr.Id || attr.TfOnly {
+			return nil, fmt.Errorf("%q: `write_only_argument` cannot be used on computed, default, reference, id or tf_only attributes", attr.TfName)
+		}
+		if attr.TfName == "" {
+			attr.TfName = TfNameFromModelName(attr.ModelName)
+		}
+
+		woName := attr.TfName + "_wo"
+		versionName := attr.TfName + "_wo_version"
+
+		wo := attr
+		wo.WriteOnlyArgument = false
+		wo.TfName = woName
+		wo.TfWriteOnly = true
+		wo.ResourceOnly = true
+		wo.WriteOnly = true
+		wo.Sensitive = true
+		wo.Mandatory = false
+		wo.RequiresReplace = false
+		wo.Example = ""
+		wo.TestValue = ""
+		wo.MinimumTestValue = ""
+		wo.ExcludeTest = true
+		wo.ExcludeExample = true
+		wo.DataSourceQuery = false
+		wo.Description = fmt.Sprintf("%s Write-only variant of `%s`, the value is never stored in the state (requires Terraform 1.11 or later). Change `%s` to send a new value to FMC.",
+			attr.Description, attr.TfName, versionName)
+
+		version := YamlConfigAttribute{
+			ModelName:       versionName,
+			TfName:          versionName,
+			Type:            "Int64",
+			TfOnly:          true,
+			RequiresReplace: attr.RequiresReplace,
+			ExcludeTest:     true,
+			ExcludeExample:  true,
+			AlsoRequires:    woName,
+			ResourceOnly:    true,
+			Description:     fmt.Sprintf("Version of `%s`. Any change of this value triggers an update of `%s` on FMC.", woName, woName),
+		}
+
+		attr.WriteOnlyArgument = false
+		if attr.Mandatory {
+			attr.Mandatory = false
+			attr.ExactlyOneOf = woName
+			// Keep the attribute in the minimum test configuration
+			if attr.MinimumTestValue == "" {
+				if attr.TestValue != "" {
+					attr.MinimumTestValue = attr.TestValue
+				} else {
+					attr.MinimumTestValue = fmt.Sprintf("%q", attr.Example)
+				}
+			}
+		} else {
+			wo.ConflictsWith = attr.TfName
+		}
+
+		result = append(result, attr, wo, version)
+	}
+	return result, nil
+}
+
+// Validate state migrations and complete them with empty migrations, so that there is exactly one
+// migration for every prior schema version, ordered by version
+func (config *YamlConfig) initStateMigrations() error {
+	migrations := make([]YamlStateMigration, config.SchemaVersion)
+	for i := range migrations {
+		migrations[i].Version = i
+		migrations[i].TestPriorState = "{}"
+		migrations[i].TestUpgradedState = "{}"
+	}
+	seen := map[int]bool{}
+	for _, m := range config.StateMigrations {
+		if m.Version < 0 || m.Version >= config.SchemaVersion {
+			return fmt.Errorf("state migration from version %d: version must be lower than `schema_version` %d", m.Version, config.SchemaVersion)
+		}
+		if seen[m.Version] {
+			return fmt.Errorf("state migration from version %d: multiple migrations from the same version", m.Version)
+		}
+		seen[m.Version] = true
+		for _, r := range m.Rules {
+			kinds := 0
+			for _, v := range []string{r.RenameAttribute, r.ListToSet, r.SplitAttribute} {
+				if v != "" {
+					kinds++
+				}
+			}
+			if kinds != 1 {
+				return fmt.Errorf("state migration from version %d: each rule needs exactly one of `rename_attribute`, `list_to_set`, `split_attribute`", m.Version)
+			}
+			if r.RenameAttribute != "" && r.To == "" {
+				return fmt.Errorf("state migration from version %d: `rename_attribute` %q needs `to`", m.Version, r.RenameAttribute)
+			}
+			if r.SplitAttribute != "" && (r.Separator == "" || len(r.Into) < 2) {
+				return fmt.Errorf("state migration from version %d: `split_attribute` %q needs `separator` and at least two `into` attributes", m.Version, r.SplitAttribute)
+			}
+		}
+		if m.TestPriorState == "" {
+			m.TestPriorState = "{}"
+		}
+		if m.TestUpgradedState == "" {
+			m.TestUpgradedState = "{}"
+		}
+		migrations[m.Version] = m
+	}
+	config.StateMigrations = migrations
+	return nil
+}
+
+// Returns true if any attribute (or sub-attribute) has `write_only_argument: true`
+func HasWriteOnlyArgumentsYaml(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.WriteOnlyArgument || HasWriteOnlyArgumentsYaml(attr.Attributes) {
+			return true
+		}
+	}
+	return false
+}
+
 func NewYamlConfig(bytes []byte) (YamlConfig, error) {
 	var config YamlConfig
 	var hasPutCreateDataQuery bool = false
@@ -547,6 +859,34 @@ func NewYamlConfig(bytes []byte) (YamlConfig, error) {
 		return config, err
 	}
 
+	if HasWriteOnlyArgumentsYaml(config.Attributes) {
+		if config.IsBulk {
+			return YamlConfig{}, fmt.Errorf("`write_only_argument` is not supported on bulk resources")
+		}
+		attributes, err := expandWriteOnlyArguments(config.Attributes, false)
+		if err != nil {
+			return YamlConfig{}, err
+		}
+		config.Attributes = attributes
+	}
+
+	if config.AdoptExisting {
+		if !config.IsBulk {
+			return YamlConfig{}, fmt.Errorf("`adopt_existing` is only supported on bulk resources")
+		}
+		config.Attributes = append(config.Attributes, YamlConfigAttribute{
+			ModelName:    "adoptExisting",
+			TfName:       "adopt_existing",
+			Type:         "Bool",
+			TfOnly:       true,
+			DefaultValue: "false",
+			Description:  "Adopt objects that already exist on FMC with the same name as an item, instead of creating them. Adopted objects are updated if their configuration differs. Only applies when items are created.",
+			Example:      "true",
+			ExcludeTest:  true,
+			ResourceOnly: true,
+		})
+	}
+
 	for i := range config.Attributes {
 		if err := config.Attributes[i].init(CamelCase(config.Name)); err != nil {
 			return YamlConfig{}, err
@@ -558,6 +898,9 @@ func NewYamlConfig(bytes []byte) (YamlConfig, error) {
 			hasPutCreateDataQuery = true
 		}
 	}
+	if err := config.initStateMigrations(); err != nil {
+		return YamlConfig{}, err
+	}
 	if config.DsDescription == "" {
 		config.DsDescription = fmt.Sprintf("This data source reads the %s.", config.Name)
 	}
@@ -574,6 +917,12 @@ func NewYamlConfig(bytes []byte) (YamlConfig, error) {
 	if config.TfName == "" {
 		config.TfName = strings.ReplaceAll(config.Name, " ", "_")
 	}
+	if config.Timeouts && config.DefaultTimeout == "" {
+		config.DefaultTimeout = "30m"
+	}
+	if d, err := time.ParseDuration(config.DefaultTimeout); config.Timeouts && (err != nil || d < time.Second) {
+		return YamlConfig{}, fmt.Errorf("%s: invalid default_timeout %q", config.Name, config.DefaultTimeout)
+	}
 
 	return config, nil
 }
@@ -669,6 +1018,35 @@ func renderTemplate(templatePath, outputPath string, config any) {
 	f.Write(output.Bytes())
 }
 
+// Set state movers of bulk resources, so that state of single object resources listed in `move_state_from`
+// can be moved to them. By default these are all single object resources using the same REST endpoint.
+func initStateMovers(configs []YamlConfig) {
+	byName := map[string]YamlConfig{}
+	for _, c := range configs {
+		byName[SnakeCase(c.Name)] = c
+	}
+	for i := range configs {
+		if !configs[i].IsBulk || configs[i].NoResource {
+			continue
+		}
+		sources := configs[i].MoveStateFrom
+		if len(sources) == 0 {
+			for _, c := range configs {
+				if c.RestEndpoint == configs[i].RestEndpoint && !c.IsBulk && !c.IsOverride && !c.NoResource {
+					sources = append(sources, SnakeCase(c.Name))
+				}
+			}
+		}
+		for _, name := range sources {
+			source, ok := byName[name]
+			if !ok || source.IsBulk || source.NoResource {
+				log.Fatalf("%s: `move_state_from` %q is not a single object resource", configs[i].Name, name)
+			}
+			configs[i].StateMovers = append(configs[i].StateMovers, YamlStateMover{SourceName: name, SourceSchemaVersion: source.SchemaVersion})
+		}
+	}
+}
+
 func main() {
 	// Load configs
 	var configs []YamlConfig
@@ -688,6 +1066,8 @@ func main() {
 		configs = append(configs, config)
 	}
 
+	initStateMovers(configs)
+
 	for i := range configs {
 		// Iterate over templates and render files
 		for _, t := range templates {
@@ -50,6 +50,9 @@ func New{{camelCase .Name}}DataSource() datasource.DataSource {
 
 type {{camelCase .Name}}DataSource struct {
 	client *fmc.Client
+	{{- if isDomainDependent .}}
+	defaultDomain string
+	{{- end}}
 }
 
 func (d *{{camelCase .Name}}DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -87,8 +90,9 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 			{{- end}}
 			{{- if isDomainDependent .}}
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:			true,
+				Computed:			true,
 			},
 			{{- end}}
 			{{- if .RestEndpointVrf }}
@@ -98,13 +102,13 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 			},
 			{{- end}}
 			{{- range .Attributes}}
-			{{- if not .Value}}
+			{{- if and (not .Value) (not .ResourceOnly)}}
 			"{{.TfName}}": schema.{{if isNestedListMapSet .}}{{.Type}}Nested{{else if isList .}}List{{else if isSet .}}Set{{else if eq .Type "Versions"}}List{{else if eq .Type "Version"}}Int64{{else}}{{.Type}}{{end}}Attribute{
 				MarkdownDescription: "{{.Description}}",
 				{{- if isListSet .}}
 				ElementType:         types.{{.ElementType}}Type,
 				{{- end}}
-				{{- if .Reference}}
+				{{- if or .Reference (and .DataSourceOptionalParameter .Mandatory)}}
 				Required:            true,
 				{{- else}}
 				{{- if or .DataSourceQuery .DataSourceOptionalParameter }}
@@ -123,7 +127,7 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						{{- range .Attributes}}
-						{{- if not .Value}}
+						{{- if and (not .Value) (not .ResourceOnly)}}
 						"{{.TfName}}": schema.{{if isNestedListMapSet .}}{{.Type}}Nested{{else if isList .}}List{{else if isSet .}}Set{{else if eq .Type "Versions"}}List{{else if eq .Type "Version"}}Int64{{else}}{{.Type}}{{end}}Attribute{
 							MarkdownDescription: "{{.Description}}",
 							{{- if isListSet .}}
@@ -209,6 +213,9 @@ func (d *{{camelCase .Name}}DataSource) Configure(_ context.Context, req datasou
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	{{- if isDomainDependent .}}
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+	{{- end}}
 }
 
 // End of section. //template:end model
@@ -227,17 +234,29 @@ func (d *{{camelCase .Name}}DataSource) Read(ctx context.Context, req datasource
 	var config {{camelCase .Name}}
 
 	// Read config
+	{{- if hasResourceOnlyAttributes .}}
+	diags := getDataSourceConfig(ctx, req.Config, New{{camelCase .Name}}Resource(), &config)
+	{{- else}}
 	diags := req.Config.Get(ctx, &config)
+	{{- end}}
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	{{- if isDomainDependent .}}
+
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+	{{- end}}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	{{- if isDomainDependent .}}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 	{{- end}}
 
@@ -323,8 +342,11 @@ func (d *{{camelCase .Name}}DataSource) Read(ctx context.Context, req datasource
 	config.fromBody(ctx, res)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", {{if .NoId}}"{{.Name}}"{{else}}config.Id.ValueString(){{end}}))
-
+	{{if hasResourceOnlyAttributes .}}
+	diags = setDataSourceState(ctx, &resp.State, New{{camelCase .Name}}Resource(), &config)
+	{{- else}}
 	diags = resp.State.Set(ctx, &config)
+	{{- end}}
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -1,4 +1,4 @@
-# <domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.
+# <domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.
 {{if .RestEndpointVrf -}}
 # <vrf_id> is optional.
 terraform import fmc_{{snakeCase .Name}}.example "<domain>,<device_id>,<vrf_id>,<id>"
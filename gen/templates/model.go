@@ -25,6 +25,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
@@ -57,6 +58,9 @@ VrfId                types.String                               `tfsdk:"vrf_id"`
 {{- end}}
 {{- end}}
 {{- end}}
+{{- if .Timeouts}}
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+{{- end}}
 }
 
 {{range .Attributes}}
@@ -147,6 +151,9 @@ var minFMCVersionBulkCreate{{camelCase .Name}} = version.Must(version.NewVersion
 {{- if .MinimumVersionBulkDelete}}
 var minFMCVersionBulkDelete{{camelCase .Name}} = version.Must(version.NewVersion("{{.MinimumVersionBulkDelete}}"))
 {{- end}}
+{{- if .MinimumVersionBulkUpdate}}
+var minFMCVersionBulkUpdate{{camelCase .Name}} = version.Must(version.NewVersion("{{.MinimumVersionBulkUpdate}}"))
+{{- end}}
 {{- if .BulkSizeCreate}}
 const bulkSizeCreate{{camelCase .Name}} int = {{.BulkSizeCreate}}
 {{- end}}
@@ -23,18 +23,25 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/ratelimit"
 	"github.com/netascode/go-fmc"
+	"github.com/hashicorp/go-version"
 )
 
 // FmcProvider defines the provider implementation.
@@ -43,6 +50,9 @@ type FmcProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// transport optionally wraps the HTTP transport of the FMC client, for
+	// example to record or replay REST API interactions in tests.
+	transport func(http.RoundTripper) http.RoundTripper
 }
 
 // FmcProviderModel describes the provider data model.
@@ -54,11 +64,16 @@ type FmcProviderModel struct {
 	Insecure   types.Bool   `tfsdk:"insecure"`
 	ReqTimeout types.String `tfsdk:"req_timeout"`
 	Retries    types.Int64  `tfsdk:"retries"`
+	RequestsPerMinute     types.Int64  `tfsdk:"requests_per_minute"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	Domain                types.String `tfsdk:"domain"`
 }
 
 // FmcProviderData describes the data maintained by the provider.
 type FmcProviderData struct {
 	Client      *fmc.Client
+	// DefaultDomain is the domain used by resources and data sources without a `domain` attribute
+	DefaultDomain string
 }
 
 // Define provider constants
@@ -69,6 +84,15 @@ const (
 	maxPayloadSize int = 2048000
 	// maximum URL Param length. This is a rough estimate and does not account for the entire URL length.
 	maxUrlParamLength int = 7000
+	// default REST API rate limit, slightly below the 120 requests per minute allowed by FMC
+	defaultRequestsPerMinute int = 118
+	// default REST API rate limit for FMC 7.4.1 and later, which allow 300 requests per minute
+	defaultRequestsPerMinuteHigh int = 295
+	// default maximum number of REST API requests in flight
+	defaultMaxConcurrentRequests int = 10
+	// rate of the client rate limiter, which is bypassed in favour of the request scheduler
+	unlimitedRate         float64 = 1e9
+	unlimitedRateCapacity int64   = 1e9
 )
 
 // Metadata returns the provider type name.
@@ -103,7 +127,7 @@ func (p *FmcProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				Optional:            true,
 			},
 			"req_timeout": schema.StringAttribute{
-				MarkdownDescription: "Timeout for a single HTTPS request made to REST API before it is retried. This can also be set as the FMC_REQTIMEOUT environment variable. A string like `\"1s\"` means one second. Defaults to unlimited.",
+				MarkdownDescription: "Timeout for a single HTTPS request made to REST API before it is retried. Time spent waiting for the request scheduler (see `requests_per_minute`) is not counted. This can also be set as the FMC_REQTIMEOUT environment variable. A string like `\"1s\"` means one second. Defaults to unlimited.",
 				Optional:            true,
 			},
 			"retries": schema.Int64Attribute{
@@ -113,6 +137,24 @@ func (p *FmcProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 					int64validator.Between(0, 9),
 				},
 			},
+			"requests_per_minute": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of REST API calls per minute, shared by all resources and data sources. Reads are sent before queued writes. This can also be set as the FMC_REQUESTS_PER_MINUTE environment variable. Defaults to `118`, or `295` for FMC 7.4.1 and later.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of REST API calls in flight at the same time. This can also be set as the FMC_MAX_CONCURRENT_REQUESTS environment variable. Defaults to `10`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the default FMC domain, e.g. `Global/EMEA`, used by all resources and data sources which do not set their own `domain` attribute. This can also be set as the FMC_DOMAIN environment variable. Defaults to the domain of the user.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -289,19 +331,110 @@ func (p *FmcProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		retries = config.Retries.ValueInt64()
 	}
 
+	var requestsPerMinute int64
+	if config.RequestsPerMinute.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as requests_per_minute",
+		)
+		return
+	}
+
+	if config.RequestsPerMinute.IsNull() {
+		requestsPerMinuteStr := os.Getenv("FMC_REQUESTS_PER_MINUTE")
+		if requestsPerMinuteStr != "" {
+			requestsPerMinute, err = strconv.ParseInt(requestsPerMinuteStr, 0, 64)
+			if err != nil || requestsPerMinute < 1 {
+				resp.Diagnostics.AddError(
+					"Unable to create client",
+					fmt.Sprintf("Invalid FMC_REQUESTS_PER_MINUTE value %q, expected a positive integer", requestsPerMinuteStr),
+				)
+				return
+			}
+		}
+	} else {
+		requestsPerMinute = config.RequestsPerMinute.ValueInt64()
+	}
+
+	var maxConcurrentRequests int64
+	if config.MaxConcurrentRequests.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as max_concurrent_requests",
+		)
+		return
+	}
+
+	if config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequestsStr := os.Getenv("FMC_MAX_CONCURRENT_REQUESTS")
+		if maxConcurrentRequestsStr == "" {
+			maxConcurrentRequests = int64(defaultMaxConcurrentRequests)
+		} else {
+			maxConcurrentRequests, err = strconv.ParseInt(maxConcurrentRequestsStr, 0, 64)
+			if err != nil || maxConcurrentRequests < 1 {
+				resp.Diagnostics.AddError(
+					"Unable to create client",
+					fmt.Sprintf("Invalid FMC_MAX_CONCURRENT_REQUESTS value %q, expected a positive integer", maxConcurrentRequestsStr),
+				)
+				return
+			}
+		}
+	} else {
+		maxConcurrentRequests = config.MaxConcurrentRequests.ValueInt64()
+	}
+
+	var domain string
+	if config.Domain.IsUnknown() {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as domain",
+		)
+		return
+	}
+
+	if config.Domain.IsNull() {
+		domain = os.Getenv("FMC_DOMAIN")
+	} else {
+		domain = config.Domain.ValueString()
+	}
+
 	tflog.Debug(ctx, fmt.Sprint("Creating a new FMC client",
 		"  url=", url,
 		"  insecure=", insecure,
 		"  req_timeout=", reqTimeout,
 		"  retries=", retries,
+		"  requests_per_minute=", requestsPerMinute,
+		"  max_concurrent_requests=", maxConcurrentRequests,
+		"  domain=", domain,
 	))
 
+	// All REST API calls go through a single scheduler, which replaces the rate limiter of the client.
+	// Until the FMC version is known, the conservative default rate is used.
+	scheduler := helpers.NewRequestScheduler(ctx, defaultRequestsPerMinute, int(maxConcurrentRequests))
+	if requestsPerMinute > 0 {
+		scheduler.SetRequestsPerMinute(int(requestsPerMinute))
+	}
+
 	// Create a new FMC or cdFMC client and set it to the provider client
 	var c fmc.Client
+	mods := []func(*fmc.Client){fmc.Insecure(insecure), fmc.MaxRetries(int(retries))}
+	// Must be applied after fmc.Insecure, which expects the default transport
+	mods = append(mods, func(c *fmc.Client) {
+		if p.transport != nil {
+			c.HttpClient.Transport = p.transport(c.HttpClient.Transport)
+		}
+		// The request timeout is applied by the scheduler, so that time spent in its queue is not counted
+		c.HttpClient.Transport = scheduler.Transport(c.HttpClient.Transport, reqTimeout)
+		c.HttpClient.Timeout = 0
+		c.RateLimiterBucket = ratelimit.NewBucketWithRate(unlimitedRate, unlimitedRateCapacity)
+	})
 	if password != "" {
-		c, err = fmc.NewClient(url, username, password, fmc.Insecure(insecure), fmc.MaxRetries(int(retries)), fmc.RequestTimeout(reqTimeout))
+		c, err = fmc.NewClient(url, username, password, mods...)
 	} else if token != "" {
-		c, err = fmc.NewClientCDFMC(url, token, fmc.Insecure(insecure), fmc.MaxRetries(int(retries)), fmc.RequestTimeout(reqTimeout))
+		c, err = fmc.NewClientCDFMC(url, token, mods...)
 	} else {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -317,9 +450,30 @@ func (p *FmcProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	data := FmcProviderData{Client: &c}
+	// The client resets its rate limiter once the FMC version is known
+	c.RateLimiterBucket = ratelimit.NewBucketWithRate(unlimitedRate, unlimitedRateCapacity)
+	if requestsPerMinute == 0 && c.FMCVersionParsed != nil && c.FMCVersionParsed.GreaterThanOrEqual(version.Must(version.NewVersion("7.4.1"))) {
+		scheduler.SetRequestsPerMinute(defaultRequestsPerMinuteHigh)
+	}
+
+	if _, ok := c.Domains[domain]; domain != "" && !ok {
+		availableDomains := make([]string, 0, len(c.Domains))
+		for k := range c.Domains {
+			availableDomains = append(availableDomains, k)
+		}
+		slices.Sort(availableDomains)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domain"),
+			"Invalid domain",
+			fmt.Sprintf("Domain %q not found, available domains: %s", domain, strings.Join(availableDomains, ", ")),
+		)
+		return
+	}
+
+	data := FmcProviderData{Client: &c, DefaultDomain: domain}
 	resp.DataSourceData = &data
 	resp.ResourceData = &data
+	resp.EphemeralResourceData = &data
 }
 
 func (p *FmcProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -342,6 +496,21 @@ func (p *FmcProvider) DataSources(ctx context.Context) []func() datasource.DataS
 	}
 }
 
+func (p *FmcProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAccessTokenEphemeralResource,
+	}
+}
+
+func (p *FmcProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCidrOverlapsFunction,
+		NewNormalizeIpFunction,
+		NewParsePortSpecFunction,
+		NewRangeToCidrsFunction,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &FmcProvider{
@@ -25,6 +25,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -34,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
@@ -54,6 +56,15 @@ var (
 	{{- if not .NoImport}}
 	_ resource.ResourceWithImportState = &{{camelCase .Name}}Resource{}
 	{{- end}}
+	{{- if isDomainDependent .}}
+	_ resource.ResourceWithModifyPlan  = &{{camelCase .Name}}Resource{}
+	{{- end}}
+	{{- if .SchemaVersion}}
+	_ resource.ResourceWithUpgradeState = &{{camelCase .Name}}Resource{}
+	{{- end}}
+	{{- if .StateMovers}}
+	_ resource.ResourceWithMoveState = &{{camelCase .Name}}Resource{}
+	{{- end}}
 )
 
 func New{{camelCase .Name}}Resource() resource.Resource {
@@ -62,6 +73,9 @@ func New{{camelCase .Name}}Resource() resource.Resource {
 
 type {{camelCase .Name}}Resource struct {
 	client *fmc.Client
+	{{- if isDomainDependent .}}
+	defaultDomain string
+	{{- end}}
 }
 
 func (r *{{camelCase .Name}}Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -93,16 +107,19 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 		{{- if .MinimumVersionBulkDelete -}}
 		.AddMinimumVersionBulkDeleteDescription("{{.MinimumVersionBulkDelete}}")
 		{{- end -}}
-		{{- if and (or .MinimumVersionBulkCreate .MinimumVersionBulkDelete) (ne .MinimumVersionBulkCreate "999") (ne .MinimumVersionBulkDelete "999")  -}}
+		{{- if and (or .MinimumVersionBulkCreate .MinimumVersionBulkDelete .MinimumVersionBulkUpdate) (ne .MinimumVersionBulkCreate "999") (ne .MinimumVersionBulkDelete "999")  -}}
 		.AddMinimumVersionBulkDisclaimerDescription()
 		{{- end -}}
 		{{- if .IsBulk -}}
-		.AddMinimumVersionBulkUpdateDescription()
+		.AddMinimumVersionBulkUpdateDescription("{{.MinimumVersionBulkUpdate}}")
 		{{- end -}}
 		.String,
 		{{- if .DeprecationMessage }}
 		DeprecationMessage:  helpers.NewAttributeDescription("{{.DeprecationMessage}}").String,
 		{{- end}}
+		{{- if .SchemaVersion}}
+		Version:             {{.SchemaVersion}},
+		{{- end}}
 
 		Attributes: map[string]schema.Attribute{
 			{{- if not .NoId}}
@@ -116,11 +133,9 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 			{{- end}}
 			{{- if isDomainDependent .}}
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:			true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:			true,
 			},
 			{{- end}}
 			{{- if .RestEndpointVrf }}
@@ -163,7 +178,22 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 				{{- if .Sensitive}}
 				Sensitive:           true,
 				{{- end}}
-				{{- if len .EnumValues}}
+				{{- if .TfWriteOnly}}
+				WriteOnly:           true,
+				{{- end}}
+				{{- if or .ConflictsWith .ExactlyOneOf .AlsoRequires}}
+				Validators: []validator.{{.Type}}{
+					{{- if .ConflictsWith}}
+					{{snakeCase .Type}}validator.ConflictsWith(path.MatchRelative().AtParent().AtName("{{.ConflictsWith}}")),
+					{{- end}}
+					{{- if .ExactlyOneOf}}
+					{{snakeCase .Type}}validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("{{.ExactlyOneOf}}")),
+					{{- end}}
+					{{- if .AlsoRequires}}
+					{{snakeCase .Type}}validator.AlsoRequires(path.MatchRelative().AtParent().AtName("{{.AlsoRequires}}")),
+					{{- end}}
+				},
+				{{- else if len .EnumValues}}
 				{{- if isSet .}}
 				Validators: []validator.Set{
 					{{- if eq .ElementType "String"}}
@@ -207,7 +237,9 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 					{{- if or .Id .Reference (and .RequiresReplace (not $.IsBulk))}}
 					{{snakeCase .Type}}planmodifier.RequiresReplace(),
 					{{end}}
-					{{- if and .Computed (not .ComputedRefreshValue)}}
+					{{- if .ComputedRefreshOnChange}}
+					planmodifiers.ConditionalUseStateForUnknownString({{range $i, $a := .ComputedRefreshOnChange}}{{if $i}}, {{end}}"{{$a}}"{{end}}),
+					{{- else if and .Computed (not .ComputedRefreshValue)}}
 					{{snakeCase .Type}}planmodifier.UseStateForUnknown(),
 					{{end}}
 				},
@@ -248,7 +280,22 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 							{{- if .Sensitive}}
 							Sensitive:           true,
 							{{- end}}
-							{{- if len .EnumValues}}
+							{{- if .TfWriteOnly}}
+							WriteOnly:           true,
+							{{- end}}
+							{{- if or .ConflictsWith .ExactlyOneOf .AlsoRequires}}
+							Validators: []validator.{{.Type}}{
+								{{- if .ConflictsWith}}
+								{{snakeCase .Type}}validator.ConflictsWith(path.MatchRelative().AtParent().AtName("{{.ConflictsWith}}")),
+								{{- end}}
+								{{- if .ExactlyOneOf}}
+								{{snakeCase .Type}}validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("{{.ExactlyOneOf}}")),
+								{{- end}}
+								{{- if .AlsoRequires}}
+								{{snakeCase .Type}}validator.AlsoRequires(path.MatchRelative().AtParent().AtName("{{.AlsoRequires}}")),
+								{{- end}}
+							},
+							{{- else if len .EnumValues}}
 							{{- if isSet .}}
 							Validators: []validator.Set{
 								{{- if eq .ElementType "String"}}
@@ -337,7 +384,19 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 										{{- if or (len .DefaultValue) .ResourceId .Computed}}
 										Computed:            true,
 										{{- end}}
-										{{- if len .EnumValues}}
+										{{- if or .ConflictsWith .ExactlyOneOf .AlsoRequires}}
+										Validators: []validator.{{.Type}}{
+											{{- if .ConflictsWith}}
+											{{snakeCase .Type}}validator.ConflictsWith(path.MatchRelative().AtParent().AtName("{{.ConflictsWith}}")),
+											{{- end}}
+											{{- if .ExactlyOneOf}}
+											{{snakeCase .Type}}validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("{{.ExactlyOneOf}}")),
+											{{- end}}
+											{{- if .AlsoRequires}}
+											{{snakeCase .Type}}validator.AlsoRequires(path.MatchRelative().AtParent().AtName("{{.AlsoRequires}}")),
+											{{- end}}
+										},
+										{{- else if len .EnumValues}}
 										{{- if isSet .}}
 										Validators: []validator.Set{
 											{{- if eq .ElementType "String"}}
@@ -389,6 +448,9 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 										{{- if .Sensitive}}
 										Sensitive:           true,
 										{{- end}}
+										{{- if .TfWriteOnly}}
+										WriteOnly:           true,
+										{{- end}}
 										{{- if isNestedListMapSet .}}
 										NestedObject: schema.NestedAttributeObject{
 											Attributes: map[string]schema.Attribute{
@@ -423,7 +485,22 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 													{{- if .Sensitive}}
 													Sensitive:           true,
 													{{- end}}
-													{{- if len .EnumValues}}
+													{{- if .TfWriteOnly}}
+													WriteOnly:           true,
+													{{- end}}
+													{{- if or .ConflictsWith .ExactlyOneOf .AlsoRequires}}
+													Validators: []validator.{{.Type}}{
+														{{- if .ConflictsWith}}
+														{{snakeCase .Type}}validator.ConflictsWith(path.MatchRelative().AtParent().AtName("{{.ConflictsWith}}")),
+														{{- end}}
+														{{- if .ExactlyOneOf}}
+														{{snakeCase .Type}}validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("{{.ExactlyOneOf}}")),
+														{{- end}}
+														{{- if .AlsoRequires}}
+														{{snakeCase .Type}}validator.AlsoRequires(path.MatchRelative().AtParent().AtName("{{.AlsoRequires}}")),
+														{{- end}}
+													},
+													{{- else if len .EnumValues}}
 													{{- if isSet .}}
 													Validators: []validator.Set{
 														{{- if eq .ElementType "String"}}
@@ -524,6 +601,11 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 			{{- end}}
 			{{- end}}
 		},
+		{{- if .Timeouts}}
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true{{if not .NoDelete}}, Delete: true{{end}}}),
+		},
+		{{- end}}
 	}
 }
 
@@ -533,7 +615,51 @@ func (r *{{camelCase .Name}}Resource) Configure(_ context.Context, req resource.
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	{{- if isDomainDependent .}}
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+	{{- end}}
+}
+{{- if isDomainDependent .}}
+
+func (r *{{camelCase .Name}}Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
+}
+{{- end}}
+{{- if .SchemaVersion}}
+
+func (r *{{camelCase .Name}}Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return helpers.StateUpgraders(schemaResp.Schema, [][]helpers.StateMigrationRule{
+		{{- range .StateMigrations}}
+		// Version {{.Version}} to {{add .Version 1}}
+		{
+			{{- range .Rules}}
+			{{- if .RenameAttribute}}
+			helpers.RenameAttribute("{{.RenameAttribute}}", "{{.To}}"),
+			{{- else if .ListToSet}}
+			helpers.ListToSet("{{.ListToSet}}"),
+			{{- else if .SplitAttribute}}
+			helpers.SplitAttribute("{{.SplitAttribute}}", "{{.Separator}}"{{range .Into}}, "{{.}}"{{end}}),
+			{{- end}}
+			{{- end}}
+		},
+		{{- end}}
+	})
+}
+{{- end}}
+{{- if .StateMovers}}
+
+// MoveState adopts objects managed by single object resources, see `moved` block
+func (r *{{camelCase .Name}}Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{{- range .StateMovers}}
+		helpers.BulkStateMover("fmc_{{.SourceName}}", {{.SourceSchemaVersion}}),
+		{{- end}}
+	}
 }
+{{- end}}
 
 // End of section. //template:end model
 
@@ -555,12 +681,45 @@ func (r *{{camelCase .Name}}Resource) Create(ctx context.Context, req resource.C
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
+	{{- if hasWriteOnlyArguments .Attributes}}
+
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config {{camelCase .Name}}
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	{{- range .Attributes}}
+	{{- if .TfWriteOnly}}
+	plan.{{toGoName .TfName}} = config.{{toGoName .TfName}}
+	{{- else if and (isNestedList .) (hasWriteOnlyArguments .Attributes)}}
+	{{- $list := toGoName .TfName}}
+	for i := range min(len(plan.{{$list}}), len(config.{{$list}})) {
+		{{- range .Attributes}}
+		{{- if .TfWriteOnly}}
+		plan.{{$list}}[i].{{toGoName .TfName}} = config.{{$list}}[i].{{toGoName .TfName}}
+		{{- end}}
+		{{- end}}
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	{{- if .Timeouts}}
+
+	// Apply create timeout
+	createTimeout, diags := plan.Timeouts.Create(ctx, {{goDuration .DefaultTimeout}})
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	{{- end}}
 
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	{{- if isDomainDependent .}}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 	{{- end}}
 
@@ -727,7 +886,7 @@ func (r *{{camelCase .Name}}Resource) Read(ctx context.Context, req resource.Rea
 	reqMods := [](func(*fmc.Req)){}
 	{{- if isDomainDependent .}}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 	{{- end}}
 
@@ -787,19 +946,52 @@ func (r *{{camelCase .Name}}Resource) Update(ctx context.Context, req resource.U
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
+	{{- if hasWriteOnlyArguments .Attributes}}
+
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config {{camelCase .Name}}
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	{{- range .Attributes}}
+	{{- if .TfWriteOnly}}
+	plan.{{toGoName .TfName}} = config.{{toGoName .TfName}}
+	{{- else if and (isNestedList .) (hasWriteOnlyArguments .Attributes)}}
+	{{- $list := toGoName .TfName}}
+	for i := range min(len(plan.{{$list}}), len(config.{{$list}})) {
+		{{- range .Attributes}}
+		{{- if .TfWriteOnly}}
+		plan.{{$list}}[i].{{toGoName .TfName}} = config.{{$list}}[i].{{toGoName .TfName}}
+		{{- end}}
+		{{- end}}
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
 
 	// Read state
 	diags = req.State.Get(ctx, &state)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
+	{{- if .Timeouts}}
+
+	// Apply update timeout
+	updateTimeout, diags := plan.Timeouts.Update(ctx, {{goDuration .DefaultTimeout}})
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+	{{- end}}
 
 	// Set request domain if provided
 	{{- if not .NoUpdate}}
 	reqMods := [](func(*fmc.Req)){}
 	{{- if isDomainDependent .}}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 	{{- end}}
 	{{- end}}
@@ -859,6 +1051,9 @@ func (r *{{camelCase .Name}}Resource) Update(ctx context.Context, req resource.U
 	var toCreate {{camelCase .Name}}
 	toCreate.Items = make(map[string]{{camelCase .Name}}Items, len(plan.Items))
 	{{- end}}
+	{{- if .AdoptExisting}}
+	toCreate.AdoptExisting = plan.AdoptExisting
+	{{- end}}
 	// Scan plan for items with no ID
 	for k, v := range plan.Items {
 		if v.Id.IsUnknown() || v.Id.IsNull() {
@@ -1110,12 +1305,22 @@ func (r *{{camelCase .Name}}Resource) Delete(ctx context.Context, req resource.D
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
+	{{- if and .Timeouts (not .NoDelete)}}
+
+	// Apply delete timeout
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, {{goDuration .DefaultTimeout}})
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	{{- end}}
 
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	{{- if isDomainDependent .}}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 	{{- end}}
 
@@ -1189,8 +1394,14 @@ func (r *{{camelCase .Name}}Resource) Delete(ctx context.Context, req resource.D
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 {{- if not .NoImport}}
 func (r *{{camelCase .Name}}Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	{{- if isDomainDependent .}}
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
+	{{- end}}
 	{{- if .RestEndpointVrf}}
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<device_id>,<vrf_id>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n<vrf_id> is optional.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<device_id>,<vrf_id>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n<vrf_id> is optional.\n" + fmt.Sprintf("Got: %q", req.ID)
 		parts := strings.Split(req.ID, ",")
 		if len(parts) < 2 || len(parts) > 4 {
 			resp.Diagnostics.AddError("Import error", errMsg)
@@ -1234,7 +1445,7 @@ func (r *{{camelCase .Name}}Resource) ImportState(ctx context.Context, req resou
 		match := inputPattern.FindStringSubmatch(req.ID)
 		if match == nil {
 			errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>{{range $index, $attr := .Attributes}}{{if $attr.Reference}},<{{$attr.TfName}}>{{end}}{{end}},
-				{{- if .IsBulk -}}[<item1_name>,<item2_name>,...]{{- else -}}<id>{{- end -}}\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+				{{- if .IsBulk -}}[<item1_name>,<item2_name>,...]{{- else -}}<id>{{- end -}}\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 			resp.Diagnostics.AddError("Import error", errMsg)
 			return
 		}
@@ -1289,6 +1500,61 @@ func (r *{{camelCase .Name}}Resource) ImportState(ctx context.Context, req resou
 // createSubresources takes list of objects, splits them into bulks and creates them
 // We want to save the state after each create event, to be able track already created resources
 func (r *{{camelCase .Name}}Resource) createSubresources(ctx context.Context, state, plan {{camelCase .Name}}, reqMods ...func(*fmc.Req)) ({{camelCase .Name}}, diag.Diagnostics) {	
+	{{- if .AdoptExisting}}
+	// Adopt objects that already exist on FMC with the same name, instead of creating them
+	if plan.AdoptExisting.ValueBool() {
+		res, err := r.client.Get(state.getPath()+"?expanded=true", reqMods...)
+		if err != nil {
+			return state, diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to retrieve existing objects (GET), got error: %s, %s", state.Id.ValueString(), err, res.String())),
+			}
+		}
+		existing := make(map[string]gjson.Result)
+		res.Get("items").ForEach(func(_, v gjson.Result) bool {
+			if name := v.Get("name").String(); name != "" {
+				existing[name] = v
+			}
+			return true
+		})
+
+		toCreate := plan.Clone()
+		var tmpObject {{camelCase .Name}}
+		tmpObject.Items = make(map[string]{{camelCase .Name}}Items, 1)
+		for k, v := range plan.Items {
+			item, found := existing[k]
+			if !found {
+				continue
+			}
+			delete(toCreate.Items, k)
+			v.Id = types.StringValue(item.Get("id").String())
+			tmpObject.Items[k] = v
+
+			// Update adopted object only if its configuration differs
+			body := tmpObject.toBodyNonBulk(ctx, state)
+			{{- if .AdjustBody}}
+			body = tmpObject.adjustBody(ctx, body)
+			{{- end}}
+			if !helpers.IsJSONSubset(body, item.Raw) {
+				tflog.Debug(ctx, fmt.Sprintf("%s: Updating adopted object %s", state.Id.ValueString(), k))
+				res, err := r.client.Put(state.getPath()+"/"+url.QueryEscape(v.Id.ValueString()), body, reqMods...)
+				if err != nil {
+					return state, diag.Diagnostics{
+						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to update adopted object (PUT) id %s, got error: %s, %s", state.Id.ValueString(), v.Id.ValueString(), err, res.String())),
+					}
+				}
+			}
+
+			// fromBodyUnknowns expect result to be listed under "items" key
+			body, _ = sjson.SetRaw("{}", "items.-1", item.Raw)
+			tmpObject.fromBodyUnknowns(ctx, gjson.Parse(body))
+
+			// Save adopted object to state
+			state.Items[k] = tmpObject.Items[k]
+			delete(tmpObject.Items, k)
+		}
+		plan = toCreate
+	}
+	{{end}}
 	{{- if .MinimumVersionBulkCreate}}
 	// Check if FMC version supports bulk creates
 	if r.client.FMCVersionParsed.LessThan(minFMCVersionBulkCreate{{camelCase .Name}}) {
@@ -1380,6 +1646,7 @@ func (r *{{camelCase .Name}}Resource) createSubresources(ctx context.Context, st
 {{- if .IsBulk}}
 // deleteSubresources takes list of objects and deletes them either in bulk, or one-by-one, depending on FMC version
 func (r *{{camelCase .Name}}Resource) deleteSubresources(ctx context.Context, state, plan {{camelCase .Name}}, reqMods ...func(*fmc.Req)) ({{camelCase .Name}}, diag.Diagnostics) {
+	{{- $itemType := bulkItemType .Attributes}}
 	objectsToRemove := plan.Clone()
 	
 	{{- if .MinimumVersionBulkDelete}}
@@ -1397,9 +1664,14 @@ func (r *{{camelCase .Name}}Resource) deleteSubresources(ctx context.Context, st
 			urlPath := state.getPath() + "/" + url.QueryEscape(v.Id.ValueString())
 			res, err := r.client.Delete(urlPath, reqMods...)
 			if err != nil && !strings.Contains(err.Error(), "StatusCode 404") {
-				return state, diag.Diagnostics{
+				diags := diag.Diagnostics{
 					diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete object (DELETE) id %s, got error: %s, %s", state.Id.ValueString(), v.Id.ValueString(), err, res.String())),
 				}
+				{{- if $itemType}}
+				// Explain which objects reference the object
+				diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, []fmcObjectUsageQuery{ {Name: k, Id: v.Id.ValueString(), Type: {{if $itemType.Value}}"{{$itemType.Value}}"{{else}}v.{{toGoName $itemType.TfName}}.ValueString(){{end}}} }, reqMods)...)
+				{{- end}}
+				return state, diags
 			}
 
 			// Remove deleted item from state
@@ -1414,6 +1686,9 @@ func (r *{{camelCase .Name}}Resource) deleteSubresources(ctx context.Context, st
 		estimatedCapacity := min(len(objectsToRemove.Items)*estimatedIDLength, maxUrlParamLength)
 		var idsToRemove strings.Builder
 		idsToRemove.Grow(estimatedCapacity)
+		{{- if $itemType}}
+		var objectsInBulk []fmcObjectUsageQuery
+		{{- end}}
 
 		for k, v := range objectsToRemove.Items {
 			// Counter
@@ -1428,15 +1703,32 @@ func (r *{{camelCase .Name}}Resource) deleteSubresources(ctx context.Context, st
 			// Create list of IDs of items to delete
 			idsToRemove.WriteString(v.Id.ValueString())
 			idsToRemove.WriteString(",")
+			{{- if $itemType}}
+			objectsInBulk = append(objectsInBulk, fmcObjectUsageQuery{Name: k, Id: v.Id.ValueString(), Type: {{if $itemType.Value}}"{{$itemType.Value}}"{{else}}v.{{toGoName $itemType.TfName}}.ValueString(){{end}}})
+			{{- end}}
 
 			// If bulk size was reached or all entries have been processed
 			if idsToRemove.Len() >= maxUrlParamLength || idx == len(objectsToRemove.Items) {
 				urlPath := state.getPath() + "?bulk=true&filter=ids:" + url.QueryEscape(idsToRemove.String())
 				res, err := r.client.Delete(urlPath, reqMods...)
 				if err != nil {
-					return state, diag.Diagnostics{
+					diags := diag.Diagnostics{
 						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete subobject(s) (DELETE), got error: %s, %s", state.Id.ValueString(), err, res.String())),
 					}
+					{{- if $itemType}}
+					// Explain which objects reference the objects of this bulk, that failed to be deleted
+					failedObjects := fmcFailedObjects(res, objectsInBulk)
+					if len(failedObjects) == 0 {
+						// The error does not tell which objects failed, so delete the objects of this bulk one by one
+						var deletedItems []string
+						deletedItems, failedObjects = fmcDeleteObjectsOneByOne(ctx, r.client, state.getPath(), objectsInBulk, reqMods)
+						for _, name := range deletedItems {
+							delete(state.Items, name)
+						}
+					}
+					diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, failedObjects, reqMods)...)
+					{{- end}}
+					return state, diags
 				}
 
 				// Read result and remove deleted items from state
@@ -1447,6 +1739,9 @@ func (r *{{camelCase .Name}}Resource) deleteSubresources(ctx context.Context, st
 
 				// Reset ID string
 				idsToRemove.Reset()
+				{{- if $itemType}}
+				objectsInBulk = nil
+				{{- end}}
 			}
 		}
 
@@ -1464,13 +1759,55 @@ func (r *{{camelCase .Name}}Resource) deleteSubresources(ctx context.Context, st
 
 {{- if .IsBulk}}
 
-// updateSubresources take elements one-by-one and updates them, as bulks are not supported
+// updateSubresources updates objects either in bulk, or one-by-one, depending on FMC version
+// Objects updated successfully are saved to the state, even if updates of other objects fail
 func (r *{{camelCase .Name}}Resource) updateSubresources(ctx context.Context, state, plan {{camelCase .Name}}, reqMods ...func(*fmc.Req)) ({{camelCase .Name}}, diag.Diagnostics) {
-	var tmpObject {{camelCase .Name}}
-	tmpObject.Items = make(map[string]{{camelCase .Name}}Items, 1)
+	{{- if .MinimumVersionBulkUpdate}}
+	// Check if FMC version supports bulk updates
+	if !r.client.FMCVersionParsed.LessThan(minFMCVersionBulkUpdate{{camelCase .Name}}) {
+		tflog.Debug(ctx, fmt.Sprintf("%s: Bulk update mode ({{.Name}})", state.Id.ValueString()))
+
+		var idx = 0
+		var bulk {{camelCase .Name}}
+		bulk.Items = make(map[string]{{camelCase .Name}}Items, bulkSizeCreate)
+
+		for k, v := range plan.Items {
+			// count loops
+			idx++
+
+			// add object to current bulk
+			bulk.Items[k] = v
+
+			// If bulk size was reached or all entries have been processed
+			if idx%bulkSizeCreate == 0 || idx == len(plan.Items) {
+				body := bulk.toBody(ctx, state)
+				{{- if .AdjustBody}}
+				body = bulk.adjustBody(ctx, body)
+				{{- end}}
+				urlPath := state.getPath() + "?bulk=true"
+				res, err := r.client.Put(urlPath, body, reqMods...)
+				if err != nil {
+					return state, diag.Diagnostics{
+						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to update a bulk (PUT) id: %s, got error: %s, %s", state.Id.ValueString(), err, res.String())),
+					}
+				}
+
+				// Update state
+				maps.Copy(state.Items, bulk.Items)
+
+				// Clear bulk item for next run
+				bulk.Items = make(map[string]{{camelCase .Name}}Items, bulkSizeCreate)
+			}
+		}
+
+		return state, nil
+	}
+	{{- end}}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: One-by-one update mode ({{.Name}})", state.Id.ValueString()))
 
+	var tmpObject {{camelCase .Name}}
+	tmpObject.Items = make(map[string]{{camelCase .Name}}Items, 1)
 	for k, v := range plan.Items {
 		tmpObject.Items[k] = v
 
@@ -40,6 +40,36 @@ func TestAccFmc{{camelCase .Name}}(t *testing.T) {
         t.Skip("skipping test, set environment variable {{range $i, $e := .TestTags}}{{if $i}} and {{end}}{{$e}}{{end}}")
 	}
 	{{- end}}
+	{{- $name := .Name }}
+	var steps []resource.TestStep
+	{{- if not .SkipMinimumTest}}
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: {{if .TestPrerequisites}}testAccFmc{{camelCase .Name}}PrerequisitesConfig+{{end}}testAccFmc{{camelCase .Name}}Config_minimum(),
+		})
+	}
+	{{- end}}
+	steps = append(steps, resource.TestStep{
+		Config: {{if .TestPrerequisites}}testAccFmc{{camelCase .Name}}PrerequisitesConfig+{{end}}testAccFmc{{camelCase .Name}}Config_all(),
+		Check: resource.ComposeTestCheckFunc(testAccFmc{{camelCase .Name}}Checks()...),
+	})
+	{{- if and (not (or .NoImport (hasReference .Attributes))) (not .IsBulk)}}
+	steps = append(steps, resource.TestStep{
+		ResourceName:  "fmc_{{snakeCase $name}}.test",
+		ImportState:   true,
+	})
+	{{- end}}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps: steps,
+	})
+}
+
+// testAccFmc{{camelCase .Name}}Checks returns the checks of the configuration with all attributes
+func testAccFmc{{camelCase .Name}}Checks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	{{- $name := .Name }}
 	{{- range  .Attributes}}
@@ -139,34 +169,64 @@ func TestAccFmc{{camelCase .Name}}(t *testing.T) {
 	{{- end}}
 	{{- end}}
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+{{- $unitTestSkipReason := unitTestSkipReason .}}
+{{- if $unitTestSkipReason}}
+
+func TestUnitFmc{{camelCase .Name}}(t *testing.T) {
+	t.Skip("skipping unit test, {{$unitTestSkipReason}}")
+}
+{{- else}}
+
+func TestUnitFmc{{camelCase .Name}}(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
 	{{- if not .SkipMinimumTest}}
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: {{if .TestPrerequisites}}testAccFmc{{camelCase .Name}}PrerequisitesConfig+{{end}}testAccFmc{{camelCase .Name}}Config_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmc{{camelCase .Name}}Config_minimum(),
+	})
 	{{- end}}
 	steps = append(steps, resource.TestStep{
-		Config: {{if .TestPrerequisites}}testAccFmc{{camelCase .Name}}PrerequisitesConfig+{{end}}testAccFmc{{camelCase .Name}}Config_all(),
-		Check: resource.ComposeTestCheckFunc(checks...),
+		Config: testAccFmc{{camelCase .Name}}Config_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmc{{camelCase .Name}}Checks()...),
 	})
-	{{- if and (not (or .NoImport (hasReference .Attributes))) (not .IsBulk)}}
+	{{- if and (not .NoImport) (not .IsBulk)}}
 	steps = append(steps, resource.TestStep{
-		ResourceName:  "fmc_{{snakeCase $name}}.test",
-		ImportState:   true,
+		ResourceName:      "fmc_{{snakeCase .Name}}.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+		{{- $ignore := importStateVerifyIgnore .}}
+		{{- if $ignore}}
+		ImportStateVerifyIgnore: []string{ {{- range $i, $e := $ignore}}{{if $i}}, {{end}}"{{$e}}"{{end}} },
+		{{- end}}
 	})
 	{{- end}}
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps: steps,
+		Steps:                    steps,
 	})
 }
+{{- end}}
+{{- if .SchemaVersion}}
 
-// End of section. //template:end testAcc
+func TestUnitFmc{{camelCase .Name}}UpgradeState(t *testing.T) {
+	// Prior state and expected upgraded state (subset) of every prior schema version
+	testUnitUpgradeState(t, New{{camelCase .Name}}Resource(), [][2]string{
+		{{- range .StateMigrations}}
+		{`{{.TestPriorState}}`, `{{.TestUpgradedState}}`},
+		{{- end}}
+	})
+}
+{{- end}}
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 {{- if .TestPrerequisites}}
@@ -0,0 +1,463 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/netascode/go-fmc"
+)
+
+// Cassette modes, selected with the FMC_CASSETTE environment variable.
+const (
+	cassetteModeRecord = "record"
+	cassetteModeReplay = "replay"
+)
+
+const (
+	cassetteDir       = "testdata/cassettes"
+	cassetteReplayURL = "https://fmc.cassette.invalid"
+	cassetteRedacted  = "REDACTED"
+)
+
+// cassetteSensitiveKeyRegex matches JSON keys whose string values are scrubbed before a cassette is written.
+var cassetteSensitiveKeyRegex = regexp.MustCompile(`(?i)(password|passwd|secret|token|regkey|registrationkey|presharedkey|sharedkey|authkey|psk)`)
+
+// cassetteHeaders lists the response headers stored in a cassette. Other headers are dropped.
+var cassetteHeaders = map[string]bool{
+	"Content-Type":         false,
+	"Domain_uuid":          false,
+	"Domains":              false,
+	"X-Auth-Access-Token":  true,
+	"X-Auth-Refresh-Token": true,
+}
+
+// testAccCassette is the cassette of the currently running acceptance test, if any.
+var testAccCassette *cassette
+
+// cassette records REST API interactions of the FMC client into a fixture file, or serves
+// previously recorded interactions back, so acceptance tests can run without a live FMC.
+type cassette struct {
+	FMCVersion   string                `json:"fmc_version"`
+	CDFMC        bool                  `json:"cdfmc"`
+	Env          map[string]string     `json:"env,omitempty"`
+	Interactions []cassetteInteraction `json:"interactions"`
+
+	path   string
+	mode   string
+	mu     sync.Mutex
+	replay map[string][]cassetteInteraction
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// cassettePath returns the fixture file of the test for the given FMC version.
+func cassettePath(t *testing.T, fmcVersion string) string {
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	return filepath.Join(cassetteDir, fmcVersion, name+".json")
+}
+
+// setupCassette starts recording or replaying the REST API interactions of the test, depending on
+// FMC_CASSETTE. Cassettes are stored per FMC version, which is taken from FMC_VERSION.
+func setupCassette(t *testing.T) {
+	t.Helper()
+
+	mode := os.Getenv("FMC_CASSETTE")
+	if mode == "" {
+		return
+	}
+	if mode != cassetteModeRecord && mode != cassetteModeReplay {
+		t.Fatalf("FMC_CASSETTE must be either %q or %q", cassetteModeRecord, cassetteModeReplay)
+	}
+
+	fmcVersion := os.Getenv("FMC_VERSION")
+	if fmcVersion == "" {
+		t.Fatal("FMC_VERSION env variable must be set when FMC_CASSETTE is used")
+	}
+
+	c := &cassette{
+		FMCVersion: fmcVersion,
+		path:       cassettePath(t, fmcVersion),
+		mode:       mode,
+	}
+
+	if mode == cassetteModeReplay {
+		if _, err := os.Stat(c.path); os.IsNotExist(err) {
+			t.Skipf("no cassette recorded for FMC version %s at %s", fmcVersion, c.path)
+		}
+		if err := c.load(); err != nil {
+			t.Fatalf("failed to load cassette %s: %s", c.path, err)
+		}
+
+		// Credentials are not needed during replay, but the provider refuses to configure without them
+		t.Setenv("FMC_URL", cassetteReplayURL)
+		t.Setenv("FMC_RETRIES", "0")
+		t.Setenv("FMC_REQUESTS_PER_MINUTE", "60000")
+		if c.CDFMC {
+			t.Setenv("FMC_USERNAME", "")
+			t.Setenv("FMC_PASSWORD", "")
+			t.Setenv("FMC_TOKEN", cassetteRedacted)
+		} else {
+			t.Setenv("FMC_USERNAME", cassetteRedacted)
+			t.Setenv("FMC_PASSWORD", cassetteRedacted)
+			t.Setenv("FMC_TOKEN", "")
+		}
+		for k, v := range c.Env {
+			t.Setenv(k, v)
+		}
+	} else {
+		c.CDFMC = os.Getenv("FMC_TOKEN") != ""
+		c.Env = map[string]string{}
+		for _, e := range os.Environ() {
+			if k, v, ok := strings.Cut(e, "="); ok && strings.HasPrefix(k, "TF_VAR_") {
+				c.Env[k] = v
+			}
+		}
+		t.Cleanup(func() {
+			if t.Failed() {
+				return
+			}
+			if err := c.save(); err != nil {
+				t.Errorf("failed to save cassette %s: %s", c.path, err)
+			}
+		})
+	}
+
+	testAccCassette = c
+	t.Cleanup(func() { testAccCassette = nil })
+}
+
+// transport returns the transport wrapper to be used by the provider, or nil if no cassette is active.
+func (c *cassette) transport() func(http.RoundTripper) http.RoundTripper {
+	if c == nil {
+		return nil
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &cassetteTransport{cassette: c, next: next}
+	}
+}
+
+// load reads the recorded interactions for replay.
+func (c *cassette) load() error {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, c); err != nil {
+		return err
+	}
+	c.replay = map[string][]cassetteInteraction{}
+	for _, i := range c.Interactions {
+		key := cassetteKey(i.Request.Method, i.Request.URL, scrubCassetteBody([]byte(i.Request.Body)))
+		c.replay[key] = append(c.replay[key], i)
+	}
+	return nil
+}
+
+// save writes the recorded interactions to the cassette file.
+func (c *cassette) save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// record stores a scrubbed copy of the interaction.
+func (c *cassette) record(req *http.Request, reqBody []byte, res *http.Response, resBody []byte) {
+	headers := map[string]string{}
+	for k, v := range res.Header {
+		if scrub, ok := cassetteHeaders[http.CanonicalHeaderKey(k)]; ok && len(v) > 0 {
+			if scrub {
+				headers[http.CanonicalHeaderKey(k)] = cassetteRedacted
+			} else {
+				headers[http.CanonicalHeaderKey(k)] = v[0]
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, cassetteInteraction{
+		Request: cassetteRequest{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Body:   scrubCassetteBody(reqBody),
+		},
+		Response: cassetteResponse{
+			StatusCode: res.StatusCode,
+			Headers:    headers,
+			Body:       scrubCassetteBody(resBody),
+		},
+	})
+}
+
+// next returns the next recorded interaction matching the request method, URL and body. Interactions
+// with the same key are returned in recorded order.
+func (c *cassette) next(req *http.Request, body []byte) (cassetteInteraction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := cassetteKey(req.Method, req.URL.RequestURI(), scrubCassetteBody(body))
+	queue := c.replay[key]
+	if len(queue) == 0 {
+		return cassetteInteraction{}, false
+	}
+	c.replay[key] = queue[1:]
+	return queue[0], true
+}
+
+// cassetteKey returns the replay key of a request. The body is expected to be normalized by
+// scrubCassetteBody, so JSON key order and scrubbed values do not change the key.
+func cassetteKey(method, uri, body string) string {
+	key := method + " " + uri
+	if body != "" {
+		sum := sha256.Sum256([]byte(body))
+		key += " " + hex.EncodeToString(sum[:])
+	}
+	return key
+}
+
+// scrubCassetteBody replaces values of sensitive keys in a JSON body. Non-JSON bodies are returned unchanged.
+func scrubCassetteBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	res, err := json.Marshal(scrubCassetteValue(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(res)
+}
+
+func scrubCassetteValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if _, ok := e.(string); ok && cassetteSensitiveKeyRegex.MatchString(k) {
+				v[k] = cassetteRedacted
+			} else {
+				v[k] = scrubCassetteValue(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = scrubCassetteValue(e)
+		}
+	}
+	return v
+}
+
+type cassetteTransport struct {
+	cassette *cassette
+	next     http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	if t.cassette.mode == cassetteModeReplay {
+		i, ok := t.cassette.next(req, reqBody)
+		if !ok {
+			return nil, fmt.Errorf("cassette %s: no recorded interaction left for %s %s with body %s", t.cassette.path, req.Method, req.URL.RequestURI(), scrubCassetteBody(reqBody))
+		}
+		res := &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{},
+			Body:          io.NopCloser(strings.NewReader(i.Response.Body)),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}
+		for k, v := range i.Response.Headers {
+			res.Header.Set(k, v)
+		}
+		return res, nil
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	t.cassette.record(req, reqBody, res, resBody)
+	return res, nil
+}
+
+// runCassetteScenario creates two realms with the given names, in the given order, through a client using the
+// cassette, and reads them back. It returns the domain UUID and, per realm, its ID and the name read back.
+func runCassetteScenario(t *testing.T, c *cassette, url string, names ...string) []string {
+	t.Helper()
+
+	client, err := fmc.NewClient(url, mockFMCUsername, mockFMCPassword, fmc.MaxRetries(0), func(client *fmc.Client) {
+		client.HttpClient.Transport = c.transport()(client.HttpClient.Transport)
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	ids := map[string]string{}
+	for _, name := range names {
+		res, err := client.Post("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/realms", fmt.Sprintf(`{"name":%q,"password":"s3cret-%s"}`, name, name))
+		if err != nil {
+			t.Fatalf("failed to create object %s: %s", name, err)
+		}
+		ids[name] = res.Get("id").String()
+	}
+
+	results := []string{client.DomainUUID}
+	for _, name := range slices.Sorted(maps.Keys(ids)) {
+		read, err := client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/realms/" + ids[name])
+		if err != nil {
+			t.Fatalf("failed to read object %s: %s", name, err)
+		}
+		results = append(results, ids[name], read.Get("name").String())
+	}
+	return results
+}
+
+func TestUnitCassetteRecordReplay(t *testing.T) {
+	m := newMockFMC(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder := &cassette{path: path, mode: cassetteModeRecord}
+	recorded := runCassetteScenario(t, recorder, m.URL, "realm1", "realm2")
+	if err := recorder.save(); err != nil {
+		t.Fatalf("failed to save cassette: %s", err)
+	}
+	m.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %s", err)
+	}
+	for _, secret := range []string{"s3cret-realm1", "s3cret-realm2", mockFMCPassword} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains unscrubbed secret %q", secret)
+		}
+	}
+	for _, token := range slices.Collect(maps.Keys(m.tokens)) {
+		if strings.Contains(string(data), token) {
+			t.Errorf("cassette contains unscrubbed token %q", token)
+		}
+	}
+
+	// Requests to the same URL are told apart by their body, so the realms can be created in another order
+	player := &cassette{path: path, mode: cassetteModeReplay}
+	if err := player.load(); err != nil {
+		t.Fatalf("failed to load cassette: %s", err)
+	}
+	replayed := runCassetteScenario(t, player, cassetteReplayURL, "realm2", "realm1")
+	if !slices.Equal(recorded, replayed) {
+		t.Errorf("replayed results %v differ from recorded results %v", replayed, recorded)
+	}
+
+	if _, ok := player.next(httptest.NewRequest(http.MethodGet, "/api/fmc_platform/v1/info/serverversion", nil), nil); ok {
+		t.Error("expected all recorded interactions to be consumed")
+	}
+}
+
+func TestUnitCassetteReplayFixture(t *testing.T) {
+	c := &cassette{FMCVersion: "mock", path: cassettePath(t, "mock"), mode: cassetteModeReplay}
+	url := cassetteReplayURL
+
+	// The fixture is recorded against the mock FMC with FMC_CASSETTE=record
+	if os.Getenv("FMC_CASSETTE") == cassetteModeRecord {
+		m := newMockFMC(t)
+		c.mode = cassetteModeRecord
+		url = m.URL
+		t.Cleanup(func() {
+			if err := c.save(); err != nil {
+				t.Errorf("failed to save cassette %s: %s", c.path, err)
+			}
+		})
+	} else if err := c.load(); err != nil {
+		t.Fatalf("failed to load cassette %s: %s", c.path, err)
+	}
+
+	results := runCassetteScenario(t, c, url, "realm1", "realm2")
+	if results[0] != mockFMCGlobalDomainUUID {
+		t.Errorf("unexpected domain UUID %q", results[0])
+	}
+	for i, name := range []string{"realm1", "realm2"} {
+		if id := results[1+2*i]; id == "" {
+			t.Errorf("expected an ID for %s", name)
+		}
+		if got := results[2+2*i]; got != name {
+			t.Errorf("expected name %q, got %q", name, got)
+		}
+	}
+}
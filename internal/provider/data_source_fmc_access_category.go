@@ -49,7 +49,8 @@ func NewAccessCategoryDataSource() datasource.DataSource {
 }
 
 type AccessCategoryDataSource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (d *AccessCategoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,8 +69,9 @@ func (d *AccessCategoryDataSource) Schema(ctx context.Context, req datasource.Sc
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"access_control_policy_id": schema.StringAttribute{
 				MarkdownDescription: "Id of the Access Control Policy.",
@@ -118,6 +120,7 @@ func (d *AccessCategoryDataSource) Configure(_ context.Context, req datasource.C
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
 }
 
 // End of section. //template:end model
@@ -134,10 +137,15 @@ func (d *AccessCategoryDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
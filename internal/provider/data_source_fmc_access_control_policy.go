@@ -50,7 +50,8 @@ func NewAccessControlPolicyDataSource() datasource.DataSource {
 }
 
 type AccessControlPolicyDataSource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (d *AccessControlPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -69,8 +70,9 @@ func (d *AccessControlPolicyDataSource) Schema(ctx context.Context, req datasour
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the Access Control Policy.",
@@ -133,6 +135,18 @@ func (d *AccessControlPolicyDataSource) Schema(ctx context.Context, req datasour
 				MarkdownDescription: "Id of the Prefilter Policy.",
 				Computed:            true,
 			},
+			"decryption_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Decryption Policy (`fmc_decryption_policy`).",
+				Computed:            true,
+			},
+			"dns_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the DNS Policy (`fmc_dns_policy`).",
+				Computed:            true,
+			},
+			"umbrella_dns_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Umbrella DNS Policy (`fmc_umbrella_dns_policy`).",
+				Computed:            true,
+			},
 			"manage_categories": schema.BoolAttribute{
 				MarkdownDescription: "Should this resource manage Access Policy Categories. For Data Sources this defaults to `false` (Categories are not read).",
 				Optional:            true,
@@ -689,6 +703,7 @@ func (d *AccessControlPolicyDataSource) Configure(_ context.Context, req datasou
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
 }
 
 // End of section. //template:end model
@@ -704,10 +719,15 @@ func (d *AccessControlPolicyDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
@@ -26,6 +26,7 @@ import (
 	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
 )
@@ -45,7 +46,8 @@ func NewAccessControlPolicyInheritanceDataSource() datasource.DataSource {
 }
 
 type AccessControlPolicyInheritanceDataSource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (d *AccessControlPolicyInheritanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,8 +65,9 @@ func (d *AccessControlPolicyInheritanceDataSource) Schema(ctx context.Context, r
 				Required:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"access_control_policy_id": schema.StringAttribute{
 				MarkdownDescription: "Id of the Access Control Policy.",
@@ -88,6 +91,7 @@ func (d *AccessControlPolicyInheritanceDataSource) Configure(_ context.Context,
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
 }
 
 // End of section. //template:end model
@@ -104,10 +108,15 @@ func (d *AccessControlPolicyInheritanceDataSource) Read(ctx context.Context, req
 		return
 	}
 
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
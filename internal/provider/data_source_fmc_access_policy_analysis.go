@@ -0,0 +1,507 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AccessPolicyAnalysisDataSource{}
+	_ datasource.DataSourceWithConfigure = &AccessPolicyAnalysisDataSource{}
+)
+
+func NewAccessPolicyAnalysisDataSource() datasource.DataSource {
+	return &AccessPolicyAnalysisDataSource{}
+}
+
+type AccessPolicyAnalysisDataSource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (d *AccessPolicyAnalysisDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_policy_analysis"
+}
+
+func (d *AccessPolicyAnalysisDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This data source analyzes the Access Rules of an Access Control Policy and reports rules that can never match, because an earlier rule covers their traffic, and overly permissive rules.\n Network and port objects (including nested groups and ranges) are expanded, zones are compared by Id. Other rule conditions (e.g. applications, URLs or users) are only considered equal if they reference the same objects. Disabled rules are ignored and `MONITOR` rules never cover other rules.\n The analysis runs entirely in the provider, no changes are made on FMC.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"access_control_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Access Control Policy.",
+				Required:            true,
+			},
+			"findings": schema.ListNestedAttribute{
+				MarkdownDescription: "List of findings, ordered by rule index.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the finding. `shadowed`: the rule is covered by an earlier rule with a different action. `redundant`: the rule is covered by an earlier rule with the same action. `permissive`: the rule allows traffic from any source network to any destination network and port.",
+							Computed:            true,
+						},
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the Access Rule.",
+							Computed:            true,
+						},
+						"rule_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the Access Rule.",
+							Computed:            true,
+						},
+						"rule_index": schema.Int64Attribute{
+							MarkdownDescription: "Index of the Access Rule in the policy.",
+							Computed:            true,
+						},
+						"covering_rule_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the earlier Access Rule that covers this rule, for `shadowed` and `redundant` findings.",
+							Computed:            true,
+						},
+						"covering_rule_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the earlier Access Rule that covers this rule, for `shadowed` and `redundant` findings.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccessPolicyAnalysisDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+// End of section. //template:end model
+
+func (d *AccessPolicyAnalysisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AccessPolicyAnalysis
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", "Access Policy Analysis"))
+
+	// Get all rules of the policy, in the order they are evaluated
+	urlPath := config.getPath() + "?expanded=true"
+	res, err := d.client.Get(urlPath, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+
+	analyzer := accessPolicyAnalyzer{client: d.client, reqMods: reqMods, objects: map[string]gjson.Result{}}
+	findings, err := analyzer.analyze(res.Get("items").Array())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to expand objects referenced by rules, got error: %s", err))
+		return
+	}
+
+	body, _ := sjson.Set("", "findings", findings)
+	config.fromBody(ctx, gjson.Parse(body))
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", "Access Policy Analysis"))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// accessPolicyAnalysisMaxDepth limits expansion of nested groups, so that group loops cannot hang the analysis.
+const accessPolicyAnalysisMaxDepth = 10
+
+// accessPolicyAnalysisObjectPaths maps types of objects, that can be expanded, to their endpoints.
+var accessPolicyAnalysisObjectPaths = map[string]string{
+	"Host":               "/object/hosts",
+	"Network":            "/object/networks",
+	"Range":              "/object/ranges",
+	"NetworkGroup":       "/object/networkgroups",
+	"ProtocolPortObject": "/object/protocolportobjects",
+	"PortObjectGroup":    "/object/portobjectgroups",
+	"ICMPV4Object":       "/object/icmpv4objects",
+	"ICMPV6Object":       "/object/icmpv6objects",
+}
+
+// accessPolicyAnalysisOtherConditions are rule conditions that are not expanded. A rule only covers another rule
+// on these conditions, if it does not set them or if both rules reference exactly the same objects.
+var accessPolicyAnalysisOtherConditions = []string{
+	"vlanTags", "applications", "urls", "users", "sourceSecurityGroupTags", "destinationSecurityGroupTags",
+	"sourceDynamicObjects", "destinationDynamicObjects", "endPointDeviceTypes", "networkAccessDeviceIPs", "timeRangeObjects",
+}
+
+type accessPolicyFinding struct {
+	Kind             string `json:"kind"`
+	RuleId           string `json:"ruleId"`
+	RuleName         string `json:"ruleName"`
+	RuleIndex        int64  `json:"ruleIndex"`
+	CoveringRuleId   string `json:"coveringRuleId,omitempty"`
+	CoveringRuleName string `json:"coveringRuleName,omitempty"`
+}
+
+// servicePoint encodes a protocol and port (or ICMP type and code) as protocol<<16 | port,
+// so that port conditions can be stored in a helpers.RangeSet.
+type servicePoint uint32
+
+func (p servicePoint) Compare(o servicePoint) int { return cmp.Compare(p, o) }
+func (p servicePoint) Next() servicePoint         { return p + 1 }
+
+// conditionSet is the traffic matched by a single rule condition. Values that cannot be expanded (such as
+// FQDN objects or zones) are kept as atoms, which are only covered by the same atom.
+type conditionSet[T helpers.RangeValue[T]] struct {
+	any    bool
+	ranges helpers.RangeSet[T]
+	atoms  map[string]bool
+}
+
+func (c conditionSet[T]) covers(o conditionSet[T]) bool {
+	if c.any {
+		return true
+	}
+	if o.any || !c.ranges.Contains(o.ranges) {
+		return false
+	}
+	for k := range o.atoms {
+		if !c.atoms[k] {
+			return false
+		}
+	}
+	return true
+}
+
+type accessRuleConditions struct {
+	id, name, action                    string
+	index                               int64
+	sourceZones, destinationZones       conditionSet[servicePoint]
+	sourceNetworks, destinationNetworks conditionSet[netip.Addr]
+	sourcePorts, destinationPorts       conditionSet[servicePoint]
+	other                               map[string]string
+}
+
+// covers reports whether all traffic matched by o is also matched by r.
+func (r accessRuleConditions) covers(o accessRuleConditions) bool {
+	for _, k := range accessPolicyAnalysisOtherConditions {
+		if r.other[k] != "" && r.other[k] != o.other[k] {
+			return false
+		}
+	}
+	return r.sourceZones.covers(o.sourceZones) && r.destinationZones.covers(o.destinationZones) &&
+		r.sourceNetworks.covers(o.sourceNetworks) && r.destinationNetworks.covers(o.destinationNetworks) &&
+		r.sourcePorts.covers(o.sourcePorts) && r.destinationPorts.covers(o.destinationPorts)
+}
+
+// permissive reports whether r allows traffic from any network to any network and port.
+func (r accessRuleConditions) permissive() bool {
+	anyNetwork := func(c conditionSet[netip.Addr]) bool {
+		var all helpers.RangeSet[netip.Addr]
+		all.Add(netip.IPv4Unspecified(), netip.MustParseAddr("255.255.255.255"))
+		return c.any || c.ranges.Contains(all)
+	}
+	return (r.action == "ALLOW" || r.action == "TRUST") &&
+		anyNetwork(r.sourceNetworks) && anyNetwork(r.destinationNetworks) && r.destinationPorts.any
+}
+
+type accessPolicyAnalyzer struct {
+	client  *fmc.Client
+	reqMods []func(*fmc.Req)
+	objects map[string]gjson.Result
+}
+
+// analyze reports rules covered by an earlier rule and overly permissive rules. Rules must be in evaluation order.
+func (a *accessPolicyAnalyzer) analyze(rules []gjson.Result) ([]accessPolicyFinding, error) {
+	findings := []accessPolicyFinding{}
+	var active []accessRuleConditions
+
+	for i, rule := range rules {
+		if v := rule.Get("enabled"); v.Exists() && !v.Bool() {
+			continue
+		}
+		r, err := a.conditions(rule)
+		if err != nil {
+			return nil, err
+		}
+		if r.index == 0 {
+			r.index = int64(i + 1)
+		}
+
+		for _, c := range active {
+			// Monitor rules log traffic and continue with the next rule, so they never cover anything
+			if c.action == "MONITOR" || !c.covers(r) {
+				continue
+			}
+			kind := "redundant"
+			if c.action != r.action {
+				kind = "shadowed"
+			}
+			findings = append(findings, accessPolicyFinding{Kind: kind, RuleId: r.id, RuleName: r.name, RuleIndex: r.index, CoveringRuleId: c.id, CoveringRuleName: c.name})
+			break
+		}
+		if r.permissive() {
+			findings = append(findings, accessPolicyFinding{Kind: "permissive", RuleId: r.id, RuleName: r.name, RuleIndex: r.index})
+		}
+		active = append(active, r)
+	}
+
+	return findings, nil
+}
+
+func (a *accessPolicyAnalyzer) conditions(rule gjson.Result) (accessRuleConditions, error) {
+	r := accessRuleConditions{
+		id:     rule.Get("id").String(),
+		name:   rule.Get("name").String(),
+		action: rule.Get("action").String(),
+		index:  rule.Get("metadata.ruleIndex").Int(),
+		other:  map[string]string{},
+	}
+
+	r.sourceZones = zoneCondition(rule.Get("sourceZones"))
+	r.destinationZones = zoneCondition(rule.Get("destinationZones"))
+	var err error
+	if r.sourceNetworks, err = a.networkCondition(rule.Get("sourceNetworks")); err != nil {
+		return r, err
+	}
+	if r.destinationNetworks, err = a.networkCondition(rule.Get("destinationNetworks")); err != nil {
+		return r, err
+	}
+	if r.sourcePorts, err = a.portCondition(rule.Get("sourcePorts")); err != nil {
+		return r, err
+	}
+	if r.destinationPorts, err = a.portCondition(rule.Get("destinationPorts")); err != nil {
+		return r, err
+	}
+	for _, k := range accessPolicyAnalysisOtherConditions {
+		if v := rule.Get(k); !isAnyCondition(v) {
+			r.other[k] = canonicalJSON(v)
+		}
+	}
+
+	return r, nil
+}
+
+// object returns the expanded object of the given type, or false if objects of this type cannot be expanded.
+func (a *accessPolicyAnalyzer) object(typ, id string) (gjson.Result, bool, error) {
+	path, ok := accessPolicyAnalysisObjectPaths[typ]
+	if !ok {
+		return gjson.Result{}, false, nil
+	}
+	if res, ok := a.objects[typ+"/"+id]; ok {
+		return res, true, nil
+	}
+	res, err := a.client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}"+path+"/"+url.QueryEscape(id), a.reqMods...)
+	if err != nil {
+		return res, false, fmt.Errorf("%s %s: %s", typ, id, err)
+	}
+	a.objects[typ+"/"+id] = res
+	return res, true, nil
+}
+
+func (a *accessPolicyAnalyzer) networkCondition(v gjson.Result) (conditionSet[netip.Addr], error) {
+	c := conditionSet[netip.Addr]{any: isAnyCondition(v), atoms: map[string]bool{}}
+	return c, a.addNetworks(&c, v, 0)
+}
+
+func (a *accessPolicyAnalyzer) addNetworks(c *conditionSet[netip.Addr], v gjson.Result, depth int) error {
+	addAddress := func(value, atom string) {
+		if from, to, err := helpers.ParseAddressRange(value); err == nil {
+			c.ranges.Add(from, to)
+		} else {
+			c.atoms[atom] = true
+		}
+	}
+
+	for _, l := range v.Get("literals").Array() {
+		addAddress(l.Get("value").String(), "literal/"+l.Get("value").String())
+	}
+	for _, o := range v.Get("objects").Array() {
+		typ, id := o.Get("type").String(), o.Get("id").String()
+		obj, ok, err := a.object(typ, id)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !ok || depth >= accessPolicyAnalysisMaxDepth:
+			c.atoms[typ+"/"+id] = true
+		case typ == "NetworkGroup":
+			if err := a.addNetworks(c, obj, depth+1); err != nil {
+				return err
+			}
+		default:
+			addAddress(obj.Get("value").String(), typ+"/"+id)
+		}
+	}
+	return nil
+}
+
+func (a *accessPolicyAnalyzer) portCondition(v gjson.Result) (conditionSet[servicePoint], error) {
+	c := conditionSet[servicePoint]{any: isAnyCondition(v), atoms: map[string]bool{}}
+	return c, a.addPorts(&c, v, 0)
+}
+
+func (a *accessPolicyAnalyzer) addPorts(c *conditionSet[servicePoint], v gjson.Result, depth int) error {
+	for _, l := range v.Get("literals").Array() {
+		addPort(c, l, "literal/"+canonicalJSON(l))
+	}
+	for _, o := range v.Get("objects").Array() {
+		typ, id := o.Get("type").String(), o.Get("id").String()
+		obj, ok, err := a.object(typ, id)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !ok || depth >= accessPolicyAnalysisMaxDepth:
+			c.atoms[typ+"/"+id] = true
+		case typ == "PortObjectGroup":
+			if err := a.addPorts(c, obj, depth+1); err != nil {
+				return err
+			}
+		default:
+			addPort(c, obj, typ+"/"+id)
+		}
+	}
+	return nil
+}
+
+// addPort adds a port literal or object to c. ICMP types and codes are stored in place of the port.
+func addPort(c *conditionSet[servicePoint], v gjson.Result, atom string) {
+	protocol, err := helpers.ProtocolNumber(v.Get("protocol").String())
+	switch v.Get("type").String() {
+	case "ICMPV4Object":
+		protocol, err = 1, nil
+	case "ICMPV6Object":
+		protocol, err = 58, nil
+	}
+	if err != nil {
+		c.atoms[atom] = true
+		return
+	}
+
+	from, to := 0, 0xffff
+	if protocol == 1 || protocol == 58 {
+		if t := v.Get("icmpType").String(); t != "" && !strings.EqualFold(t, "any") {
+			icmpType, err := strconv.Atoi(t)
+			if err != nil || icmpType < 0 || icmpType > 0xff {
+				c.atoms[atom] = true
+				return
+			}
+			from, to = icmpType<<8, icmpType<<8|0xff
+			if code, err := strconv.Atoi(v.Get("code").String()); err == nil && code >= 0 && code <= 0xff {
+				from, to = from|code, from|code
+			}
+		}
+	} else if port := v.Get("port").String(); port != "" {
+		if from, to, err = helpers.ParsePortRange(port); err != nil {
+			c.atoms[atom] = true
+			return
+		}
+	}
+	c.ranges.Add(servicePoint(protocol<<16|from), servicePoint(protocol<<16|to))
+}
+
+func zoneCondition(v gjson.Result) conditionSet[servicePoint] {
+	c := conditionSet[servicePoint]{any: isAnyCondition(v), atoms: map[string]bool{}}
+	for _, o := range v.Get("objects").Array() {
+		c.atoms[o.Get("id").String()] = true
+	}
+	return c
+}
+
+// isAnyCondition reports whether a rule condition is not set, i.e. it matches any traffic.
+func isAnyCondition(v gjson.Result) bool {
+	if !v.Exists() {
+		return true
+	}
+	if !v.IsObject() {
+		return len(v.Array()) == 0
+	}
+	empty := true
+	v.ForEach(func(_, v gjson.Result) bool {
+		empty = v.IsArray() && len(v.Array()) == 0
+		return empty
+	})
+	return empty
+}
+
+// canonicalJSON returns v with sorted object keys and array elements, so that equal conditions compare equal.
+func canonicalJSON(v gjson.Result) string {
+	var elems []string
+	switch {
+	case v.IsArray():
+		for _, e := range v.Array() {
+			elems = append(elems, canonicalJSON(e))
+		}
+		slices.Sort(elems)
+		return "[" + strings.Join(elems, ",") + "]"
+	case v.IsObject():
+		v.ForEach(func(k, e gjson.Result) bool {
+			if k.String() != "links" {
+				elems = append(elems, k.Raw+":"+canonicalJSON(e))
+			}
+			return true
+		})
+		slices.Sort(elems)
+		return "{" + strings.Join(elems, ",") + "}"
+	}
+	return v.Raw
+}
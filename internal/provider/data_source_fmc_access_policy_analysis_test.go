@@ -0,0 +1,87 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+)
+
+func TestUnitAccessPolicyAnalysisRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	d := &AccessPolicyAnalysisDataSource{client: client}
+
+	network := m.AddObject("/object/networks", `{"name":"net10","value":"10.0.0.0/8"}`)
+	group := m.AddObject("/object/networkgroups", `{"name":"inner","objects":[{"id":"`+network+`","type":"Network"}],"literals":[{"type":"Range","value":"172.16.0.1-172.16.0.9"}]}`)
+	group = m.AddObject("/object/networkgroups", `{"name":"outer","objects":[{"id":"`+group+`","type":"NetworkGroup"}]}`)
+	host := m.AddObject("/object/hosts", `{"name":"host","value":"10.1.1.1"}`)
+	hostRange := m.AddObject("/object/ranges", `{"name":"range","value":"172.16.0.2-172.16.0.3"}`)
+	fqdn := m.AddObject("/object/fqdns", `{"name":"fqdn","value":"example.com"}`)
+	https := m.AddObject("/object/protocolportobjects", `{"name":"https","protocol":"TCP","port":"443"}`)
+	ports := m.AddObject("/object/portobjectgroups", `{"name":"web","objects":[{"id":"`+https+`","type":"ProtocolPortObject"}]}`)
+
+	acp := m.AddObject("/policy/accesspolicies", `{"name":"acp"}`)
+	rules := []string{
+		`{"name":"allow_inside","action":"ALLOW","sourceZones":{"objects":[{"id":"zone_a"}]},"sourceNetworks":{"objects":[{"id":"` + group + `","type":"NetworkGroup"}]},"destinationPorts":{"objects":[{"id":"` + https + `","type":"ProtocolPortObject"}]}}`,
+		`{"name":"allow_host","action":"ALLOW","sourceZones":{"objects":[{"id":"zone_a"}]},"sourceNetworks":{"literals":[{"type":"Host","value":"10.1.1.1"}]},"destinationPorts":{"literals":[{"type":"PortLiteral","protocol":"6","port":"443"}]}}`,
+		`{"name":"block_range","action":"BLOCK","sourceZones":{"objects":[{"id":"zone_a"}]},"sourceNetworks":{"objects":[{"id":"` + hostRange + `","type":"Range"}]},"destinationPorts":{"objects":[{"id":"` + ports + `","type":"PortObjectGroup"}]}}`,
+		`{"name":"allow_zone_b","action":"ALLOW","sourceZones":{"objects":[{"id":"zone_b"}]},"sourceNetworks":{"objects":[{"id":"` + host + `","type":"Host"}]}}`,
+		`{"name":"allow_app","action":"ALLOW","sourceZones":{"objects":[{"id":"zone_a"}]},"sourceNetworks":{"objects":[{"id":"` + host + `","type":"Host"}]},"destinationPorts":{"literals":[{"type":"PortLiteral","protocol":"6","port":"443"}]},"applications":{"applications":[{"id":"app1"}]}}`,
+		`{"name":"allow_other_port","action":"ALLOW","sourceZones":{"objects":[{"id":"zone_a"}]},"sourceNetworks":{"objects":[{"id":"` + host + `","type":"Host"}]},"destinationPorts":{"literals":[{"type":"PortLiteral","protocol":"6","port":"442-443"}]}}`,
+		`{"name":"monitor_any","action":"MONITOR"}`,
+		`{"name":"allow_any","action":"ALLOW","sourceNetworks":{"objects":[],"literals":[]}}`,
+		`{"name":"disabled","action":"BLOCK","enabled":false}`,
+		`{"name":"block_fqdn","action":"BLOCK","destinationNetworks":{"objects":[{"id":"` + fqdn + `","type":"FQDN"}]}}`,
+	}
+	ids := map[string]string{}
+	for _, v := range rules {
+		ids[gjson.Get(v, "name").String()] = m.AddObject("/policy/accesspolicies/"+acp+"/accessrules", v)
+	}
+
+	resp := testUnitDataSourceRead(ctx, d, AccessPolicyAnalysis{AccessControlPolicyId: types.StringValue(acp)})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to analyze policy: %v", resp.Diagnostics)
+	}
+	var data AccessPolicyAnalysis
+	resp.State.Get(ctx, &data)
+
+	var findings []string
+	for _, f := range data.Findings {
+		if f.RuleId.ValueString() != ids[f.RuleName.ValueString()] || f.CoveringRuleId.ValueString() != ids[f.CoveringRuleName.ValueString()] {
+			t.Errorf("unexpected rule IDs in finding %+v", f)
+		}
+		findings = append(findings, fmt.Sprintf("%d:%s:%s:%s", f.RuleIndex.ValueInt64(), f.RuleName.ValueString(), f.Kind.ValueString(), f.CoveringRuleName.ValueString()))
+	}
+	expected := []string{
+		"2:allow_host:redundant:allow_inside",
+		"3:block_range:shadowed:allow_inside",
+		"5:allow_app:redundant:allow_inside",
+		"8:allow_any:permissive:",
+		"10:block_fqdn:shadowed:allow_any",
+	}
+	if !slices.Equal(findings, expected) {
+		t.Errorf("expected findings %v, got %v", expected, findings)
+	}
+}
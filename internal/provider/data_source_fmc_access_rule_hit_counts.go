@@ -0,0 +1,200 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &AccessRuleHitCountsDataSource{}
+	_ datasource.DataSourceWithConfigure = &AccessRuleHitCountsDataSource{}
+)
+
+func NewAccessRuleHitCountsDataSource() datasource.DataSource {
+	return &AccessRuleHitCountsDataSource{}
+}
+
+type AccessRuleHitCountsDataSource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (d *AccessRuleHitCountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_rule_hit_counts"
+}
+
+func (d *AccessRuleHitCountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This data source reads the hit counts of Access Rules of an Access Control Policy on a Device.\n Rules managed by both `fmc_access_rule` and `fmc_access_rules` can be selected by their IDs.\n Timestamps are in RFC 3339 format, so they can be compared using `timecmp()` and `timeadd()`, e.g. in a `check` block to find rules unused for 90 days.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the object",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"access_control_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Access Control Policy.",
+				Required:            true,
+			},
+			"device_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Device the hit counts are collected from.",
+				Required:            true,
+			},
+			"refresh": schema.BoolAttribute{
+				MarkdownDescription: "Refresh the hit counts on the Device before reading them.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"items": schema.MapNestedAttribute{
+				MarkdownDescription: "Map of Access Rule hit counts. The key of the map is the Id of the individual Access Rule. If no keys are provided, hit counts of all rules in the policy are read.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the Access Rule.",
+							Computed:            true,
+						},
+						"hit_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of times the Access Rule was hit.",
+							Computed:            true,
+						},
+						"first_hit_time": schema.StringAttribute{
+							MarkdownDescription: "Time of the first hit (RFC 3339), null if the rule was never hit.",
+							Computed:            true,
+						},
+						"last_hit_time": schema.StringAttribute{
+							MarkdownDescription: "Time of the last hit (RFC 3339), null if the rule was never hit.",
+							Computed:            true,
+						},
+						"last_fetch_time": schema.StringAttribute{
+							MarkdownDescription: "Time the hit counts were last fetched from the Device (RFC 3339).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccessRuleHitCountsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+// End of section. //template:end model
+
+func (d *AccessRuleHitCountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AccessRuleHitCounts
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
+	}
+
+	// Hit counts are specific to the policy and the device
+	config.Id = types.StringValue(config.AccessControlPolicyId.ValueString() + ":" + config.DeviceId.ValueString())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.ValueString()))
+
+	filter := "deviceId:" + config.DeviceId.ValueString()
+
+	// Refresh hit counts on the device, if requested
+	if config.Refresh.ValueBool() {
+		res, err := d.client.Put(config.getPath()+"?filter="+url.QueryEscape(filter), "", reqMods...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to refresh hit counts, got error: %s, %s", err, res.String()))
+			return
+		}
+		if taskId := res.Get("metadata.task.id").String(); taskId != "" {
+			diags = FMCWaitForJobToFinish(ctx, d.client, taskId, reqMods)
+			if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	// Get hit counts of all rules, including those that were never hit
+	urlPath := config.getPath() + "?expanded=true&filter=" + url.QueryEscape(filter+";fetchZeroHitCount:true")
+	res, err := d.client.Get(urlPath, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+
+	// Read all items if user did not provide any specific rule IDs in the config
+	if len(config.Items) == 0 {
+		if config.Items == nil {
+			config.Items = map[string]AccessRuleHitCountsItems{}
+		}
+		res.Get("items").ForEach(func(_, v gjson.Result) bool {
+			if id := v.Get("rule.id").String(); id != "" {
+				config.Items[id] = AccessRuleHitCountsItems{}
+			}
+			return true
+		})
+	}
+
+	config.fromBody(ctx, res)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitAccessRuleHitCountsRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	d := &AccessRuleHitCountsDataSource{client: client}
+	acp := m.AddObject("/policy/accesspolicies", `{"name":"acp"}`)
+	hitCountsPath := "/policy/accesspolicies/" + acp + "/operational/hitcounts"
+	m.AddObject(hitCountsPath, `{"rule":{"id":"rule1","name":"r1"},"hitCount":42,"firstHitTimeStamp":"2025-01-02T03:04:05Z","lastHitTimeStamp":"2025-06-07 08:09:10","lastFetchTimeStamp":"2025-06-08T00:00:00Z"}`)
+	m.AddObject(hitCountsPath, `{"rule":{"id":"rule2","name":"r2"},"hitCount":0,"firstHitTimeStamp":" ","lastHitTimeStamp":" ","lastFetchTimeStamp":"2025-06-08T00:00:00Z"}`)
+
+	read := func(config AccessRuleHitCounts) AccessRuleHitCounts {
+		t.Helper()
+		resp := testUnitDataSourceRead(ctx, d, config)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("failed to read hit counts: %v", resp.Diagnostics)
+		}
+		var data AccessRuleHitCounts
+		resp.State.Get(ctx, &data)
+		return data
+	}
+
+	config := AccessRuleHitCounts{
+		AccessControlPolicyId: types.StringValue(acp),
+		DeviceId:              types.StringValue("device1"),
+		Refresh:               types.BoolValue(true),
+	}
+	data := read(config)
+	if data.Id.ValueString() != acp+":device1" {
+		t.Errorf("expected ID of the policy and device, got %s", data.Id)
+	}
+	if len(data.Items) != 2 {
+		t.Fatalf("expected hit counts of all rules, got %v", data.Items)
+	}
+	r1 := data.Items["rule1"]
+	if r1.Name.ValueString() != "r1" || r1.HitCount.ValueInt64() != 42 || r1.FirstHitTime.ValueString() != "2025-01-02T03:04:05Z" || r1.LastHitTime.ValueString() != "2025-06-07T08:09:10Z" {
+		t.Errorf("unexpected hit count of rule1: %+v", r1)
+	}
+	if r2 := data.Items["rule2"]; r2.HitCount.ValueInt64() != 0 || !r2.FirstHitTime.IsNull() || !r2.LastHitTime.IsNull() {
+		t.Errorf("expected rule2 to have no hits, got %+v", r2)
+	}
+	if !slices.ContainsFunc(m.Requests(), func(v string) bool {
+		return strings.HasPrefix(v, "PUT") && strings.Contains(v, "/operational/hitcounts?filter=deviceId%3Adevice1")
+	}) {
+		t.Errorf("expected hit counts to be refreshed, got requests %v", m.Requests())
+	}
+
+	// Select rules by ID, as known from fmc_access_rule or fmc_access_rules
+	config.Refresh = types.BoolNull()
+	config.Items = map[string]AccessRuleHitCountsItems{"rule2": {}}
+	if data := read(config); len(data.Items) != 1 || data.Items["rule2"].Name.ValueString() != "r2" {
+		t.Errorf("expected only rule2 to be read, got %v", data.Items)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
 	"github.com/tidwall/gjson"
@@ -45,7 +46,8 @@ func NewApplicationFiltersDataSource() datasource.DataSource {
 }
 
 type ApplicationFiltersDataSource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (d *ApplicationFiltersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,8 +65,9 @@ func (d *ApplicationFiltersDataSource) Schema(ctx context.Context, req datasourc
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"items": schema.MapNestedAttribute{
 				MarkdownDescription: "Map of Application Filters. The key of the map is the name of the individual Application Filter.",
@@ -177,6 +180,7 @@ func (d *ApplicationFiltersDataSource) Configure(_ context.Context, req datasour
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
 }
 
 // End of section. //template:end model
@@ -187,16 +191,21 @@ func (d *ApplicationFiltersDataSource) Read(ctx context.Context, req datasource.
 	var config ApplicationFilters
 
 	// Read config
-	diags := req.Config.Get(ctx, &config)
+	diags := getDataSourceConfig(ctx, req.Config, NewApplicationFiltersResource(), &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
@@ -226,7 +235,7 @@ func (d *ApplicationFiltersDataSource) Read(ctx context.Context, req datasource.
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	diags = resp.State.Set(ctx, &config)
+	diags = setDataSourceState(ctx, &resp.State, NewApplicationFiltersResource(), &config)
 	resp.Diagnostics.Append(diags...)
 }
 
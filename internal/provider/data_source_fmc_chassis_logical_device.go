@@ -49,7 +49,8 @@ func NewChassisLogicalDeviceDataSource() datasource.DataSource {
 }
 
 type ChassisLogicalDeviceDataSource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (d *ChassisLogicalDeviceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,8 +69,9 @@ func (d *ChassisLogicalDeviceDataSource) Schema(ctx context.Context, req datasou
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"chassis_id": schema.StringAttribute{
 				MarkdownDescription: "Id of the parent chassis.",
@@ -224,6 +226,7 @@ func (d *ChassisLogicalDeviceDataSource) Configure(_ context.Context, req dataso
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
 }
 
 // End of section. //template:end model
@@ -234,16 +237,21 @@ func (d *ChassisLogicalDeviceDataSource) Read(ctx context.Context, req datasourc
 	var config ChassisLogicalDevice
 
 	// Read config
-	diags := req.Config.Get(ctx, &config)
+	diags := getDataSourceConfig(ctx, req.Config, NewChassisLogicalDeviceResource(), &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
@@ -289,7 +297,7 @@ func (d *ChassisLogicalDeviceDataSource) Read(ctx context.Context, req datasourc
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	diags = resp.State.Set(ctx, &config)
+	diags = setDataSourceState(ctx, &resp.State, NewChassisLogicalDeviceResource(), &config)
 	resp.Diagnostics.Append(diags...)
 }
 
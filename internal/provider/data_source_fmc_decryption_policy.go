@@ -0,0 +1,230 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DecryptionPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &DecryptionPolicyDataSource{}
+)
+
+func NewDecryptionPolicyDataSource() datasource.DataSource {
+	return &DecryptionPolicyDataSource{}
+}
+
+type DecryptionPolicyDataSource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (d *DecryptionPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_decryption_policy"
+}
+
+func (d *DecryptionPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This data source reads the Decryption Policy.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the object",
+				Optional:            true,
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Decryption Policy.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the policy.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the object; this value is always 'SSLPolicy'.",
+				Computed:            true,
+			},
+			"default_action": schema.StringAttribute{
+				MarkdownDescription: "Action to take when none of the rules match.",
+				Computed:            true,
+			},
+			"default_action_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the default action.",
+				Computed:            true,
+			},
+			"default_action_log_connection_end": schema.BoolAttribute{
+				MarkdownDescription: "Log events at the end of the connection for default action.",
+				Computed:            true,
+			},
+			"default_action_send_events_to_fmc": schema.BoolAttribute{
+				MarkdownDescription: "Send events to the Firepower Management Center event viewer for default action.",
+				Computed:            true,
+			},
+			"default_action_syslog_alert_id": schema.StringAttribute{
+				MarkdownDescription: "Id of syslog alert. Can be set only when `default_action_log_connection_end` is true.",
+				Computed:            true,
+			},
+			"undecryptable_compressed_session_action": schema.StringAttribute{
+				MarkdownDescription: "Action for compressed sessions.",
+				Computed:            true,
+			},
+			"undecryptable_sslv2_session_action": schema.StringAttribute{
+				MarkdownDescription: "Action for SSLv2 sessions.",
+				Computed:            true,
+			},
+			"undecryptable_unknown_cipher_suite_action": schema.StringAttribute{
+				MarkdownDescription: "Action for sessions with unknown cipher suite.",
+				Computed:            true,
+			},
+			"undecryptable_unsupported_cipher_suite_action": schema.StringAttribute{
+				MarkdownDescription: "Action for sessions with unsupported cipher suite.",
+				Computed:            true,
+			},
+			"undecryptable_session_not_cached_action": schema.StringAttribute{
+				MarkdownDescription: "Action for sessions, that are not cached.",
+				Computed:            true,
+			},
+			"undecryptable_handshake_errors_action": schema.StringAttribute{
+				MarkdownDescription: "Action for sessions with handshake errors.",
+				Computed:            true,
+			},
+			"undecryptable_decryption_errors_action": schema.StringAttribute{
+				MarkdownDescription: "Action for sessions with decryption errors.",
+				Computed:            true,
+			},
+		},
+	}
+}
+func (d *DecryptionPolicyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *DecryptionPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+// End of section. //template:end model
+
+// Section below is generated&owned by "gen/generator.go". //template:begin read
+
+func (d *DecryptionPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DecryptionPolicy
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+	if config.Id.IsNull() && !config.Name.IsNull() {
+		offset := 0
+		limit := 1000
+		for page := 1; ; page++ {
+			queryString := fmt.Sprintf("?limit=%d&offset=%d&expanded=true", limit, offset)
+			res, err := d.client.Get(config.getPath()+queryString, reqMods...)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve objects, got error: %s", err))
+				return
+			}
+			if value := res.Get("items"); len(value.Array()) > 0 {
+				value.ForEach(func(k, v gjson.Result) bool {
+					if config.Name.ValueString() == v.Get("name").String() {
+						config.Id = types.StringValue(v.Get("id").String())
+						tflog.Debug(ctx, fmt.Sprintf("%s: Found object with name '%v', id: %v", config.Id.ValueString(), config.Name.ValueString(), config.Id.ValueString()))
+						return false
+					}
+					return true
+				})
+			}
+			if !config.Id.IsNull() || !res.Get("paging.next.0").Exists() {
+				break
+			}
+			offset += limit
+		}
+
+		if config.Id.IsNull() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to find object with name: %v", config.Name.ValueString()))
+			return
+		}
+	}
+	urlPath := config.getPath() + "/" + url.QueryEscape(config.Id.ValueString())
+	res, err := d.client.Get(urlPath, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+
+	config.fromBody(ctx, res)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// End of section. //template:end read
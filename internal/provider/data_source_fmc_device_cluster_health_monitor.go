@@ -46,7 +46,8 @@ func NewDeviceClusterHealthMonitorDataSource() datasource.DataSource {
 }
 
 type DeviceClusterHealthMonitorDataSource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (d *DeviceClusterHealthMonitorDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -64,8 +65,9 @@ func (d *DeviceClusterHealthMonitorDataSource) Schema(ctx context.Context, req d
 				Required:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"cluster_id": schema.StringAttribute{
 				MarkdownDescription: "Id of the parent cluster",
@@ -142,6 +144,7 @@ func (d *DeviceClusterHealthMonitorDataSource) Configure(_ context.Context, req
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
 }
 
 // End of section. //template:end model
@@ -158,10 +161,15 @@ func (d *DeviceClusterHealthMonitorDataSource) Read(ctx context.Context, req dat
 		return
 	}
 
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
@@ -19,9 +19,14 @@ package provider
 
 // Section below is generated&owned by "gen/generator.go". //template:begin imports
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -133,3 +138,39 @@ func testAccNamedDataSourceFmcDeviceClusterConfig() string {
 }
 
 // End of section. //template:end testAccDataSourceConfig
+
+func TestUnitDeviceClusterDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	d := &DeviceClusterDataSource{client: newMockFMCClient(t, m)}
+
+	id := m.AddObject("/deviceclusters/ftddevicecluster", `{"name":"cluster","type":"DeviceCluster","controlDevice":{"deviceDetails":{"id":"control","name":"ftd-1"}},"dataDevices":[{"deviceDetails":{"id":"data","name":"ftd-2"}}]}`)
+
+	// The model is shared with the resource, while the data source schema has no `timeouts` block
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if _, ok := schemaResp.Schema.Blocks["timeouts"]; ok {
+		t.Error("expected no timeouts block in the data source schema")
+	}
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := config.SetAttribute(ctx, path.Root("id"), id); diags.HasError() {
+		t.Fatalf("failed to set config: %v", diags)
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to read device cluster: %v", resp.Diagnostics)
+	}
+
+	var data DeviceCluster
+	if diags := getDataSourceConfig(ctx, tfsdk.Config(resp.State), NewDeviceClusterResource(), &data); diags.HasError() {
+		t.Fatalf("failed to convert state: %v", diags)
+	}
+	if data.Name.ValueString() != "cluster" || data.ControlNodeDeviceId.ValueString() != "control" {
+		t.Errorf("unexpected name %q or control node %q", data.Name.ValueString(), data.ControlNodeDeviceId.ValueString())
+	}
+	if len(data.DataNodes) != 1 || data.DataNodes[0].DeviceId.ValueString() != "data" {
+		t.Errorf("unexpected data nodes %v", data.DataNodes)
+	}
+}
@@ -49,7 +49,8 @@ func NewDeviceHAPairFailoverInterfaceMACAddressDataSource() datasource.DataSourc
 }
 
 type DeviceHAPairFailoverInterfaceMACAddressDataSource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (d *DeviceHAPairFailoverInterfaceMACAddressDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,8 +69,9 @@ func (d *DeviceHAPairFailoverInterfaceMACAddressDataSource) Schema(ctx context.C
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"ha_pair_id": schema.StringAttribute{
 				MarkdownDescription: "Id of the parent HA Pair device.",
@@ -118,6 +120,7 @@ func (d *DeviceHAPairFailoverInterfaceMACAddressDataSource) Configure(_ context.
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
 }
 
 // End of section. //template:end model
@@ -134,10 +137,15 @@ func (d *DeviceHAPairFailoverInterfaceMACAddressDataSource) Read(ctx context.Con
 		return
 	}
 
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
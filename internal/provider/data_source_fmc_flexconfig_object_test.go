@@ -0,0 +1,116 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccDataSource
+
+func TestAccDataSourceFmcFlexConfigObject(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_flexconfig_object.test", "name", "my_flexconfig_object"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_flexconfig_object.test", "description", "My FlexConfig object"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("data.fmc_flexconfig_object.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_flexconfig_object.test", "deployment", "EVERYTIME"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_flexconfig_object.test", "flexconfig_type", "APPEND"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_flexconfig_object.test", "body", "snmp-server location $location"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_flexconfig_object.test", "variables.0.name", "location"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcFlexConfigObjectPrerequisitesConfig + testAccDataSourceFmcFlexConfigObjectConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+			{
+				Config: testAccDataSourceFmcFlexConfigObjectPrerequisitesConfig + testAccNamedDataSourceFmcFlexConfigObjectConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+// End of section. //template:end testAccDataSource
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+
+const testAccDataSourceFmcFlexConfigObjectPrerequisitesConfig = `
+resource "fmc_flexconfig_text_object" "test" {
+  name   = "fmc_flexconfig_object_location"
+  values = ["Building 1"]
+}
+`
+
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccDataSourceConfig
+
+func testAccDataSourceFmcFlexConfigObjectConfig() string {
+	config := `resource "fmc_flexconfig_object" "test" {` + "\n"
+	config += `	name = "my_flexconfig_object"` + "\n"
+	config += `	description = "My FlexConfig object"` + "\n"
+	config += `	deployment = "EVERYTIME"` + "\n"
+	config += `	flexconfig_type = "APPEND"` + "\n"
+	config += `	body = "snmp-server location $location"` + "\n"
+	config += `	variables = [{` + "\n"
+	config += `		name = "location"` + "\n"
+	config += `		object_id = fmc_flexconfig_text_object.test.id` + "\n"
+	config += `		object_type = fmc_flexconfig_text_object.test.type` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_flexconfig_object" "test" {
+			id = fmc_flexconfig_object.test.id
+		}
+	`
+	return config
+}
+
+func testAccNamedDataSourceFmcFlexConfigObjectConfig() string {
+	config := `resource "fmc_flexconfig_object" "test" {` + "\n"
+	config += `	name = "my_flexconfig_object"` + "\n"
+	config += `	description = "My FlexConfig object"` + "\n"
+	config += `	deployment = "EVERYTIME"` + "\n"
+	config += `	flexconfig_type = "APPEND"` + "\n"
+	config += `	body = "snmp-server location $location"` + "\n"
+	config += `	variables = [{` + "\n"
+	config += `		name = "location"` + "\n"
+	config += `		object_id = fmc_flexconfig_text_object.test.id` + "\n"
+	config += `		object_type = fmc_flexconfig_text_object.test.type` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_flexconfig_object" "test" {
+			name = fmc_flexconfig_object.test.name
+		}
+	`
+	return config
+}
+
+// End of section. //template:end testAccDataSourceConfig
@@ -0,0 +1,104 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccDataSource
+
+func TestAccDataSourceFmcFlexConfigPolicy(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_flexconfig_policy.test", "name", "my_flexconfig_policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_flexconfig_policy.test", "description", "My FlexConfig policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("data.fmc_flexconfig_policy.test", "type"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcFlexConfigPolicyPrerequisitesConfig + testAccDataSourceFmcFlexConfigPolicyConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+			{
+				Config: testAccDataSourceFmcFlexConfigPolicyPrerequisitesConfig + testAccNamedDataSourceFmcFlexConfigPolicyConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+// End of section. //template:end testAccDataSource
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+
+const testAccDataSourceFmcFlexConfigPolicyPrerequisitesConfig = `
+resource "fmc_flexconfig_object" "test" {
+  name            = "fmc_flexconfig_policy_object"
+  deployment      = "EVERYTIME"
+  flexconfig_type = "APPEND"
+  body            = "snmp-server location Building 1"
+}
+`
+
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccDataSourceConfig
+
+func testAccDataSourceFmcFlexConfigPolicyConfig() string {
+	config := `resource "fmc_flexconfig_policy" "test" {` + "\n"
+	config += `	name = "my_flexconfig_policy"` + "\n"
+	config += `	description = "My FlexConfig policy"` + "\n"
+	config += `	append_objects = [{` + "\n"
+	config += `		id = fmc_flexconfig_object.test.id` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_flexconfig_policy" "test" {
+			id = fmc_flexconfig_policy.test.id
+		}
+	`
+	return config
+}
+
+func testAccNamedDataSourceFmcFlexConfigPolicyConfig() string {
+	config := `resource "fmc_flexconfig_policy" "test" {` + "\n"
+	config += `	name = "my_flexconfig_policy"` + "\n"
+	config += `	description = "My FlexConfig policy"` + "\n"
+	config += `	append_objects = [{` + "\n"
+	config += `		id = fmc_flexconfig_object.test.id` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_flexconfig_policy" "test" {
+			name = fmc_flexconfig_policy.test.name
+		}
+	`
+	return config
+}
+
+// End of section. //template:end testAccDataSourceConfig
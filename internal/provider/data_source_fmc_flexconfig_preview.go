@@ -0,0 +1,245 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &FlexConfigPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &FlexConfigPreviewDataSource{}
+)
+
+func NewFlexConfigPreviewDataSource() datasource.DataSource {
+	return &FlexConfigPreviewDataSource{}
+}
+
+type FlexConfigPreviewDataSource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (d *FlexConfigPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flexconfig_preview"
+}
+
+func (d *FlexConfigPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This data source renders the CLI commands, that a FlexConfig Policy deploys to a device. Variables of the FlexConfig Objects (`$name` or `${name}`) are replaced with the values of the objects bound to them, using device specific overrides where present. Text Objects with multiple values are rendered as values separated by a space.\n Only variables bound to Text, Host, Network, Range and FQDN objects are replaced, others (e.g. system variables) are left as they are and are listed in `unresolved`. Velocity directives, such as `#foreach`, are not evaluated.\n The rendering runs entirely in the provider, no changes are made on FMC.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"flexconfig_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the FlexConfig Policy.",
+				Required:            true,
+			},
+			"device_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the device, whose overrides should be used.",
+				Required:            true,
+			},
+			"prepend_cli": schema.StringAttribute{
+				MarkdownDescription: "Commands deployed before the configuration generated by FMC.",
+				Computed:            true,
+			},
+			"append_cli": schema.StringAttribute{
+				MarkdownDescription: "Commands deployed after the configuration generated by FMC.",
+				Computed:            true,
+			},
+			"unresolved": schema.ListAttribute{
+				MarkdownDescription: "Variables (as `object_name/variable_name`), that could not be replaced.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FlexConfigPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+// End of section. //template:end model
+
+func (d *FlexConfigPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config FlexConfigPreview
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.FlexconfigPolicyId.ValueString()))
+
+	policy, err := d.client.Get(config.getPath()+"/"+url.QueryEscape(config.FlexconfigPolicyId.ValueString()), reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+
+	renderer := flexConfigRenderer{
+		client:     d.client,
+		reqMods:    reqMods,
+		deviceId:   config.DeviceId.ValueString(),
+		objects:    map[string]gjson.Result{},
+		unresolved: map[string]bool{},
+	}
+	prependCli, err := renderer.render(policy.Get("prependFlexConfigs"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to render FlexConfig Objects, got error: %s", err))
+		return
+	}
+	appendCli, err := renderer.render(policy.Get("appendFlexConfigs"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to render FlexConfig Objects, got error: %s", err))
+		return
+	}
+
+	body, _ := sjson.Set("", "prependCli", prependCli)
+	body, _ = sjson.Set(body, "appendCli", appendCli)
+	body, _ = sjson.Set(body, "unresolved", slices.Sorted(maps.Keys(renderer.unresolved)))
+	config.fromBody(ctx, gjson.Parse(body))
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.FlexconfigPolicyId.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// flexConfigVariableObjectPaths maps types of objects, whose values can be bound to FlexConfig variables, to their
+// endpoints.
+var flexConfigVariableObjectPaths = map[string]string{
+	"TextObject": "/object/textobjects",
+	"Host":       "/object/hosts",
+	"Network":    "/object/networks",
+	"Range":      "/object/ranges",
+	"FQDN":       "/object/fqdns",
+}
+
+// flexConfigVariable matches Velocity references, `$name` or `${name}`.
+var flexConfigVariable = regexp.MustCompile(`\$(?:\{([A-Za-z][\w-]*)\}|([A-Za-z][\w-]*))`)
+
+type flexConfigRenderer struct {
+	client     *fmc.Client
+	reqMods    []func(*fmc.Req)
+	deviceId   string
+	objects    map[string]gjson.Result
+	unresolved map[string]bool
+}
+
+// object returns the object at the given path, using the override for the device, if there is one.
+func (f *flexConfigRenderer) object(path, id string) (gjson.Result, error) {
+	if res, ok := f.objects[path+"/"+id]; ok {
+		return res, nil
+	}
+	res, err := fmcObjectForDevice(f.client, "/api/fmc_config/v1/domain/{DOMAIN_UUID}"+path+"/"+url.QueryEscape(id), f.deviceId, f.reqMods...)
+	if err != nil {
+		return res, fmt.Errorf("%s/%s: %s", path, id, err)
+	}
+	f.objects[path+"/"+id] = res
+	return res, nil
+}
+
+// render returns the CLI of the given FlexConfig Objects, in their order, with variables replaced by their values.
+func (f *flexConfigRenderer) render(refs gjson.Result) (string, error) {
+	var lines []string
+	for _, ref := range refs.Array() {
+		obj, err := f.object("/object/flexconfigobjects", ref.Get("id").String())
+		if err != nil {
+			return "", err
+		}
+
+		values := map[string]string{}
+		for _, v := range obj.Get("variables").Array() {
+			path, ok := flexConfigVariableObjectPaths[v.Get("value.type").String()]
+			if !ok {
+				continue
+			}
+			value, err := f.object(path, v.Get("value.id").String())
+			if err != nil {
+				return "", err
+			}
+			if value.Get("values").Exists() {
+				var items []string
+				for _, item := range value.Get("values").Array() {
+					items = append(items, item.String())
+				}
+				values[v.Get("name").String()] = strings.Join(items, " ")
+			} else {
+				values[v.Get("name").String()] = value.Get("value").String()
+			}
+		}
+
+		body := flexConfigVariable.ReplaceAllStringFunc(obj.Get("objectBody").String(), func(ref string) string {
+			name := strings.Trim(ref, "${}")
+			if value, ok := values[name]; ok {
+				return value
+			}
+			f.unresolved[obj.Get("name").String()+"/"+name] = true
+			return ref
+		})
+		if body = strings.TrimRight(body, "\n"); body != "" {
+			lines = append(lines, body)
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitFlexConfigPreviewRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	d := &FlexConfigPreviewDataSource{client: client}
+	device := "00000000-0000-0000-0000-0000000000d1"
+
+	location := m.AddObject("/object/textobjects", `{"name":"location","type":"TextObject","variableType":"SINGLE","values":["Building 1"],"overridable":true}`)
+	m.AddObject("/object/textobjects/"+location+"/overrides", `{"name":"location","type":"TextObject","values":["Building 2"],"overrides":{"parent":{"id":"`+location+`"},"target":{"id":"`+device+`","type":"Device"}}}`)
+	servers := m.AddObject("/object/textobjects", `{"name":"servers","type":"TextObject","variableType":"MULTIPLE","values":["a","b"]}`)
+	host := m.AddObject("/object/hosts", `{"name":"wccp","value":"10.1.1.1","type":"Host"}`)
+	snmp := m.AddObject("/object/flexconfigobjects", `{"name":"snmp","type":"FlexConfigObject","flexConfigType":"PREPEND","objectBody":"snmp-server location $location\nsnmp-server contact ${servers}\n","variables":[{"name":"location","value":{"id":"`+location+`","type":"TextObject"}},{"name":"servers","value":{"id":"`+servers+`","type":"TextObject"}}]}`)
+	wccp := m.AddObject("/object/flexconfigobjects", `{"name":"wccp","type":"FlexConfigObject","flexConfigType":"APPEND","objectBody":"wccp web-cache redirect-list $acl group-list $server","variables":[{"name":"server","value":{"id":"`+host+`","type":"Host"}}]}`)
+	policy := m.AddObject("/policy/flexconfigpolicies", `{"name":"flex","type":"FlexConfigPolicy","prependFlexConfigs":[{"id":"`+snmp+`","type":"FlexConfigObject"}],"appendFlexConfigs":[{"id":"`+wccp+`","type":"FlexConfigObject"}]}`)
+
+	resp := testUnitDataSourceRead(ctx, d, FlexConfigPreview{Domain: types.StringNull(), FlexconfigPolicyId: types.StringValue(policy), DeviceId: types.StringValue(device),
+		PrependCli: types.StringNull(), AppendCli: types.StringNull(), Unresolved: types.ListNull(types.StringType)})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to render policy: %v", resp.Diagnostics)
+	}
+	var data FlexConfigPreview
+	resp.State.Get(ctx, &data)
+
+	if v, expected := data.PrependCli.ValueString(), "snmp-server location Building 2\nsnmp-server contact a b"; v != expected {
+		t.Errorf("expected prepend cli %q, got %q", expected, v)
+	}
+	if v, expected := data.AppendCli.ValueString(), "wccp web-cache redirect-list $acl group-list 10.1.1.1"; v != expected {
+		t.Errorf("expected append cli %q, got %q", expected, v)
+	}
+	var unresolved []string
+	data.Unresolved.ElementsAs(ctx, &unresolved, false)
+	if expected := []string{"wccp/acl"}; !slices.Equal(unresolved, expected) {
+		t.Errorf("expected unresolved %v, got %v", expected, unresolved)
+	}
+}
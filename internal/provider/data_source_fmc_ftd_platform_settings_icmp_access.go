@@ -46,7 +46,8 @@ func NewFTDPlatformSettingsICMPAccessDataSource() datasource.DataSource {
 }
 
 type FTDPlatformSettingsICMPAccessDataSource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (d *FTDPlatformSettingsICMPAccessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -64,8 +65,9 @@ func (d *FTDPlatformSettingsICMPAccessDataSource) Schema(ctx context.Context, re
 				Required:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"ftd_platform_settings_id": schema.StringAttribute{
 				MarkdownDescription: "Id of the parent FTD Platform Settings.",
@@ -138,6 +140,7 @@ func (d *FTDPlatformSettingsICMPAccessDataSource) Configure(_ context.Context, r
 	}
 
 	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
 }
 
 // End of section. //template:end model
@@ -160,10 +163,15 @@ func (d *FTDPlatformSettingsICMPAccessDataSource) Read(ctx context.Context, req
 		return
 	}
 
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(config.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
@@ -0,0 +1,340 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &GroupExpansionDataSource{}
+	_ datasource.DataSourceWithConfigure = &GroupExpansionDataSource{}
+)
+
+func NewGroupExpansionDataSource() datasource.DataSource {
+	return &GroupExpansionDataSource{}
+}
+
+type GroupExpansionDataSource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (d *GroupExpansionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_expansion"
+}
+
+func (d *GroupExpansionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This data source recursively expands a Network Group, Port Group or URL Group, including nested groups and literals, into flat and deduplicated lists of CIDRs, ports or URLs. If `device_id` is set, device specific overrides of the group and of the objects it contains are used instead of their default values.\n Addresses are merged, so that e.g. a group containing `0.0.0.0/1` and `128.0.0.0/1` is reported as `0.0.0.0/0`. FQDN objects are not resolved, they are listed in `fqdns`. The result can be used in `check` blocks, e.g. to assert that a group never contains `0.0.0.0/0`.\n The expansion runs entirely in the provider, no changes are made on FMC.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the group.",
+				Required:            true,
+			},
+			"group_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the group, `NetworkGroup`, `PortObjectGroup` or `UrlGroup`.",
+				Required:            true,
+			},
+			"device_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the device, whose overrides should be used.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"cidrs": schema.ListAttribute{
+				MarkdownDescription: "Addresses of a Network Group as a minimal list of CIDRs, IPv4 before IPv6.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"fqdns": schema.ListAttribute{
+				MarkdownDescription: "FQDNs of a Network Group, sorted.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"ports": schema.ListAttribute{
+				MarkdownDescription: "Ports of a Port Group, merged and sorted. Format is `PROTOCOL/port` (e.g. `TCP/443`), `PROTOCOL/low-high` (e.g. `UDP/1024-65535`) or `PROTOCOL` for any port. ICMP is reported as `ICMP`, `ICMP/type` or `ICMP/type/code` (`ICMPV6` for ICMPv6).",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"urls": schema.ListAttribute{
+				MarkdownDescription: "URLs of a URL Group, sorted.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"unresolved": schema.ListAttribute{
+				MarkdownDescription: "Objects (as `type/id`) and literals, that could not be expanded, e.g. objects of unsupported types, invalid values or groups nested too deep.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GroupExpansionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+// End of section. //template:end model
+
+func (d *GroupExpansionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config GroupExpansion
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.GroupId.ValueString()))
+
+	if !slices.Contains(groupExpansionGroupTypes, config.GroupType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("group_type"), "Invalid Group Type",
+			fmt.Sprintf("Group type must be one of %s, got: %s", strings.Join(groupExpansionGroupTypes, ", "), config.GroupType.ValueString()))
+		return
+	}
+
+	expander := groupExpander{
+		client:     d.client,
+		reqMods:    reqMods,
+		deviceId:   config.DeviceId.ValueString(),
+		objects:    map[string]gjson.Result{},
+		expanded:   map[string]bool{config.GroupType.ValueString() + "/" + config.GroupId.ValueString(): true},
+		fqdns:      map[string]bool{},
+		urls:       map[string]bool{},
+		unresolved: map[string]bool{},
+	}
+	// Values that cannot be expanded are collected as atoms of the sets
+	expander.addresses.atoms = expander.unresolved
+	expander.services.atoms = expander.unresolved
+
+	group, _, err := expander.object(config.GroupType.ValueString(), config.GroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+	if err := expander.expand(group, 0); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to expand objects referenced by group, got error: %s", err))
+		return
+	}
+
+	body, _ := sjson.Set("", "cidrs", expander.cidrs())
+	body, _ = sjson.Set(body, "fqdns", slices.Sorted(maps.Keys(expander.fqdns)))
+	body, _ = sjson.Set(body, "ports", expander.ports())
+	body, _ = sjson.Set(body, "urls", slices.Sorted(maps.Keys(expander.urls)))
+	body, _ = sjson.Set(body, "unresolved", slices.Sorted(maps.Keys(expander.unresolved)))
+	config.fromBody(ctx, gjson.Parse(body))
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.GroupId.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// groupExpansionGroupTypes are the types of groups, that can be expanded.
+var groupExpansionGroupTypes = []string{"NetworkGroup", "PortObjectGroup", "UrlGroup"}
+
+// groupExpansionObjectPaths maps types of objects, that can be expanded, to their endpoints.
+var groupExpansionObjectPaths = map[string]string{
+	"Host":               "/object/hosts",
+	"Network":            "/object/networks",
+	"Range":              "/object/ranges",
+	"FQDN":               "/object/fqdns",
+	"NetworkGroup":       "/object/networkgroups",
+	"ProtocolPortObject": "/object/protocolportobjects",
+	"PortObjectGroup":    "/object/portobjectgroups",
+	"ICMPV4Object":       "/object/icmpv4objects",
+	"ICMPV6Object":       "/object/icmpv6objects",
+	"Url":                "/object/urls",
+	"UrlGroup":           "/object/urlgroups",
+}
+
+type groupExpander struct {
+	client     *fmc.Client
+	reqMods    []func(*fmc.Req)
+	deviceId   string
+	objects    map[string]gjson.Result
+	expanded   map[string]bool
+	addresses  conditionSet[netip.Addr]
+	services   conditionSet[servicePoint]
+	fqdns      map[string]bool
+	urls       map[string]bool
+	unresolved map[string]bool
+}
+
+// object returns the object of the given type, or false if objects of this type cannot be expanded. If a device
+// is set and the object is overridable, the override for the device is returned instead, if there is one.
+func (e *groupExpander) object(typ, id string) (gjson.Result, bool, error) {
+	path, ok := groupExpansionObjectPaths[typ]
+	if !ok {
+		return gjson.Result{}, false, nil
+	}
+	if res, ok := e.objects[typ+"/"+id]; ok {
+		return res, true, nil
+	}
+	urlPath := "/api/fmc_config/v1/domain/{DOMAIN_UUID}" + path + "/" + url.QueryEscape(id)
+	res, err := fmcObjectForDevice(e.client, urlPath, e.deviceId, e.reqMods...)
+	if err != nil {
+		return res, false, fmt.Errorf("%s %s: %s", typ, id, err)
+	}
+	e.objects[typ+"/"+id] = res
+	return res, true, nil
+}
+
+// expand adds the literals and objects of a group to the expander, expanding nested groups. As the results of all
+// groups are merged, every group is expanded only once, even if it is nested in several groups or in itself.
+func (e *groupExpander) expand(group gjson.Result, depth int) error {
+	for _, l := range group.Get("literals").Array() {
+		e.add(l, "literal/"+canonicalJSON(l))
+	}
+	for _, o := range group.Get("objects").Array() {
+		typ, id := o.Get("type").String(), o.Get("id").String()
+		if e.expanded[typ+"/"+id] {
+			continue
+		}
+		if depth >= groupExpansionMaxDepth {
+			e.unresolved[typ+"/"+id] = true
+			continue
+		}
+		obj, ok, err := e.object(typ, id)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !ok:
+			e.unresolved[typ+"/"+id] = true
+		case typ == "NetworkGroup" || typ == "PortObjectGroup" || typ == "UrlGroup":
+			e.expanded[typ+"/"+id] = true
+			if err := e.expand(obj, depth+1); err != nil {
+				return err
+			}
+		default:
+			e.add(obj, typ+"/"+id)
+		}
+	}
+	return nil
+}
+
+// groupExpansionMaxDepth limits expansion of nested groups. Objects nested deeper are reported as unresolved.
+const groupExpansionMaxDepth = 10
+
+// add adds a single object or literal, atom identifies it if it cannot be expanded.
+func (e *groupExpander) add(v gjson.Result, atom string) {
+	switch typ := v.Get("type").String(); {
+	case typ == "FQDN":
+		e.fqdns[v.Get("value").String()] = true
+	case v.Get("url").Exists():
+		e.urls[v.Get("url").String()] = true
+	case typ == "ProtocolPortObject" || typ == "PortLiteral" || typ == "ICMPV4Object" || typ == "ICMPV6Object" || v.Get("protocol").Exists():
+		addPort(&e.services, v, atom)
+	default:
+		if from, to, err := helpers.ParseAddressRange(v.Get("value").String()); err == nil {
+			e.addresses.ranges.Add(from, to)
+		} else {
+			e.unresolved[atom] = true
+		}
+	}
+}
+
+// cidrs returns the expanded addresses as a minimal list of prefixes.
+func (e *groupExpander) cidrs() []string {
+	res := []string{}
+	for _, r := range e.addresses.ranges.Ranges() {
+		for _, p := range helpers.RangePrefixes(r.From, r.To) {
+			res = append(res, p.String())
+		}
+	}
+	return res
+}
+
+// ports returns the expanded ports, split per protocol (and ICMP type).
+func (e *groupExpander) ports() []string {
+	res := []string{}
+	for _, r := range e.services.ranges.Ranges() {
+		for protocol := int(r.From >> 16); protocol <= int(r.To>>16); protocol++ {
+			name := helpers.ProtocolName(protocol)
+			from, to := max(int(r.From), protocol<<16)&0xffff, min(int(r.To), protocol<<16|0xffff)&0xffff
+			switch {
+			case from == 0 && to == 0xffff:
+				res = append(res, name)
+			case protocol == 1 || protocol == 58:
+				// ICMP type is stored in the upper byte of the port, code in the lower
+				for icmpType := from >> 8; icmpType <= to>>8; icmpType++ {
+					codeFrom, codeTo := max(from, icmpType<<8)&0xff, min(to, icmpType<<8|0xff)&0xff
+					if codeFrom == 0 && codeTo == 0xff {
+						res = append(res, fmt.Sprintf("%s/%d", name, icmpType))
+						continue
+					}
+					for code := codeFrom; code <= codeTo; code++ {
+						res = append(res, fmt.Sprintf("%s/%d/%d", name, icmpType, code))
+					}
+				}
+			case from == to:
+				res = append(res, fmt.Sprintf("%s/%d", name, from))
+			default:
+				res = append(res, fmt.Sprintf("%s/%d-%d", name, from, to))
+			}
+		}
+	}
+	return res
+}
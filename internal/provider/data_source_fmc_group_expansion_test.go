@@ -0,0 +1,158 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitGroupExpansionRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	d := &GroupExpansionDataSource{client: client}
+	device := "00000000-0000-0000-0000-0000000000d1"
+
+	read := func(groupType, id, deviceId string) GroupExpansion {
+		t.Helper()
+		null := types.ListNull(types.StringType)
+		config := GroupExpansion{Domain: types.StringNull(), GroupId: types.StringValue(id), GroupType: types.StringValue(groupType), DeviceId: types.StringNull(),
+			Cidrs: null, Fqdns: null, Ports: null, Urls: null, Unresolved: null}
+		if deviceId != "" {
+			config.DeviceId = types.StringValue(deviceId)
+		}
+		resp := testUnitDataSourceRead(ctx, d, config)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("failed to expand group: %v", resp.Diagnostics)
+		}
+		var data GroupExpansion
+		resp.State.Get(ctx, &data)
+		return data
+	}
+	list := func(v types.List) []string {
+		var res []string
+		v.ElementsAs(ctx, &res, false)
+		return res
+	}
+
+	low := m.AddObject("/object/networks", `{"name":"low","value":"0.0.0.0/1","type":"Network"}`)
+	host := m.AddObject("/object/hosts", `{"name":"host","value":"10.1.1.1","type":"Host","overridable":true}`)
+	m.AddObject("/object/hosts/"+host+"/overrides", `{"name":"host","value":"192.168.1.1","type":"Host","overrides":{"parent":{"id":"`+host+`"},"target":{"id":"`+device+`","type":"Device"}}}`)
+	hostRange := m.AddObject("/object/ranges", `{"name":"range","value":"172.16.0.1-172.16.0.6","type":"Range"}`)
+	fqdn := m.AddObject("/object/fqdns", `{"name":"fqdn","value":"example.com","type":"FQDN"}`)
+	inner := m.AddObject("/object/networkgroups", `{"name":"inner","type":"NetworkGroup","objects":[{"id":"`+host+`","type":"Host"},{"id":"`+hostRange+`","type":"Range"},{"id":"`+fqdn+`","type":"FQDN"},{"id":"dyn","type":"DynamicObject"}],"literals":[{"type":"Network","value":"2001:db8::/32"}]}`)
+	outer := m.AddObject("/object/networkgroups", `{"name":"outer","type":"NetworkGroup","objects":[{"id":"`+inner+`","type":"NetworkGroup"},{"id":"`+low+`","type":"Network"}],"literals":[{"type":"Network","value":"128.0.0.0/1"},{"type":"Host","value":"10.1.1.1"}]}`)
+
+	data := read("NetworkGroup", outer, "")
+	if v, expected := list(data.Cidrs), []string{"0.0.0.0/0", "2001:db8::/32"}; !slices.Equal(v, expected) {
+		t.Errorf("expected cidrs %v, got %v", expected, v)
+	}
+	if v, expected := list(data.Fqdns), []string{"example.com"}; !slices.Equal(v, expected) {
+		t.Errorf("expected fqdns %v, got %v", expected, v)
+	}
+	if v, expected := list(data.Unresolved), []string{"DynamicObject/dyn"}; !slices.Equal(v, expected) {
+		t.Errorf("expected unresolved %v, got %v", expected, v)
+	}
+
+	data = read("NetworkGroup", inner, device)
+	if v, expected := list(data.Cidrs), []string{"172.16.0.1/32", "172.16.0.2/31", "172.16.0.4/31", "172.16.0.6/32", "192.168.1.1/32", "2001:db8::/32"}; !slices.Equal(v, expected) {
+		t.Errorf("expected cidrs with device override %v, got %v", expected, v)
+	}
+
+	https := m.AddObject("/object/protocolportobjects", `{"name":"https","protocol":"TCP","port":"443","type":"ProtocolPortObject"}`)
+	echo := m.AddObject("/object/icmpv4objects", `{"name":"echo","icmpType":"8","type":"ICMPV4Object"}`)
+	ports := m.AddObject("/object/portobjectgroups", `{"name":"ports","type":"PortObjectGroup","objects":[{"id":"`+https+`","type":"ProtocolPortObject"},{"id":"`+echo+`","type":"ICMPV4Object"}],"literals":[{"type":"PortLiteral","protocol":"6","port":"444-450"},{"type":"PortLiteral","protocol":"17"}]}`)
+	data = read("PortObjectGroup", ports, "")
+	if v, expected := list(data.Ports), []string{"ICMP/8", "TCP/443-450", "UDP"}; !slices.Equal(v, expected) {
+		t.Errorf("expected ports %v, got %v", expected, v)
+	}
+
+	site := m.AddObject("/object/urls", `{"name":"site","url":"https://example.com","type":"Url"}`)
+	urls := m.AddObject("/object/urlgroups", `{"name":"urls","type":"UrlGroup","objects":[{"id":"`+site+`","type":"Url"}],"literals":[{"type":"Url","url":"https://example.org"},{"type":"Url","url":"https://example.com"}]}`)
+	data = read("UrlGroup", urls, "")
+	if v, expected := list(data.Urls), []string{"https://example.com", "https://example.org"}; !slices.Equal(v, expected) {
+		t.Errorf("expected urls %v, got %v", expected, v)
+	}
+}
+
+func TestUnitGroupExpansionNested(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	d := &GroupExpansionDataSource{client: client}
+
+	read := func(id string) GroupExpansion {
+		t.Helper()
+		null := types.ListNull(types.StringType)
+		config := GroupExpansion{Domain: types.StringNull(), GroupId: types.StringValue(id), GroupType: types.StringValue("NetworkGroup"), DeviceId: types.StringNull(),
+			Cidrs: null, Fqdns: null, Ports: null, Urls: null, Unresolved: null}
+		resp := testUnitDataSourceRead(ctx, d, config)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("failed to expand group: %v", resp.Diagnostics)
+		}
+		var data GroupExpansion
+		resp.State.Get(ctx, &data)
+		return data
+	}
+	list := func(v types.List) []string {
+		var res []string
+		v.ElementsAs(ctx, &res, false)
+		return res
+	}
+	group := func(name string, members ...string) string {
+		var objects []string
+		for _, id := range members {
+			objects = append(objects, `{"id":"`+id+`","type":"NetworkGroup"}`)
+		}
+		return m.AddObject("/object/networkgroups", `{"name":"`+name+`","type":"NetworkGroup","objects":[`+strings.Join(objects, ",")+`],"literals":[{"type":"Host","value":"10.1.1.1"}]}`)
+	}
+
+	// Groups nested in several groups, or in themselves, are expanded once
+	bottom := group("bottom")
+	left, right := group("left", bottom), group("right", bottom)
+	top := group("top", left, right)
+	loop := group("loop", top)
+	if _, err := client.Put("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networkgroups/"+bottom, `{"name":"bottom","type":"NetworkGroup","objects":[{"id":"`+loop+`","type":"NetworkGroup"}]}`); err != nil {
+		t.Fatalf("failed to update group: %s", err)
+	}
+	data := read(top)
+	if v, expected := list(data.Cidrs), []string{"10.1.1.1/32"}; !slices.Equal(v, expected) {
+		t.Errorf("expected cidrs %v, got %v", expected, v)
+	}
+	if v := list(data.Unresolved); len(v) != 0 {
+		t.Errorf("expected no unresolved objects, got %v", v)
+	}
+
+	// Groups nested deeper than the limit are reported as unresolved
+	id := group("chain0")
+	ids := []string{id}
+	for i := 1; i <= groupExpansionMaxDepth+1; i++ {
+		id = group(fmt.Sprintf("chain%d", i), id)
+		ids = append(ids, id)
+	}
+	data = read(id)
+	if v, expected := list(data.Unresolved), []string{"NetworkGroup/" + ids[0]}; !slices.Equal(v, expected) {
+		t.Errorf("expected unresolved %v, got %v", expected, v)
+	}
+}
@@ -19,8 +19,14 @@ package provider
 
 // Section below is generated&owned by "gen/generator.go". //template:begin imports
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -143,3 +149,34 @@ func TestAccDataSourceFmcHostsGetAll(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitHostsDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	d := &HostsDataSource{client: newMockFMCClient(t, m)}
+
+	m.AddObject("/object/hosts", `{"name":"host1","value":"10.1.1.1","type":"Host"}`)
+
+	// The model is shared with the resource, while `adopt_existing` only applies to resources
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if _, ok := schemaResp.Schema.Attributes["adopt_existing"]; ok {
+		t.Error("expected no adopt_existing attribute in the data source schema")
+	}
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	null := HostsItems{Id: types.StringNull(), Description: types.StringNull(), Overridable: types.BoolNull(), Ip: types.StringNull(), Type: types.StringNull()}
+	if diags := config.SetAttribute(ctx, path.Root("items"), map[string]HostsItems{"host1": null}); diags.HasError() {
+		t.Fatalf("failed to set config: %v", diags)
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to read hosts: %v", resp.Diagnostics)
+	}
+
+	var ip string
+	if diags := resp.State.GetAttribute(ctx, path.Root("items").AtMapKey("host1").AtName("ip"), &ip); diags.HasError() || ip != "10.1.1.1" {
+		t.Errorf("unexpected ip %q: %v", ip, diags)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &NetworkDiscoveryPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &NetworkDiscoveryPolicyDataSource{}
+)
+
+func NewNetworkDiscoveryPolicyDataSource() datasource.DataSource {
+	return &NetworkDiscoveryPolicyDataSource{}
+}
+
+type NetworkDiscoveryPolicyDataSource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (d *NetworkDiscoveryPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_discovery_policy"
+}
+
+func (d *NetworkDiscoveryPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This data source reads the Network Discovery Policy.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the object",
+				Required:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the object; this value is always 'NetworkDiscoveryPolicy'.",
+				Computed:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of discovery rules. The first rule matching a network determines what is discovered.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Whether matching traffic is used for discovery (`DISCOVER`) or is excluded from it (`EXCLUDE`).",
+							Computed:            true,
+						},
+						"network_literals": schema.SetNestedAttribute{
+							MarkdownDescription: "Set of networks the rule applies to (literally specified).",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										MarkdownDescription: "IP address or network in CIDR format.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"network_objects": schema.SetNestedAttribute{
+							MarkdownDescription: "Set of objects representing networks the rule applies to (`fmc_networks`, `fmc_network_groups`, Host or Range).",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Id of the object.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "Type of the object.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"zones": schema.SetNestedAttribute{
+							MarkdownDescription: "Set of Security Zones the rule applies to. If not set, the rule applies to all zones.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Id of the object.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"source_port_exclusions": schema.SetNestedAttribute{
+							MarkdownDescription: "Set of Port objects (`fmc_ports` or `fmc_port_groups`) representing source ports, that are excluded from discovery.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Id of the object.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "Type of the object.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"destination_port_exclusions": schema.SetNestedAttribute{
+							MarkdownDescription: "Set of Port objects (`fmc_ports` or `fmc_port_groups`) representing destination ports, that are excluded from discovery.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Id of the object.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "Type of the object.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"discover_hosts": schema.BoolAttribute{
+							MarkdownDescription: "Discover hosts. Can be set only for `DISCOVER` action.",
+							Computed:            true,
+						},
+						"discover_users": schema.BoolAttribute{
+							MarkdownDescription: "Discover users. Can be set only for `DISCOVER` action and requires `discover_hosts`.",
+							Computed:            true,
+						},
+						"discover_applications": schema.BoolAttribute{
+							MarkdownDescription: "Discover applications. Can be set only for `DISCOVER` action and requires `discover_hosts`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"capture_banners": schema.BoolAttribute{
+				MarkdownDescription: "Store header information from network traffic, that advertises server vendors and versions.",
+				Computed:            true,
+			},
+			"update_interval": schema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds at which host information is updated (e.g. when a host was last seen).",
+				Computed:            true,
+			},
+			"identity_conflict_generate_event": schema.BoolAttribute{
+				MarkdownDescription: "Generate an event, when a conflict between identity sources for the OS or server of a host occurs.",
+				Computed:            true,
+			},
+			"identity_conflict_automatic_resolution": schema.StringAttribute{
+				MarkdownDescription: "How conflicts between identity sources are resolved automatically. `IDENTITY` uses the identity from the scanner or application, `KEEP_ACTIVE` keeps the active identity.",
+				Computed:            true,
+			},
+			"data_storage_host_limit_action": schema.StringAttribute{
+				MarkdownDescription: "Action when the host limit is reached. `DROP_HOSTS` drops the hosts, that were inactive for the longest time, `DONT_INSERT_HOSTS` stops discovering new hosts.",
+				Computed:            true,
+			},
+			"data_storage_host_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time in minutes after which inactive hosts are deleted from the network map.",
+				Computed:            true,
+			},
+			"data_storage_server_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time in minutes after which inactive servers are deleted from the network map.",
+				Computed:            true,
+			},
+			"data_storage_client_application_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time in minutes after which inactive client applications are deleted from the network map.",
+				Computed:            true,
+			},
+			"logged_events": schema.SetAttribute{
+				MarkdownDescription: "Set of discovery and host input events, that are logged, e.g. `NEW_HOST`, `NEW_OS`, `HOST_IP_ADDRESS_CHANGED` or `IDENTITY_CONFLICT`. Events not in the set are not logged.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NetworkDiscoveryPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+// End of section. //template:end model
+
+// Section below is generated&owned by "gen/generator.go". //template:begin read
+
+func (d *NetworkDiscoveryPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config NetworkDiscoveryPolicy
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+	urlPath := config.getPath() + "/" + url.QueryEscape(config.Id.ValueString())
+	res, err := d.client.Get(urlPath, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		return
+	}
+
+	config.fromBody(ctx, res)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// End of section. //template:end read
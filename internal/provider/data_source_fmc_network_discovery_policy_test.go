@@ -0,0 +1,108 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccDataSource
+
+func TestAccDataSourceFmcNetworkDiscoveryPolicy(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("data.fmc_network_discovery_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "rules.0.action", "DISCOVER"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "rules.0.network_literals.0.value", "10.0.0.0/8"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "rules.0.discover_hosts", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "rules.0.discover_users", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "rules.0.discover_applications", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "capture_banners", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "update_interval", "3600"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "identity_conflict_generate_event", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "identity_conflict_automatic_resolution", "DISABLED"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "data_storage_host_limit_action", "DROP_HOSTS"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "data_storage_host_timeout", "10080"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "data_storage_server_timeout", "10080"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_network_discovery_policy.test", "data_storage_client_application_timeout", "10080"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcNetworkDiscoveryPolicyPrerequisitesConfig + testAccDataSourceFmcNetworkDiscoveryPolicyConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+// End of section. //template:end testAccDataSource
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+
+const testAccDataSourceFmcNetworkDiscoveryPolicyPrerequisitesConfig = `
+resource "fmc_network" "test" {
+  name   = "fmc_network_discovery_policy_network"
+  prefix = "10.0.0.0/8"
+}
+`
+
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccDataSourceConfig
+
+func testAccDataSourceFmcNetworkDiscoveryPolicyConfig() string {
+	config := `resource "fmc_network_discovery_policy" "test" {` + "\n"
+	config += `	rules = [{` + "\n"
+	config += `		action = "DISCOVER"` + "\n"
+	config += `		network_literals = [{` + "\n"
+	config += `			value = "10.0.0.0/8"` + "\n"
+	config += `		}]` + "\n"
+	config += `		network_objects = [{` + "\n"
+	config += `			id = fmc_network.test.id` + "\n"
+	config += `			type = fmc_network.test.type` + "\n"
+	config += `		}]` + "\n"
+	config += `		discover_hosts = true` + "\n"
+	config += `		discover_users = true` + "\n"
+	config += `		discover_applications = true` + "\n"
+	config += `	}]` + "\n"
+	config += `	capture_banners = false` + "\n"
+	config += `	update_interval = 3600` + "\n"
+	config += `	identity_conflict_generate_event = false` + "\n"
+	config += `	identity_conflict_automatic_resolution = "DISABLED"` + "\n"
+	config += `	data_storage_host_limit_action = "DROP_HOSTS"` + "\n"
+	config += `	data_storage_host_timeout = 10080` + "\n"
+	config += `	data_storage_server_timeout = 10080` + "\n"
+	config += `	data_storage_client_application_timeout = 10080` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_network_discovery_policy" "test" {
+			id = fmc_network_discovery_policy.test.id
+		}
+	`
+	return config
+}
+
+// End of section. //template:end testAccDataSourceConfig
@@ -0,0 +1,291 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ObjectHygieneDataSource{}
+	_ datasource.DataSourceWithConfigure = &ObjectHygieneDataSource{}
+)
+
+func NewObjectHygieneDataSource() datasource.DataSource {
+	return &ObjectHygieneDataSource{}
+}
+
+type ObjectHygieneDataSource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (d *ObjectHygieneDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_hygiene"
+}
+
+func (d *ObjectHygieneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This data source scans the Host, Network, Range, Port and URL objects of a domain and reports objects with duplicate values and objects, that are not referenced by any other object, policy or rule.\n Address objects are compared by the addresses they match, so that e.g. Host `10.1.1.1` and Network `10.1.1.1/32` are duplicates. Read-only (system defined) objects are ignored.\n The result can be used to clean up objects, e.g. by importing them into bulk resources.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"duplicates": schema.ListNestedAttribute{
+				MarkdownDescription: "Groups of objects with the same value, ordered by value.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Normalized value of the objects. Addresses are reported as address, CIDR or range, ports as `PROTOCOL/port` (e.g. `TCP/443`) and URLs as is.",
+							Computed:            true,
+						},
+						"objects": schema.ListNestedAttribute{
+							MarkdownDescription: "Objects with the value, ordered by name.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "Id of the object.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Name of the object.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "Type of the object, e.g. `Host`, `Network`, `Range`, `ProtocolPortObject` or `Url`.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"unused": schema.ListNestedAttribute{
+				MarkdownDescription: "Objects not referenced by any other object, grouped by type and ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Id of the object.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the object.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the object, e.g. `Host`, `Network`, `Range`, `ProtocolPortObject` or `Url`.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Normalized value of the object.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ObjectHygieneDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*FmcProviderData).Client
+	d.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+// End of section. //template:end model
+
+func (d *ObjectHygieneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ObjectHygiene
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the provider default domain, unless the data source sets its own
+	if config.Domain.IsNull() && d.defaultDomain != "" {
+		config.Domain = types.StringValue(d.defaultDomain)
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !config.Domain.IsNull() && config.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(d.client, config.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", "Object Hygiene"))
+
+	duplicates := map[string][]objectHygieneObject{}
+	unused := []objectHygieneObject{}
+	for _, path := range objectHygieneObjectPaths {
+		objects, err := d.list(path, "", reqMods)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve objects, got error: %s", err))
+			return
+		}
+		for _, v := range objects {
+			duplicates[v.Value] = append(duplicates[v.Value], v)
+		}
+
+		// Objects not referenced anywhere are filtered by FMC
+		objects, err = d.list(path, "unusedOnly:true", reqMods)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve unused objects, got error: %s", err))
+			return
+		}
+		unused = append(unused, objects...)
+	}
+
+	type duplicate struct {
+		Value   string                `json:"value"`
+		Objects []objectHygieneObject `json:"objects"`
+	}
+	res := []duplicate{}
+	for _, value := range slices.Sorted(maps.Keys(duplicates)) {
+		if objects := duplicates[value]; len(objects) > 1 {
+			slices.SortStableFunc(objects, func(a, b objectHygieneObject) int { return strings.Compare(a.Name, b.Name) })
+			res = append(res, duplicate{Value: value, Objects: objects})
+		}
+	}
+
+	body, _ := sjson.Set("", "duplicates", res)
+	body, _ = sjson.Set(body, "unused", unused)
+	config.fromBody(ctx, gjson.Parse(body))
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", "Object Hygiene"))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// objectHygieneObjectPaths are the endpoints of objects, that are scanned. Objects of a type are listed together,
+// as they have the same format of values.
+var objectHygieneObjectPaths = []string{
+	"/object/hosts",
+	"/object/networks",
+	"/object/ranges",
+	"/object/protocolportobjects",
+	"/object/urls",
+}
+
+type objectHygieneObject struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// list returns all objects at path matching the filter, ordered by name. Read-only objects are skipped.
+func (d *ObjectHygieneDataSource) list(path, filter string, reqMods []func(*fmc.Req)) ([]objectHygieneObject, error) {
+	objects := []objectHygieneObject{}
+	for offset, limit := 0, 1000; ; offset += limit {
+		query := fmt.Sprintf("?expanded=true&limit=%d&offset=%d", limit, offset)
+		if filter != "" {
+			query += "&filter=" + url.QueryEscape(filter)
+		}
+		res, err := d.client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}"+path+query, reqMods...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+		for _, v := range res.Get("items").Array() {
+			if v.Get("metadata.readOnly.state").Bool() {
+				continue
+			}
+			objects = append(objects, objectHygieneObject{
+				Id:    v.Get("id").String(),
+				Name:  v.Get("name").String(),
+				Type:  v.Get("type").String(),
+				Value: objectHygieneValue(v),
+			})
+		}
+		if !res.Get("paging.next.0").Exists() {
+			break
+		}
+	}
+	slices.SortFunc(objects, func(a, b objectHygieneObject) int { return strings.Compare(a.Name, b.Name) })
+	return objects, nil
+}
+
+// objectHygieneValue returns the normalized value of an object, so that objects matching the same traffic have
+// the same value. Values that cannot be parsed are returned as is.
+func objectHygieneValue(v gjson.Result) string {
+	switch v.Get("type").String() {
+	case "ProtocolPortObject":
+		protocol, err := helpers.ProtocolNumber(v.Get("protocol").String())
+		if err != nil {
+			return v.Get("protocol").String() + "/" + v.Get("port").String()
+		}
+		if v.Get("port").String() == "" {
+			return helpers.ProtocolName(protocol)
+		}
+		low, high, err := helpers.ParsePortRange(v.Get("port").String())
+		switch {
+		case err != nil:
+			return helpers.ProtocolName(protocol) + "/" + v.Get("port").String()
+		case low == high:
+			return fmt.Sprintf("%s/%d", helpers.ProtocolName(protocol), low)
+		}
+		return fmt.Sprintf("%s/%d-%d", helpers.ProtocolName(protocol), low, high)
+	case "Url":
+		return strings.TrimSpace(v.Get("url").String())
+	}
+	from, to, err := helpers.ParseAddressRange(v.Get("value").String())
+	if err != nil {
+		return v.Get("value").String()
+	}
+	if prefixes := helpers.RangePrefixes(from, to); from == to {
+		return from.String()
+	} else if len(prefixes) == 1 {
+		return prefixes[0].String()
+	}
+	return from.String() + "-" + to.String()
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitObjectHygieneRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	d := &ObjectHygieneDataSource{client: client}
+
+	host := m.AddObject("/object/hosts", `{"name":"host_b","value":"10.1.1.1","type":"Host"}`)
+	network := m.AddObject("/object/networks", `{"name":"host_a","value":"10.1.1.1/32","type":"Network"}`)
+	hostRange := m.AddObject("/object/ranges", `{"name":"range","value":"10.1.1.1-10.1.1.1","type":"Range"}`)
+	m.AddObject("/object/networks", `{"name":"any-ipv4","value":"0.0.0.0/0","type":"Network","metadata":{"readOnly":{"state":true}}}`)
+	m.AddObject("/object/networks", `{"name":"any","value":"0.0.0.0/0","type":"Network","metadata":{"readOnly":{"state":true}}}`)
+	m.AddObject("/object/networks", `{"name":"net","value":"10.2.0.0/16","type":"Network"}`)
+	https := m.AddObject("/object/protocolportobjects", `{"name":"https","protocol":"TCP","port":"443","type":"ProtocolPortObject"}`)
+	tls := m.AddObject("/object/protocolportobjects", `{"name":"tls","protocol":"6","port":"443-443","type":"ProtocolPortObject"}`)
+	m.AddObject("/object/urls", `{"name":"site","url":"https://example.com","type":"Url"}`)
+	m.AddObject("/object/networkgroups", `{"name":"group","type":"NetworkGroup","objects":[{"id":"`+network+`","type":"Network"}]}`)
+	m.AddObject("/object/portobjectgroups", `{"name":"ports","type":"PortObjectGroup","objects":[{"id":"`+https+`","type":"ProtocolPortObject"}]}`)
+
+	resp := testUnitDataSourceRead(ctx, d, ObjectHygiene{Domain: types.StringNull()})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to read object hygiene: %v", resp.Diagnostics)
+	}
+	var data ObjectHygiene
+	resp.State.Get(ctx, &data)
+
+	var duplicates []string
+	for _, v := range data.Duplicates {
+		var objects []string
+		for _, o := range v.Objects {
+			objects = append(objects, o.Type.ValueString()+"/"+o.Name.ValueString()+"/"+o.Id.ValueString())
+		}
+		duplicates = append(duplicates, v.Value.ValueString()+": "+strings.Join(objects, ","))
+	}
+	expected := []string{
+		"10.1.1.1: Network/host_a/" + network + ",Host/host_b/" + host + ",Range/range/" + hostRange,
+		"TCP/443: ProtocolPortObject/https/" + https + ",ProtocolPortObject/tls/" + tls,
+	}
+	if !slices.Equal(duplicates, expected) {
+		t.Errorf("expected duplicates %v, got %v", expected, duplicates)
+	}
+
+	var unused []string
+	for _, v := range data.Unused {
+		unused = append(unused, v.Type.ValueString()+"/"+v.Name.ValueString()+"="+v.Value.ValueString())
+	}
+	expected = []string{"Host/host_b=10.1.1.1", "Network/net=10.2.0.0/16", "Range/range=10.1.1.1", "ProtocolPortObject/tls=TCP/443", "Url/site=https://example.com"}
+	if !slices.Equal(unused, expected) {
+		t.Errorf("expected unused %v, got %v", expected, unused)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitObjectUsageRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	d := &ObjectUsageDataSource{client: client}
+
+	host := m.AddObject("/object/hosts", `{"name":"host","value":"10.1.1.1","type":"Host"}`)
+	m.AddObject("/object/hosts", `{"name":"unused","value":"10.1.1.2","type":"Host"}`)
+	group := m.AddObject("/object/networkgroups", `{"name":"group","type":"NetworkGroup","objects":[{"id":"`+host+`","type":"Host"}]}`)
+	acp := m.AddObject("/policy/accesspolicies", `{"name":"acp","type":"AccessPolicy"}`)
+	rule := m.AddObject("/policy/accesspolicies/"+acp+"/accessrules", `{"name":"rule","type":"AccessRule","sourceNetworks":{"objects":[{"id":"`+host+`","type":"Host"}]}}`)
+
+	resp := testUnitDataSourceRead(ctx, d, ObjectUsage{Domain: types.StringNull(), ObjectId: types.StringValue(host), ObjectType: types.StringValue("Host")})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to read object usage: %v", resp.Diagnostics)
+	}
+	var data ObjectUsage
+	resp.State.Get(ctx, &data)
+
+	var references []string
+	for _, v := range data.References {
+		references = append(references, fmt.Sprintf("%s:%s:%s:%s:%s:%s", v.Id.ValueString(), v.Name.ValueString(), v.Type.ValueString(), v.ParentId.ValueString(), v.ParentName.ValueString(), v.ParentType.ValueString()))
+	}
+	expected := []string{
+		group + ":group:NetworkGroup:::",
+		rule + ":rule:AccessRule:" + acp + ":acp:AccessPolicy",
+	}
+	slices.Sort(references)
+	slices.Sort(expected)
+	if !slices.Equal(references, expected) {
+		t.Errorf("expected references %v, got %v", expected, references)
+	}
+}
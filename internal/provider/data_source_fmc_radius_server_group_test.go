@@ -19,8 +19,14 @@ package provider
 
 // Section below is generated&owned by "gen/generator.go". //template:begin imports
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -128,3 +134,36 @@ func testAccNamedDataSourceFmcRadiusServerGroupConfig() string {
 }
 
 // End of section. //template:end testAccDataSourceConfig
+
+func TestUnitRadiusServerGroupDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	d := &RadiusServerGroupDataSource{client: newMockFMCClient(t, m)}
+
+	id := m.AddObject("/object/radiusservergroups", `{"name":"radius","type":"RadiusServerGroup","radiusServers":[{"host":"10.10.10.10","authenticationPort":1812}]}`)
+
+	// The model is shared with the resource, while write-only attributes are not part of the data source schema
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	servers := schemaResp.Schema.Attributes["radius_servers"].(schema.ListNestedAttribute).NestedObject.Attributes
+	for _, v := range []string{"key_wo", "key_wo_version"} {
+		if _, ok := servers[v]; ok {
+			t.Errorf("expected no %s attribute in the data source schema", v)
+		}
+	}
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := config.SetAttribute(ctx, path.Root("id"), id); diags.HasError() {
+		t.Fatalf("failed to set config: %v", diags)
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to read RADIUS server group: %v", resp.Diagnostics)
+	}
+
+	var hostname string
+	if diags := resp.State.GetAttribute(ctx, path.Root("radius_servers").AtListIndex(0).AtName("hostname"), &hostname); diags.HasError() || hostname != "10.10.10.10" {
+		t.Errorf("unexpected hostname %q: %v", hostname, diags)
+	}
+}
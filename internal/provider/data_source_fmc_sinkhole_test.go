@@ -0,0 +1,94 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccDataSource
+
+func TestAccDataSourceFmcSinkhole(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_sinkhole.test", "name", "my_sinkhole"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_sinkhole.test", "description", "My Sinkhole object"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("data.fmc_sinkhole.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_sinkhole.test", "ipv4_address", "10.10.10.10"))
+	checks = append(checks, resource.TestCheckResourceAttr("data.fmc_sinkhole.test", "ipv6_address", "2001:db8::10"))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFmcSinkholeConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+			{
+				Config: testAccNamedDataSourceFmcSinkholeConfig(),
+				Check:  resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+// End of section. //template:end testAccDataSource
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccDataSourceConfig
+
+func testAccDataSourceFmcSinkholeConfig() string {
+	config := `resource "fmc_sinkhole" "test" {` + "\n"
+	config += `	name = "my_sinkhole"` + "\n"
+	config += `	description = "My Sinkhole object"` + "\n"
+	config += `	ipv4_address = "10.10.10.10"` + "\n"
+	config += `	ipv6_address = "2001:db8::10"` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_sinkhole" "test" {
+			id = fmc_sinkhole.test.id
+		}
+	`
+	return config
+}
+
+func testAccNamedDataSourceFmcSinkholeConfig() string {
+	config := `resource "fmc_sinkhole" "test" {` + "\n"
+	config += `	name = "my_sinkhole"` + "\n"
+	config += `	description = "My Sinkhole object"` + "\n"
+	config += `	ipv4_address = "10.10.10.10"` + "\n"
+	config += `	ipv6_address = "2001:db8::10"` + "\n"
+	config += `}` + "\n"
+
+	config += `
+		data "fmc_sinkhole" "test" {
+			name = fmc_sinkhole.test.name
+		}
+	`
+	return config
+}
+
+// End of section. //template:end testAccDataSourceConfig
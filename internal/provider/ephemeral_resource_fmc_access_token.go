@@ -0,0 +1,192 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ ephemeral.EphemeralResource              = &AccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &AccessTokenEphemeralResource{}
+)
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+type AccessTokenEphemeralResource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+type AccessToken struct {
+	Domain       types.String `tfsdk:"domain"`
+	Url          types.String `tfsdk:"url"`
+	Cdfmc        types.Bool   `tfsdk:"cdfmc"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	DomainUuid   types.String `tfsdk:"domain_uuid"`
+	Domains      types.Map    `tfsdk:"domains"`
+}
+
+func (r *AccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This ephemeral resource returns an FMC REST API access token using the provider credentials, for use by tools outside Terraform. Nothing is stored in the state.\n For FMC a new token is generated, it expires after 30 minutes and can be refreshed up to three times with the refresh token. For cdFMC the API token of the provider is returned.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain, for which `domain_uuid` is returned. Defaults to the provider `domain`.",
+				Optional:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the REST API, as used by the provider. For cdFMC behind Security Cloud Control this includes the `/v1/cdfmc` suffix.",
+				Computed:            true,
+			},
+			"cdfmc": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider is connected to cdFMC. cdFMC expects the token in the `Authorization: Bearer` header, FMC in the `X-auth-access-token` header.",
+				Computed:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Access token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "Refresh token. Not set for cdFMC.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"domain_uuid": schema.StringAttribute{
+				MarkdownDescription: "UUID of the selected domain, or of the login domain if no domain is selected.",
+				Computed:            true,
+			},
+			"domains": schema.MapAttribute{
+				MarkdownDescription: "Map of domain names to domain UUIDs, which are accessible with the token.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AccessToken
+
+	// Read config
+	diags := req.Config.Get(ctx, &data)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Beginning Open of access token")
+
+	domains := map[string]string{}
+	var domainUuid string
+	if r.client.IsCDFMC {
+		// cdFMC authenticates with the fixed API token the provider was configured with
+		data.AccessToken = types.StringValue(r.client.Pwd)
+		data.RefreshToken = types.StringNull()
+		domainUuid = r.client.DomainUUID
+		for k, v := range fmcDomains(r.client) {
+			domains[k] = v
+		}
+	} else {
+		// Generate a new token, so that the session of the provider is not affected by its use
+		req, err := r.client.NewReq("POST", "/api/fmc_platform/v1/auth/generatetoken", strings.NewReader(""), fmc.NoLogPayload)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to create token request, got error: %s", err))
+			return
+		}
+		req.HttpReq.Header.Add("User-Agent", r.client.UserAgent)
+		req.HttpReq.SetBasicAuth(r.client.Usr, r.client.Pwd)
+		r.client.RateLimiterBucket.Wait(1)
+		res, err := r.client.HttpClient.Do(req.HttpReq.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to generate access token, got error: %s", err))
+			return
+		}
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		if res.StatusCode != 204 || len(body) > 0 {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to generate access token, status code: %d, %s", res.StatusCode, string(body)))
+			return
+		}
+
+		data.AccessToken = types.StringValue(res.Header.Get("X-auth-access-token"))
+		data.RefreshToken = types.StringValue(res.Header.Get("X-auth-refresh-token"))
+		domainUuid = res.Header.Get("DOMAIN_UUID")
+		gjson.Parse(res.Header.Get("DOMAINS")).ForEach(func(_, v gjson.Result) bool {
+			domains[v.Get("name").String()] = v.Get("uuid").String()
+			return true
+		})
+	}
+
+	domain := data.Domain.ValueString()
+	if domain == "" {
+		domain = r.defaultDomain
+	}
+	if domain != "" {
+		id, ok := domains[domain]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("domain"), "Invalid domain", fmt.Sprintf("Domain %q not found", domain))
+			return
+		}
+		domainUuid = id
+	}
+
+	data.Url = types.StringValue(r.client.Url)
+	data.Cdfmc = types.BoolValue(r.client.IsCDFMC)
+	data.DomainUuid = types.StringValue(domainUuid)
+	data.Domains, diags = types.MapValueFrom(ctx, types.StringType, domains)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Open of access token finished successfully")
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/go-fmc"
+)
+
+func TestUnitAccessTokenOpen(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	subId := m.AddDomain("Global/Sub")
+	r := &AccessTokenEphemeralResource{client: client, defaultDomain: "Global/Sub"}
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+	open := func(domain types.String) (AccessToken, ephemeral.OpenResponse) {
+		config := tfsdk.State{Schema: schemaResp.Schema}
+		config.Set(ctx, AccessToken{Domain: domain, Domains: types.MapNull(types.StringType)})
+		resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema}}
+		r.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+		var data AccessToken
+		if !resp.Diagnostics.HasError() {
+			resp.Result.Get(ctx, &data)
+		}
+		return data, resp
+	}
+
+	data, resp := open(types.StringNull())
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to open access token: %v", resp.Diagnostics)
+	}
+	if data.Url.ValueString() != m.URL || data.Cdfmc.ValueBool() {
+		t.Errorf("unexpected url %s, cdfmc %v", data.Url, data.Cdfmc)
+	}
+	if data.DomainUuid.ValueString() != subId {
+		t.Errorf("expected default domain UUID %s, got %s", subId, data.DomainUuid)
+	}
+	if v, ok := data.Domains.Elements()["Global"]; !ok || v.(types.String).ValueString() != mockFMCGlobalDomainUUID {
+		t.Errorf("unexpected domains %v", data.Domains)
+	}
+	if data.RefreshToken.ValueString() == "" || !m.tokens[data.AccessToken.ValueString()] {
+		t.Errorf("expected a valid access token")
+	}
+
+	if _, resp := open(types.StringValue("Unknown")); !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error for an unknown domain")
+	}
+
+	// cdFMC returns the API token of the provider, cdFMC has the Global domain only
+	delete(m.Domains, "Global/Sub")
+	cdfmc, err := fmc.NewClientCDFMC(m.URL, data.AccessToken.ValueString(), fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create cdFMC client: %s", err)
+	}
+	r.client = &cdfmc
+	if data, resp = open(types.StringValue("Global")); resp.Diagnostics.HasError() {
+		t.Fatalf("failed to open cdFMC access token: %v", resp.Diagnostics)
+	}
+	if !data.Cdfmc.ValueBool() || data.AccessToken.ValueString() != cdfmc.Pwd || !data.RefreshToken.IsNull() || data.DomainUuid.ValueString() != mockFMCGlobalDomainUUID {
+		t.Errorf("unexpected cdFMC access token %+v", data)
+	}
+}
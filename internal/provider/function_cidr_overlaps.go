@@ -0,0 +1,84 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &CidrOverlapsFunction{}
+
+func NewCidrOverlapsFunction() function.Function {
+	return &CidrOverlapsFunction{}
+}
+
+type CidrOverlapsFunction struct{}
+
+func (f *CidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f *CidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Check whether two networks overlap",
+		MarkdownDescription: "Returns `true` if the two networks have at least one address in common. Each network can be an IP address (`10.1.1.1`), a prefix (`10.1.0.0/16`) or a range (`10.1.1.1-10.1.1.9`), as used by Host, Network and Range objects. An IPv4 and an IPv6 network never overlap.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "network",
+				MarkdownDescription: "IP address, prefix or range.",
+			},
+			function.StringParameter{
+				Name:                "other_network",
+				MarkdownDescription: "IP address, prefix or range to compare with.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *CidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var network, otherNetwork string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &network, &otherNetwork))
+	if resp.Error != nil {
+		return
+	}
+
+	from, to, err := helpers.ParseAddressRange(network)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid network %q: %s", network, err))
+		return
+	}
+	otherFrom, otherTo, err := helpers.ParseAddressRange(otherNetwork)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid network %q: %s", otherNetwork, err))
+		return
+	}
+
+	var a, b helpers.RangeSet[netip.Addr]
+	a.Add(from, to)
+	b.Add(otherFrom, otherTo)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, a.Overlaps(b)))
+}
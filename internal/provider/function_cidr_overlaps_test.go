@@ -0,0 +1,34 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitCidrOverlapsFunction(t *testing.T) {
+	testUnitFunction(t, NewCidrOverlapsFunction(), []testUnitFunctionCase{
+		{[]string{"10.0.0.0/16", "10.0.255.1-10.1.0.1"}, types.BoolValue(true)},
+		{[]string{"10.0.0.0/16", "10.1.0.0/16"}, types.BoolValue(false)},
+		{[]string{"10.0.0.1", "::ffff:10.0.0.1"}, types.BoolValue(true)},
+		{[]string{"0.0.0.0/0", "::/0"}, types.BoolValue(false)},
+		{[]string{"10.0.0.0/16", "foo"}, nil},
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &ParsePortSpecFunction{}
+
+func NewParsePortSpecFunction() function.Function {
+	return &ParsePortSpecFunction{}
+}
+
+type ParsePortSpecFunction struct{}
+
+var portSpecAttributeTypes = map[string]attr.Type{
+	"protocol": types.StringType,
+	"port":     types.StringType,
+}
+
+func (f *ParsePortSpecFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_port_spec"
+}
+
+func (f *ParsePortSpecFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a port specification",
+		MarkdownDescription: "Parses a port specification such as `tcp/443`, `udp/1024-65535`, `6/22` or `icmp` and returns an object with the `protocol` and `port` attributes of a Port object. Known protocol names are returned in upper case, `port` is null if the specification has no port.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "spec",
+				MarkdownDescription: "Port specification in the `<protocol>[/<port>[-<port>]]` format.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: portSpecAttributeTypes,
+		},
+	}
+}
+
+func (f *ParsePortSpecFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var spec string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &spec))
+	if resp.Error != nil {
+		return
+	}
+
+	protocol, port, err := helpers.ParsePortSpec(spec)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid port specification: %s", err))
+		return
+	}
+
+	portValue := types.StringNull()
+	if port != "" {
+		portValue = types.StringValue(port)
+	}
+	result, diags := types.ObjectValue(portSpecAttributeTypes, map[string]attr.Value{
+		"protocol": types.StringValue(protocol),
+		"port":     portValue,
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,36 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitParsePortSpecFunction(t *testing.T) {
+	portSpec := func(protocol string, port attr.Value) attr.Value {
+		return types.ObjectValueMust(portSpecAttributeTypes, map[string]attr.Value{"protocol": types.StringValue(protocol), "port": port})
+	}
+	testUnitFunction(t, NewParsePortSpecFunction(), []testUnitFunctionCase{
+		{[]string{"tcp/443"}, portSpec("TCP", types.StringValue("443"))},
+		{[]string{"icmp"}, portSpec("ICMP", types.StringNull())},
+		{[]string{"tcp/http"}, nil},
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &RangeToCidrsFunction{}
+
+func NewRangeToCidrsFunction() function.Function {
+	return &RangeToCidrsFunction{}
+}
+
+type RangeToCidrsFunction struct{}
+
+func (f *RangeToCidrsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "range_to_cidrs"
+}
+
+func (f *RangeToCidrsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert an IP address range to prefixes",
+		MarkdownDescription: "Returns the smallest list of prefixes (CIDRs) covering exactly the addresses of an IP address range (`10.0.0.1-10.0.0.20`). An IP address or prefix is also accepted and returned as a single prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "range",
+				MarkdownDescription: "IP address range, such as the `ip_range` of a Range object.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *RangeToCidrsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	from, to, err := helpers.ParseAddressRange(value)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid range %q: %s", value, err))
+		return
+	}
+
+	cidrs := []string{}
+	for _, prefix := range helpers.RangePrefixes(from, to) {
+		cidrs = append(cidrs, prefix.String())
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cidrs))
+}
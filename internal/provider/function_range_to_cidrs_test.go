@@ -0,0 +1,34 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUnitRangeToCidrsFunction(t *testing.T) {
+	testUnitFunction(t, NewRangeToCidrsFunction(), []testUnitFunctionCase{
+		{[]string{"10.0.0.1-10.0.0.4"}, types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("10.0.0.1/32"), types.StringValue("10.0.0.2/31"), types.StringValue("10.0.0.4/32"),
+		})},
+		{[]string{"10.0.0.9-10.0.0.1"}, nil},
+	})
+}
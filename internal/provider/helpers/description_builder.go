@@ -73,8 +73,12 @@ func (d *AttributeDescription) AddMinimumVersionBulkDeleteDescription(minimumVer
 	return d
 }
 
-func (d *AttributeDescription) AddMinimumVersionBulkUpdateDescription() *AttributeDescription {
-	d.String = fmt.Sprintf("%s\n  - Updates are always done one-by-one.", d.String)
+func (d *AttributeDescription) AddMinimumVersionBulkUpdateDescription(minimumVersion string) *AttributeDescription {
+	if minimumVersion == "" {
+		d.String = fmt.Sprintf("%s\n  - Updates are always done one-by-one.", d.String)
+	} else {
+		d.String = fmt.Sprintf("%s\n  - Minimum FMC version for bulk object update: `%s`", d.String, minimumVersion)
+	}
 	return d
 }
 
@@ -0,0 +1,63 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import "sync"
+
+// KeyedMutex is a set of mutexes identified by a key, e.g. an object ID, so that read-modify-write
+// operations on the same object are serialized while operations on different objects run in parallel.
+// The zero value is ready to use.
+type KeyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedMutexEntry
+}
+
+type keyedMutexEntry struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// Lock locks the mutex identified by key.
+func (m *KeyedMutex) Lock(key string) {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = make(map[string]*keyedMutexEntry)
+	}
+	entry, ok := m.locks[key]
+	if !ok {
+		entry = &keyedMutexEntry{}
+		m.locks[key] = entry
+	}
+	entry.refs++
+	m.mu.Unlock()
+
+	entry.mu.Lock()
+}
+
+// Unlock unlocks the mutex identified by key.
+func (m *KeyedMutex) Unlock(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := m.locks[key]
+	entry.refs--
+	if entry.refs == 0 {
+		delete(m.locks, key)
+	}
+	entry.mu.Unlock()
+}
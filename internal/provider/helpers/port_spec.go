@@ -0,0 +1,106 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// protocolNames maps protocol names accepted by FMC to IP protocol numbers.
+var protocolNames = map[string]int{
+	"ICMP":      1,
+	"TCP":       6,
+	"UDP":       17,
+	"GRE":       47,
+	"ESP":       50,
+	"AH":        51,
+	"IPV6-ICMP": 58,
+	"ICMPV6":    58,
+	"SCTP":      132,
+}
+
+// ProtocolNumber returns the IP protocol number for a protocol name or number.
+func ProtocolNumber(protocol string) (int, error) {
+	if n, ok := protocolNames[strings.ToUpper(strings.TrimSpace(protocol))]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(protocol))
+	if err == nil && (n < 0 || n > 0xff) {
+		err = fmt.Errorf("invalid protocol number %d", n)
+	}
+	return n, err
+}
+
+// ProtocolName returns the name of an IP protocol number as used by FMC, or the number itself if the protocol has no name.
+func ProtocolName(protocol int) string {
+	switch protocol {
+	case 58:
+		return "ICMPV6"
+	}
+	for name, n := range protocolNames {
+		if n == protocol {
+			return name
+		}
+	}
+	return strconv.Itoa(protocol)
+}
+
+// ParsePortRange parses a port ("443") or port range ("1024-65535") and returns the first and last port.
+func ParsePortRange(port string) (low, high int, err error) {
+	a, b, isRange := strings.Cut(port, "-")
+	if !isRange {
+		b = a
+	}
+	if low, err = strconv.Atoi(strings.TrimSpace(a)); err != nil {
+		return low, high, fmt.Errorf("invalid port %q", port)
+	}
+	if high, err = strconv.Atoi(strings.TrimSpace(b)); err != nil {
+		return low, high, fmt.Errorf("invalid port %q", port)
+	}
+	if low < 0 || high > 0xffff || low > high {
+		return low, high, fmt.Errorf("invalid port %q", port)
+	}
+	return low, high, nil
+}
+
+// ParsePortSpec parses a port specification such as "tcp/443", "UDP/1024-65535", "6/22" or "icmp" into the
+// protocol and port of a Port object. Known protocol names are returned in upper case, the port is empty
+// if the specification has none.
+func ParsePortSpec(spec string) (protocol, port string, err error) {
+	protocol, port, hasPort := strings.Cut(strings.TrimSpace(spec), "/")
+	protocol = strings.TrimSpace(protocol)
+	if _, err := ProtocolNumber(protocol); err != nil {
+		return "", "", fmt.Errorf("invalid protocol in %q", spec)
+	}
+	if _, ok := protocolNames[strings.ToUpper(protocol)]; ok {
+		protocol = strings.ToUpper(protocol)
+	}
+	if !hasPort {
+		return protocol, "", nil
+	}
+	low, high, err := ParsePortRange(port)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid port in %q", spec)
+	}
+	if low == high {
+		return protocol, strconv.Itoa(low), nil
+	}
+	return protocol, fmt.Sprintf("%d-%d", low, high), nil
+}
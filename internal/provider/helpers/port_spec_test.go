@@ -0,0 +1,57 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"testing"
+)
+
+func TestParsePortSpec(t *testing.T) {
+	cases := []struct {
+		spec, protocol, port string
+	}{
+		{"tcp/443", "TCP", "443"},
+		{" UDP / 1024 - 65535 ", "UDP", "1024-65535"},
+		{"6/22", "6", "22"},
+		{"icmp", "ICMP", ""},
+		{"udp/53-53", "UDP", "53"},
+	}
+	for _, c := range cases {
+		protocol, port, err := ParsePortSpec(c.spec)
+		if err != nil || protocol != c.protocol || port != c.port {
+			t.Errorf("%q: expected %s %s, got %s %s (%v)", c.spec, c.protocol, c.port, protocol, port, err)
+		}
+	}
+
+	for _, v := range []string{"", "foo/80", "tcp/", "tcp/70000", "tcp/90-80", "256/80"} {
+		if _, _, err := ParsePortSpec(v); err == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+}
+
+func TestProtocolName(t *testing.T) {
+	for n, name := range map[int]string{1: "ICMP", 6: "TCP", 17: "UDP", 58: "ICMPV6", 132: "SCTP", 8: "8"} {
+		if v := ProtocolName(n); v != name {
+			t.Errorf("%d: expected %s, got %s", n, name, v)
+		}
+		if v, err := ProtocolNumber(name); err != nil || v != n {
+			t.Errorf("%s: expected %d, got %d (%v)", name, n, v, err)
+		}
+	}
+}
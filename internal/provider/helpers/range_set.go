@@ -0,0 +1,182 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// RangeValue is a value that can be stored in a RangeSet, such as netip.Addr.
+type RangeValue[T any] interface {
+	Compare(T) int
+	Next() T
+}
+
+// Range is a closed range of values.
+type Range[T RangeValue[T]] struct {
+	From, To T
+}
+
+// RangeSet is a set of values stored as sorted, non-overlapping and non-adjacent closed ranges.
+// The zero value is an empty set.
+type RangeSet[T RangeValue[T]] struct {
+	ranges []Range[T]
+}
+
+// Add adds all values between from and to (inclusive) to the set.
+func (s *RangeSet[T]) Add(from, to T) {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	s.ranges = append(s.ranges, Range[T]{From: from, To: to})
+	slices.SortFunc(s.ranges, func(a, b Range[T]) int { return a.From.Compare(b.From) })
+
+	merged := s.ranges[:1]
+	for _, r := range s.ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.From.Compare(last.To) <= 0 || r.From.Compare(last.To.Next()) == 0 {
+			if r.To.Compare(last.To) > 0 {
+				last.To = r.To
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	s.ranges = merged
+}
+
+// AddSet adds all values of o to the set.
+func (s *RangeSet[T]) AddSet(o RangeSet[T]) {
+	for _, r := range o.ranges {
+		s.Add(r.From, r.To)
+	}
+}
+
+// Ranges returns the sorted ranges of the set.
+func (s RangeSet[T]) Ranges() []Range[T] {
+	return slices.Clone(s.ranges)
+}
+
+// IsEmpty reports whether the set has no values.
+func (s RangeSet[T]) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Contains reports whether all values of o are in the set.
+func (s RangeSet[T]) Contains(o RangeSet[T]) bool {
+	for _, r := range o.ranges {
+		i, _ := slices.BinarySearchFunc(s.ranges, r.From, func(a Range[T], v T) int { return a.To.Compare(v) })
+		if i == len(s.ranges) || s.ranges[i].From.Compare(r.From) > 0 || s.ranges[i].To.Compare(r.To) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Overlaps reports whether the set and o have at least one value in common.
+func (s RangeSet[T]) Overlaps(o RangeSet[T]) bool {
+	for i, j := 0, 0; i < len(s.ranges) && j < len(o.ranges); {
+		a, b := s.ranges[i], o.ranges[j]
+		if a.To.Compare(b.From) < 0 {
+			i++
+		} else if b.To.Compare(a.From) < 0 {
+			j++
+		} else {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseAddressRange parses an IP address ("10.1.1.1"), prefix ("10.1.0.0/16") or range ("10.1.1.1-10.1.1.9")
+// and returns the first and last address it covers. Prefixes with host bits set are masked.
+func ParseAddressRange(value string) (from, to netip.Addr, err error) {
+	value = strings.TrimSpace(value)
+	if a, b, ok := strings.Cut(value, "-"); ok {
+		if from, err = netip.ParseAddr(strings.TrimSpace(a)); err != nil {
+			return from, to, err
+		}
+		if to, err = netip.ParseAddr(strings.TrimSpace(b)); err != nil {
+			return from, to, err
+		}
+		from, to = from.Unmap(), to.Unmap()
+		if from.Is4() != to.Is4() || from.Compare(to) > 0 {
+			return from, to, fmt.Errorf("invalid address range %q", value)
+		}
+		return from, to, nil
+	}
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return from, to, err
+		}
+		prefix = prefix.Masked()
+		return prefix.Addr(), LastAddress(prefix), nil
+	}
+	from, err = netip.ParseAddr(value)
+	return from.Unmap(), from.Unmap(), err
+}
+
+// LastAddress returns the last address of the prefix.
+func LastAddress(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+// RangePrefixes returns the smallest list of prefixes covering exactly the addresses from (inclusive) to to (inclusive).
+func RangePrefixes(from, to netip.Addr) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for from.IsValid() && from.Compare(to) <= 0 {
+		prefix := netip.PrefixFrom(from, from.BitLen())
+		for bits := from.BitLen() - 1; bits >= 0; bits-- {
+			p := netip.PrefixFrom(from, bits)
+			if p.Masked().Addr() != from || LastAddress(p).Compare(to) > 0 {
+				break
+			}
+			prefix = p
+		}
+		prefixes = append(prefixes, prefix)
+		// Next is invalid after the last address of the family, which ends the loop
+		from = LastAddress(prefix).Next()
+	}
+	return prefixes
+}
+
+// NormalizeAddressRange returns the canonical form of an IP address, prefix or range, as accepted by ParseAddressRange:
+// IPv6 addresses are compressed and lower case, IPv4-mapped IPv6 addresses are unmapped and prefixes are masked.
+func NormalizeAddressRange(value string) (string, error) {
+	from, to, err := ParseAddressRange(value)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case strings.Contains(value, "-"):
+		return from.String() + "-" + to.String(), nil
+	case strings.Contains(value, "/"):
+		prefix := RangePrefixes(from, to)[0]
+		return prefix.String(), nil
+	}
+	return from.String(), nil
+}
@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func addressSet(t *testing.T, values ...string) RangeSet[netip.Addr] {
+	t.Helper()
+	var s RangeSet[netip.Addr]
+	for _, v := range values {
+		from, to, err := ParseAddressRange(v)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %s", v, err)
+		}
+		s.Add(from, to)
+	}
+	return s
+}
+
+func TestParseAddressRange(t *testing.T) {
+	cases := []struct {
+		value, from, to string
+	}{
+		{"10.1.1.1", "10.1.1.1", "10.1.1.1"},
+		{"10.1.1.1/24", "10.1.1.0", "10.1.1.255"},
+		{"0.0.0.0/0", "0.0.0.0", "255.255.255.255"},
+		{" 10.1.1.5 - 10.1.1.9 ", "10.1.1.5", "10.1.1.9"},
+		{"2001:db8::/126", "2001:db8::", "2001:db8::3"},
+		{"::ffff:10.1.1.1", "10.1.1.1", "10.1.1.1"},
+	}
+	for _, c := range cases {
+		from, to, err := ParseAddressRange(c.value)
+		if err != nil || from.String() != c.from || to.String() != c.to {
+			t.Errorf("%q: expected %s-%s, got %s-%s (%v)", c.value, c.from, c.to, from, to, err)
+		}
+	}
+
+	for _, v := range []string{"", "10.1.1", "10.1.1.9-10.1.1.1", "10.1.1.1-2001:db8::1", "10.1.1.0/33"} {
+		if _, _, err := ParseAddressRange(v); err == nil {
+			t.Errorf("%q: expected error", v)
+		}
+	}
+}
+
+func TestRangeSet(t *testing.T) {
+	s := addressSet(t, "10.1.2.0/24", "10.1.1.0/24", "10.1.4.0-10.1.4.9", "255.255.255.255", "2001:db8::/64")
+	if n := len(s.Ranges()); n != 4 {
+		t.Errorf("expected adjacent ranges to be merged into 4 ranges, got %v", s.Ranges())
+	}
+
+	cases := []struct {
+		name     string
+		other    []string
+		contains bool
+		overlaps bool
+	}{
+		{"merged ranges", []string{"10.1.1.128-10.1.2.10"}, true, true},
+		{"multiple ranges", []string{"10.1.1.1", "10.1.4.9", "2001:db8::1"}, true, true},
+		{"partial", []string{"10.1.4.0/24"}, false, true},
+		{"gap", []string{"10.1.3.1"}, false, false},
+		{"other family", []string{"::a01:101"}, false, false},
+		{"last address", []string{"255.255.255.255"}, true, true},
+		{"empty", nil, true, false},
+	}
+	for _, c := range cases {
+		o := addressSet(t, c.other...)
+		if s.Contains(o) != c.contains {
+			t.Errorf("%s: expected contains %v", c.name, c.contains)
+		}
+		if s.Overlaps(o) != c.overlaps || o.Overlaps(s) != c.overlaps {
+			t.Errorf("%s: expected overlaps %v", c.name, c.overlaps)
+		}
+	}
+
+	var empty RangeSet[netip.Addr]
+	if !empty.IsEmpty() || empty.Contains(s) || !s.Contains(empty) {
+		t.Error("unexpected empty set behaviour")
+	}
+}
+
+func TestRangePrefixes(t *testing.T) {
+	cases := []struct {
+		value    string
+		prefixes []string
+	}{
+		{"10.0.0.1", []string{"10.0.0.1/32"}},
+		{"10.0.0.0/24", []string{"10.0.0.0/24"}},
+		{"10.0.0.1-10.0.0.20", []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/30", "10.0.0.8/29", "10.0.0.16/30", "10.0.0.20/32"}},
+		{"0.0.0.0-255.255.255.255", []string{"0.0.0.0/0"}},
+		{"255.255.255.254-255.255.255.255", []string{"255.255.255.254/31"}},
+		{"2001:db8::1-2001:db8::3", []string{"2001:db8::1/128", "2001:db8::2/127"}},
+	}
+	for _, c := range cases {
+		from, to, _ := ParseAddressRange(c.value)
+		var prefixes []string
+		for _, p := range RangePrefixes(from, to) {
+			prefixes = append(prefixes, p.String())
+		}
+		if !slices.Equal(prefixes, c.prefixes) {
+			t.Errorf("%q: expected %v, got %v", c.value, c.prefixes, prefixes)
+		}
+	}
+}
+
+func TestNormalizeAddressRange(t *testing.T) {
+	cases := map[string]string{
+		"2001:DB8:0:0::1":             "2001:db8::1",
+		"::ffff:10.1.1.1":             "10.1.1.1",
+		"10.1.1.1/24":                 "10.1.1.0/24",
+		"2001:0db8::/32":              "2001:db8::/32",
+		" 2001:db8::A - 2001:db8::F ": "2001:db8::a-2001:db8::f",
+	}
+	for value, expected := range cases {
+		if v, err := NormalizeAddressRange(value); err != nil || v != expected {
+			t.Errorf("%q: expected %s, got %s (%v)", value, expected, v, err)
+		}
+	}
+}
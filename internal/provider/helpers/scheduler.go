@@ -0,0 +1,241 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RequestScheduler is a provider-wide token bucket that every REST API call goes through. It limits the
+// request rate and the number of requests in flight. Waiting reads (GET, HEAD) are dispatched before
+// waiting writes. As the FMC client sends writes one at a time, at most one write waits in the queue, but
+// giving reads priority keeps refreshes of other resources from queuing behind a long run of writes.
+type RequestScheduler struct {
+	ctx context.Context
+
+	mu            sync.Mutex
+	interval      time.Duration
+	maxConcurrent int
+	active        int
+	nextToken     time.Time
+	timer         *time.Timer
+	reads         []chan struct{}
+	writes        []chan struct{}
+
+	requests  int64
+	totalWait time.Duration
+	maxWait   time.Duration
+}
+
+// NewRequestScheduler creates a scheduler allowing requestsPerMinute requests per minute with at most
+// maxConcurrent requests in flight. Metrics are logged using ctx.
+func NewRequestScheduler(ctx context.Context, requestsPerMinute, maxConcurrent int) *RequestScheduler {
+	s := &RequestScheduler{ctx: ctx}
+	s.SetRequestsPerMinute(requestsPerMinute)
+	s.maxConcurrent = max(maxConcurrent, 1)
+	return s
+}
+
+// SetRequestsPerMinute changes the request rate of the scheduler.
+func (s *RequestScheduler) SetRequestsPerMinute(requestsPerMinute int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.interval = time.Minute / time.Duration(max(requestsPerMinute, 1))
+}
+
+// Transport wraps next, so that all requests sent through the returned transport are scheduled. If timeout
+// is not zero, each request is cancelled once it has been in flight for longer than timeout. Time spent in
+// the queue does not count, so the timeout of the http.Client using the transport should not be set.
+func (s *RequestScheduler) Transport(next http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	return &scheduledTransport{scheduler: s, next: next, timeout: timeout}
+}
+
+// Stats returns the number of scheduled requests, the total and the maximum time spent waiting in the queue.
+func (s *RequestScheduler) Stats() (requests int64, totalWait, maxWait time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests, s.totalWait, s.maxWait
+}
+
+// Acquire blocks until the request may be sent or ctx is done. Release must be called once the request
+// is complete if no error is returned.
+func (s *RequestScheduler) Acquire(ctx context.Context, read bool) error {
+	s.mu.Lock()
+	if len(s.reads) == 0 && len(s.writes) == 0 && s.active < s.maxConcurrent && !time.Now().Before(s.nextToken) {
+		s.take()
+		s.mu.Unlock()
+		return nil
+	}
+	ch := make(chan struct{})
+	if read {
+		s.reads = append(s.reads, ch)
+	} else {
+		s.writes = append(s.writes, ch)
+	}
+	s.dispatchLocked()
+	s.mu.Unlock()
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		removed := removeWaiter(&s.reads, ch) || removeWaiter(&s.writes, ch)
+		s.mu.Unlock()
+		if !removed {
+			// The request has been dispatched concurrently, give the slot back
+			s.Release()
+		}
+		return ctx.Err()
+	}
+}
+
+// Release marks a request as complete and dispatches waiting requests.
+func (s *RequestScheduler) Release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.active--
+	s.dispatchLocked()
+}
+
+// take consumes a token and a concurrency slot. Must be called with the lock held.
+func (s *RequestScheduler) take() {
+	now := time.Now()
+	if s.nextToken.Before(now) {
+		s.nextToken = now
+	}
+	s.nextToken = s.nextToken.Add(s.interval)
+	s.active++
+}
+
+// dispatchLocked wakes up as many waiting requests as tokens and slots allow, reads first.
+// Must be called with the lock held.
+func (s *RequestScheduler) dispatchLocked() {
+	for (len(s.reads) > 0 || len(s.writes) > 0) && s.active < s.maxConcurrent {
+		if wait := time.Until(s.nextToken); wait > 0 {
+			if s.timer == nil {
+				s.timer = time.AfterFunc(wait, func() {
+					s.mu.Lock()
+					defer s.mu.Unlock()
+					s.timer = nil
+					s.dispatchLocked()
+				})
+			}
+			return
+		}
+		var ch chan struct{}
+		if len(s.reads) > 0 {
+			ch, s.reads = s.reads[0], s.reads[1:]
+		} else {
+			ch, s.writes = s.writes[0], s.writes[1:]
+		}
+		s.take()
+		close(ch)
+	}
+}
+
+func (s *RequestScheduler) record(req *http.Request, wait time.Duration) {
+	s.mu.Lock()
+	s.requests++
+	s.totalWait += wait
+	s.maxWait = max(s.maxWait, wait)
+	fields := map[string]any{
+		"method":         req.Method,
+		"path":           req.URL.Path,
+		"wait_ms":        wait.Milliseconds(),
+		"queued_reads":   len(s.reads),
+		"queued_writes":  len(s.writes),
+		"active":         s.active,
+		"requests_total": s.requests,
+		"wait_total_ms":  s.totalWait.Milliseconds(),
+		"wait_max_ms":    s.maxWait.Milliseconds(),
+	}
+	s.mu.Unlock()
+
+	if s.ctx != nil {
+		tflog.Debug(s.ctx, fmt.Sprintf("Request scheduler: %s %s waited %s in queue", req.Method, req.URL.Path, wait), fields)
+	}
+}
+
+func removeWaiter(queue *[]chan struct{}, ch chan struct{}) bool {
+	for i := range *queue {
+		if (*queue)[i] == ch {
+			*queue = append((*queue)[:i], (*queue)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+type scheduledTransport struct {
+	scheduler *RequestScheduler
+	next      http.RoundTripper
+	timeout   time.Duration
+}
+
+func (t *scheduledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	read := req.Method == http.MethodGet || req.Method == http.MethodHead
+	if err := t.scheduler.Acquire(req.Context(), read); err != nil {
+		return nil, err
+	}
+	t.scheduler.record(req, time.Since(start))
+
+	// The timeout starts once the request leaves the queue
+	cancel := context.CancelFunc(func() {})
+	if t.timeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(req.Context(), t.timeout)
+		req = req.WithContext(ctx)
+	}
+	done := func() {
+		cancel()
+		t.scheduler.Release()
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil || res.Body == nil {
+		done()
+		return res, err
+	}
+	// The request is in flight until the response body has been consumed
+	res.Body = &releasingBody{ReadCloser: res.Body, release: done}
+	return res, nil
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,210 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func okResponse(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper, method string) {
+	t.Helper()
+
+	req, _ := http.NewRequest(method, "https://fmc.example.com/api/fmc_config/v1/domain/x/object/hosts", nil)
+	res, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	_, _ = io.ReadAll(res.Body)
+	res.Body.Close()
+}
+
+func TestRequestSchedulerRate(t *testing.T) {
+	s := NewRequestScheduler(context.Background(), 600, 10)
+	rt := s.Transport(roundTripperFunc(okResponse), 0)
+
+	start := time.Now()
+	for range 5 {
+		doRequest(t, rt, http.MethodGet)
+	}
+	// The first request is sent immediately, the others are spaced by 100ms
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("expected requests to be rate limited, 5 requests took %s", elapsed)
+	}
+
+	requests, totalWait, maxWait := s.Stats()
+	if requests != 5 || totalWait < 300*time.Millisecond || maxWait < 50*time.Millisecond {
+		t.Errorf("unexpected stats: requests=%d total_wait=%s max_wait=%s", requests, totalWait, maxWait)
+	}
+}
+
+func TestRequestSchedulerConcurrency(t *testing.T) {
+	s := NewRequestScheduler(context.Background(), 60000, 2)
+
+	var active, peak atomic.Int32
+	rt := s.Transport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		n := active.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		active.Add(-1)
+		return okResponse(req)
+	}), 0)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			doRequest(t, rt, http.MethodPost)
+		}()
+	}
+	wg.Wait()
+
+	if p := peak.Load(); p > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", p)
+	}
+}
+
+func TestRequestSchedulerReadPriority(t *testing.T) {
+	s := NewRequestScheduler(context.Background(), 60000, 1)
+	ctx := context.Background()
+
+	// Hold the only slot, so that following requests are queued
+	if err := s.Acquire(ctx, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var mu sync.Mutex
+	var order []string
+	var wg sync.WaitGroup
+	enqueue := func(name string, read bool) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.Acquire(ctx, read); err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			s.Release()
+		}()
+		// Make sure the queueing order is deterministic
+		time.Sleep(10 * time.Millisecond)
+	}
+	enqueue("write1", false)
+	enqueue("write2", false)
+	enqueue("read1", true)
+	enqueue("read2", true)
+
+	s.Release()
+	wg.Wait()
+
+	expected := []string{"read1", "read2", "write1", "write2"}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected dispatch order %v, got %v", expected, order)
+	}
+}
+
+func TestRequestSchedulerTimeout(t *testing.T) {
+	s := NewRequestScheduler(context.Background(), 60000, 1)
+	rt := s.Transport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		delay, _ := time.ParseDuration(req.URL.Query().Get("delay"))
+		select {
+		case <-time.After(delay):
+			return okResponse(req)
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}), 60*time.Millisecond)
+
+	send := func(delay string) error {
+		req, _ := http.NewRequest(http.MethodGet, "https://fmc.example.com/api/fmc_config/v1/domain/x/object/hosts?delay="+delay, nil)
+		res, err := rt.RoundTrip(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+
+	// Each request is queued behind the other one for 40ms, which must not count against the timeout
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = send("40ms")
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Errorf("expected queued request to succeed, got: %s", err)
+		}
+	}
+
+	if err := send("200ms"); err == nil {
+		t.Error("expected request in flight for longer than the timeout to fail")
+	}
+}
+
+func TestRequestSchedulerCancel(t *testing.T) {
+	s := NewRequestScheduler(context.Background(), 60000, 1)
+
+	if err := s.Acquire(context.Background(), true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s.Acquire(ctx, true); err == nil {
+		t.Fatal("expected queued request to be cancelled")
+	}
+
+	// The cancelled request must not hold the slot once the first request is done
+	s.Release()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.Acquire(ctx, false); err != nil {
+		t.Errorf("expected slot to be available, got: %s", err)
+	}
+}
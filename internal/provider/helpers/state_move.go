@@ -0,0 +1,110 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// providerAddressSuffix is the namespace and type of this provider, the registry hostname is ignored.
+const providerAddressSuffix = "ciscodevnet/fmc"
+
+// MoveToBulkState converts the JSON state of a single object resource into the JSON state of a bulk
+// resource with a single entry in the `items` map, keyed by the object name. Attributes listed in
+// topLevel (like `domain`) are kept at the top level, all other attributes are moved to the item,
+// including the object `id`. The bulk resource gets a new random `id`.
+func MoveToBulkState(source []byte, topLevel []string) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(source))
+	decoder.UseNumber()
+	var state map[string]any
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+	name, _ := state["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("source state has no `name` attribute")
+	}
+
+	item := map[string]any{}
+	target := map[string]any{
+		"id":    uuid.New().String(),
+		"items": map[string]any{name: item},
+	}
+	for k, v := range state {
+		switch {
+		case k == "name":
+		case k != "id" && k != "items" && slices.Contains(topLevel, k):
+			target[k] = v
+		default:
+			item[k] = v
+		}
+	}
+	return json.Marshal(target)
+}
+
+// BulkStateMover returns a state mover, which moves the state of a single object resource of type
+// sourceTypeName (e.g. `fmc_host`) into a bulk resource (e.g. `fmc_hosts`). The object is adopted
+// with its FMC object ID, so no changes are made on FMC. Requests for other source resources are
+// skipped, so that other state movers can handle them.
+func BulkStateMover(sourceTypeName string, sourceSchemaVersion int64) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceTypeName || !strings.HasSuffix(strings.ToLower(req.SourceProviderAddress), providerAddressSuffix) {
+				return
+			}
+			if req.SourceSchemaVersion != sourceSchemaVersion {
+				resp.Diagnostics.AddError("Unable to move state", fmt.Sprintf("Source state of %s has schema version %d, expected %d. Run `terraform apply` with the current provider version first to upgrade the source state.", sourceTypeName, req.SourceSchemaVersion, sourceSchemaVersion))
+				return
+			}
+			if req.SourceRawState == nil || req.SourceRawState.JSON == nil {
+				resp.Diagnostics.AddError("Unable to move state", "Source state in flatmap format is not supported")
+				return
+			}
+
+			var topLevel []string
+			for name := range resp.TargetState.Schema.GetAttributes() {
+				topLevel = append(topLevel, name)
+			}
+			state, err := MoveToBulkState(req.SourceRawState.JSON, topLevel)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to move state", fmt.Sprintf("Failed to move state of %s: %s", sourceTypeName, err))
+				return
+			}
+
+			raw := tfprotov6.RawState{JSON: state}
+			value, err := raw.UnmarshalWithOpts(resp.TargetState.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to move state", fmt.Sprintf("Failed to decode state moved from %s: %s", sourceTypeName, err))
+				return
+			}
+			resp.TargetState.Raw = value
+		},
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBulkStateMover(t *testing.T) {
+	ctx := context.Background()
+	target := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":     schema.StringAttribute{Computed: true},
+			"domain": schema.StringAttribute{Optional: true},
+			"items": schema.MapNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.StringAttribute{Computed: true},
+						"ip":          schema.StringAttribute{Required: true},
+						"description": schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+	mover := BulkStateMover("fmc_host", 0)
+	source := `{"id":"host-uuid","domain":"Global","name":"my_host","ip":"10.1.1.1","description":null,"type":"Host"}`
+
+	move := func(typeName, address string, version int64, state string) resource.MoveStateResponse {
+		resp := resource.MoveStateResponse{
+			TargetState: tfsdk.State{Schema: target, Raw: tftypes.NewValue(target.Type().TerraformType(ctx), nil)},
+		}
+		mover.StateMover(ctx, resource.MoveStateRequest{
+			SourceTypeName:        typeName,
+			SourceProviderAddress: address,
+			SourceSchemaVersion:   version,
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(state)},
+		}, &resp)
+		return resp
+	}
+
+	resp := move("fmc_host", "registry.terraform.io/CiscoDevNet/fmc", 0, source)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error %v", resp.Diagnostics)
+	}
+	var id, domain, itemId, ip types.String
+	resp.TargetState.GetAttribute(ctx, path.Root("id"), &id)
+	resp.TargetState.GetAttribute(ctx, path.Root("domain"), &domain)
+	resp.TargetState.GetAttribute(ctx, path.Root("items").AtMapKey("my_host").AtName("id"), &itemId)
+	resp.TargetState.GetAttribute(ctx, path.Root("items").AtMapKey("my_host").AtName("ip"), &ip)
+	if id.ValueString() == "" || id.ValueString() == "host-uuid" || domain.ValueString() != "Global" || itemId.ValueString() != "host-uuid" || ip.ValueString() != "10.1.1.1" {
+		t.Errorf("unexpected moved state %s", resp.TargetState.Raw)
+	}
+
+	// Other resources are left to other state movers
+	for _, c := range [][2]string{{"fmc_network", "registry.terraform.io/CiscoDevNet/fmc"}, {"fmc_host", "registry.terraform.io/hashicorp/random"}} {
+		resp = move(c[0], c[1], 0, source)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			t.Errorf("%s from %s: expected state mover to be skipped", c[0], c[1])
+		}
+	}
+
+	if resp = move("fmc_host", "registry.terraform.io/CiscoDevNet/fmc", 1, source); !resp.Diagnostics.HasError() {
+		t.Errorf("expected error for unknown source schema version")
+	}
+	if resp = move("fmc_host", "registry.terraform.io/CiscoDevNet/fmc", 0, `{"id":"host-uuid"}`); !resp.Diagnostics.HasError() {
+		t.Errorf("expected error for source state without name")
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// StateMigrationRule transforms the prior state of a resource, decoded from JSON, in place.
+type StateMigrationRule func(state map[string]any) error
+
+// RenameAttribute renames the attribute at path to the name to. The path is a dot separated list of
+// attribute names, where "*" stands for all elements of a list, set or map. The rule does nothing if
+// the attribute is not present, or if the new attribute is already present.
+func RenameAttribute(path, to string) StateMigrationRule {
+	return func(state map[string]any) error {
+		return walkState(state, strings.Split(path, "."), func(parent map[string]any, name string) error {
+			value, ok := parent[name]
+			if _, exists := parent[to]; !ok || exists {
+				return nil
+			}
+			delete(parent, name)
+			parent[to] = value
+			return nil
+		})
+	}
+}
+
+// ListToSet removes duplicate elements of the list attribute at path, as a set cannot contain duplicates.
+func ListToSet(path string) StateMigrationRule {
+	return func(state map[string]any) error {
+		return walkState(state, strings.Split(path, "."), func(parent map[string]any, name string) error {
+			list, ok := parent[name].([]any)
+			if !ok {
+				return nil
+			}
+			var elements []any
+			var seen []string
+			for _, v := range list {
+				key, _ := json.Marshal(v)
+				if !slices.Contains(seen, string(key)) {
+					seen = append(seen, string(key))
+					elements = append(elements, v)
+				}
+			}
+			parent[name] = elements
+			return nil
+		})
+	}
+}
+
+// SplitAttribute splits the string attribute at path by separator into the attributes into, which are
+// siblings of the split attribute. Missing parts are set to null and the split attribute is removed,
+// unless it is one of the attributes into.
+func SplitAttribute(path, separator string, into ...string) StateMigrationRule {
+	return func(state map[string]any) error {
+		return walkState(state, strings.Split(path, "."), func(parent map[string]any, name string) error {
+			value, ok := parent[name]
+			if !ok || value == nil {
+				return nil
+			}
+			s, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("cannot split attribute %s: not a string", name)
+			}
+			parts := strings.SplitN(s, separator, len(into))
+			delete(parent, name)
+			for i, v := range into {
+				parent[v] = nil
+				if i < len(parts) {
+					parent[v] = parts[i]
+				}
+			}
+			return nil
+		})
+	}
+}
+
+// walkState calls fn for every object containing the attribute at path, where "*" stands for all elements
+// of a list, set or map.
+func walkState(value any, path []string, fn func(parent map[string]any, name string) error) error {
+	if len(path) == 0 {
+		return nil
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		if path[0] == "*" {
+			for _, e := range v {
+				if err := walkState(e, path[1:], fn); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if len(path) == 1 {
+			return fn(v, path[0])
+		}
+		return walkState(v[path[0]], path[1:], fn)
+	case []any:
+		if path[0] != "*" {
+			return nil
+		}
+		for _, e := range v {
+			if err := walkState(e, path[1:], fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MigrateState applies the migration rules to the prior state in JSON format. The migrations are indexed by the
+// schema version they upgrade from, all migrations from version on are applied.
+func MigrateState(prior []byte, version int64, migrations [][]StateMigrationRule) ([]byte, error) {
+	var state map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(prior))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+	for _, rules := range migrations[version:] {
+		for _, rule := range rules {
+			if err := rule(state); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return json.Marshal(state)
+}
+
+// StateUpgraders returns state upgraders from every prior schema version to the version of the current schema.
+// The migrations are indexed by the schema version they upgrade from. Attributes not present in the current
+// schema are dropped from the state.
+func StateUpgraders(current schema.Schema, migrations [][]StateMigrationRule) map[int64]resource.StateUpgrader {
+	upgraders := map[int64]resource.StateUpgrader{}
+	for version := range int64(len(migrations)) {
+		upgraders[version] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil || req.RawState.JSON == nil {
+					resp.Diagnostics.AddError("Unable to upgrade state", "State in flatmap format is not supported, use Terraform 0.12 or later to write it in JSON format first")
+					return
+				}
+				state, err := MigrateState(req.RawState.JSON, version, migrations)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Failed to migrate state from schema version %d: %s", version, err))
+					return
+				}
+
+				typ := current.Type().TerraformType(ctx)
+				raw := tfprotov6.RawState{JSON: state}
+				value, err := raw.UnmarshalWithOpts(typ, tfprotov6.UnmarshalOpts{
+					ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+				})
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Failed to decode state migrated from schema version %d: %s", version, err))
+					return
+				}
+				dynamicValue, err := tfprotov6.NewDynamicValue(typ, value)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("Failed to encode state migrated from schema version %d: %s", version, err))
+					return
+				}
+				resp.DynamicValue = &dynamicValue
+			},
+		}
+	}
+	return upgraders
+}
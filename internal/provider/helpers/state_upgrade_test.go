@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestMigrateState(t *testing.T) {
+	migrations := [][]StateMigrationRule{
+		// Version 0 to 1
+		{
+			RenameAttribute("udp_port_number", "port"),
+			RenameAttribute("items.*.encryption", "encryption_algorithm"),
+			RenameAttribute("servers.*.key", "secret"),
+		},
+		// Version 1 to 2
+		{
+			ListToSet("tags"),
+			SplitAttribute("servers.*.address", "/", "ip", "netmask"),
+		},
+	}
+	prior := `{
+		"udp_port_number": 9023,
+		"items": {"a.b": {"encryption": "AES"}, "c": {"encryption": "DES", "encryption_algorithm": "AES"}},
+		"servers": [{"key": "k", "address": "10.1.1.1/24"}, {"address": "10.1.1.2"}, {"address": null}],
+		"tags": ["x", "y", "x"]
+	}`
+	cases := []struct {
+		version  int64
+		expected string
+	}{
+		{0, `{"port":9023,"items":{"a.b":{"encryption_algorithm":"AES"},"c":{"encryption":"DES","encryption_algorithm":"AES"}},` +
+			`"servers":[{"secret":"k","ip":"10.1.1.1","netmask":"24"},{"ip":"10.1.1.2","netmask":null},{"address":null}],"tags":["x","y"]}`},
+		{1, `{"udp_port_number":9023,"items":{"a.b":{"encryption":"AES"},"c":{"encryption":"DES","encryption_algorithm":"AES"}},` +
+			`"servers":[{"key":"k","ip":"10.1.1.1","netmask":"24"},{"ip":"10.1.1.2","netmask":null},{"address":null}],"tags":["x","y"]}`},
+		{2, prior},
+	}
+	for _, c := range cases {
+		state, err := MigrateState([]byte(prior), c.version, migrations)
+		if err != nil {
+			t.Fatalf("version %d: unexpected error %s", c.version, err)
+		}
+		var got, expected any
+		json.Unmarshal(state, &got)
+		json.Unmarshal([]byte(c.expected), &expected)
+		gotJson, _ := json.Marshal(got)
+		expectedJson, _ := json.Marshal(expected)
+		if string(gotJson) != string(expectedJson) {
+			t.Errorf("version %d: expected %s, got %s", c.version, expectedJson, gotJson)
+		}
+	}
+
+	if _, err := MigrateState([]byte(`{"servers":[{"address":1}]}`), 1, migrations); err == nil {
+		t.Errorf("expected error when splitting a number")
+	}
+}
+
+func TestStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+	current := schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"port": schema.Int64Attribute{Optional: true},
+			"name": schema.StringAttribute{Optional: true},
+		},
+	}
+	upgraders := StateUpgraders(current, [][]StateMigrationRule{{RenameAttribute("udp_port_number", "port")}})
+	if len(upgraders) != 1 {
+		t.Fatalf("expected 1 upgrader, got %d", len(upgraders))
+	}
+
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(`{"id":"1","udp_port_number":9023,"removed":true}`)}}
+	var resp resource.UpgradeStateResponse
+	upgraders[0].StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error %v", resp.Diagnostics)
+	}
+
+	value, err := resp.DynamicValue.Unmarshal(current.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("failed to decode upgraded state: %s", err)
+	}
+	state := tfsdk.State{Schema: current, Raw: value}
+	var port types.Int64
+	var name types.String
+	state.GetAttribute(ctx, path.Root("port"), &port)
+	state.GetAttribute(ctx, path.Root("name"), &name)
+	if port.ValueInt64() != 9023 || !name.IsNull() {
+		t.Errorf("unexpected upgraded state %s", value)
+	}
+
+	resp = resource.UpgradeStateResponse{}
+	upgraders[0].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{Flatmap: map[string]string{"id": "1"}}}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected error for flatmap state")
+	}
+}
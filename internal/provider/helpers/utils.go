@@ -134,3 +134,37 @@ func DifferenceStringSet(ctx context.Context, a basetypes.SetValue, b basetypes.
 
 	return types.SetValueMust(types.StringType, diff)
 }
+
+// IsJSONSubset returns true if all values present in JSON `subset` are equal in JSON `full`. Objects in `full`
+// may contain additional keys, arrays must have the same length and are compared element by element.
+func IsJSONSubset(subset, full string) bool {
+	return isGjsonSubset(gjson.Parse(subset), gjson.Parse(full))
+}
+
+func isGjsonSubset(subset, full gjson.Result) bool {
+	switch {
+	case subset.IsObject():
+		if !full.IsObject() {
+			return false
+		}
+		ret := true
+		subset.ForEach(func(k, v gjson.Result) bool {
+			ret = isGjsonSubset(v, full.Get(gjson.Escape(k.String())))
+			return ret
+		})
+		return ret
+	case subset.IsArray():
+		s, f := subset.Array(), full.Array()
+		if !full.IsArray() || len(s) != len(f) {
+			return false
+		}
+		for i := range s {
+			if !isGjsonSubset(s[i], f[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return subset.Type == full.Type && subset.Value() == full.Value()
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import "testing"
+
+func TestIsJSONSubset(t *testing.T) {
+	full := `{"id":"1","name":"a.b","value":"10.1.1.1","port":80,"enabled":true,"tags":[{"id":"x","type":"T"},{"id":"y"}],"metadata":{"domain":"Global"}}`
+	cases := []struct {
+		subset   string
+		expected bool
+	}{
+		{`{}`, true},
+		{`{"name":"a.b","value":"10.1.1.1"}`, true},
+		{`{"port":80,"enabled":true,"tags":[{"id":"x"},{"id":"y"}]}`, true},
+		{`{"value":"10.1.1.2"}`, false},
+		{`{"port":"80"}`, false},
+		{`{"enabled":false}`, false},
+		{`{"tags":[{"id":"x"}]}`, false},
+		{`{"tags":[{"id":"y"},{"id":"x"}]}`, false},
+		{`{"description":"d"}`, false},
+		{`{"metadata":{"domain":"Global"}}`, true},
+	}
+	for _, c := range cases {
+		if got := IsJSONSubset(c.subset, full); got != c.expected {
+			t.Errorf("IsJSONSubset(%s): expected %v, got %v", c.subset, c.expected, got)
+		}
+	}
+}
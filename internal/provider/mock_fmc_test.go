@@ -0,0 +1,906 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/juju/ratelimit"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/sjson"
+)
+
+const (
+	mockFMCUsername         = "admin"
+	mockFMCPassword         = "mock-password"
+	mockFMCGlobalDomainUUID = "e276abec-e0f2-11e3-8169-6d9ed49b625f"
+	mockFMCDefaultPageLimit = 25
+)
+
+var mockFMCObjectIdRegex = regexp.MustCompile(`^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`)
+
+// mockFMCTypes maps the last segment of a collection path to the object type reported by FMC.
+// Collections not listed here get a type derived from the collection name.
+var mockFMCTypes = map[string]string{
+	"hosts":               "Host",
+	"networks":            "Network",
+	"ranges":              "Range",
+	"fqdns":               "FQDN",
+	"networkgroups":       "NetworkGroup",
+	"protocolportobjects": "ProtocolPortObject",
+	"portobjectgroups":    "PortObjectGroup",
+	"urls":                "Url",
+	"urlgroups":           "UrlGroup",
+	"securityzones":       "SecurityZone",
+	"accesspolicies":      "AccessPolicy",
+	"accessrules":         "AccessRule",
+	"devicerecords":       "Device",
+	"devicegrouprecords":  "DeviceGroup",
+	"taskstatuses":        "TaskStatus",
+}
+
+// mockFMC is an in-memory stand-in for the FMC REST API. It implements token authentication,
+// domain UUID routing, generic CRUD on any collection, bulk create/update/delete, expanded paging and
+// task status polling and object usage lookups, which is enough to run the generated resources without a real FMC.
+type mockFMC struct {
+	*httptest.Server
+
+	// Version is reported by the server version endpoint.
+	Version string
+	// Domains maps domain name to domain UUID. The Global domain is always present.
+	Domains map[string]string
+	// BulkDeleteErrorsWithoutIds makes failed bulk deletes not name the objects in use.
+	BulkDeleteErrorsWithoutIds bool
+
+	mu          sync.Mutex
+	tokens      map[string]bool
+	collections map[string][]string
+	objects     map[string]map[string]any
+	tasks       map[string]string
+	requests    []string
+}
+
+// newMockFMC starts a new mock FMC server which is shut down when the test completes.
+func newMockFMC(t *testing.T) *mockFMC {
+	t.Helper()
+
+	m := &mockFMC{
+		Version:     "7.7.0",
+		Domains:     map[string]string{"Global": mockFMCGlobalDomainUUID},
+		tokens:      map[string]bool{},
+		collections: map[string][]string{},
+		objects:     map[string]map[string]any{},
+		tasks:       map[string]string{},
+	}
+	m.Server = httptest.NewTLSServer(http.HandlerFunc(m.handle))
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+// setupProviderEnv points the provider configuration environment variables at the mock server.
+// Tests are skipped if no Terraform CLI is available, as the test framework needs it to run steps.
+func (m *mockFMC) setupProviderEnv(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("skipping unit test, terraform CLI not found in PATH and TF_ACC_TERRAFORM_PATH not set")
+		}
+	}
+
+	t.Setenv("FMC_URL", m.URL)
+	t.Setenv("FMC_USERNAME", mockFMCUsername)
+	t.Setenv("FMC_PASSWORD", mockFMCPassword)
+	t.Setenv("FMC_TOKEN", "")
+	t.Setenv("FMC_INSECURE", "true")
+	t.Setenv("FMC_RETRIES", "0")
+	t.Setenv("FMC_REQUESTS_PER_MINUTE", "60000")
+}
+
+// AddDomain registers an additional domain and returns its UUID.
+func (m *mockFMC) AddDomain(name string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := uuid.New().String()
+	m.Domains[name] = id
+	return id
+}
+
+// AddObject stores an object in the collection identified by path (relative to the domain, e.g. "/object/hosts")
+// in the Global domain and returns its ID.
+func (m *mockFMC) AddObject(path string, body string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	obj := map[string]any{}
+	_ = json.Unmarshal([]byte(body), &obj)
+	return m.store(m.domainPath(mockFMCGlobalDomainUUID, path), obj)
+}
+
+// Object returns the object stored under path (relative to the Global domain) or an empty string.
+func (m *mockFMC) Object(path string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	obj, ok := m.objects[m.domainPath(mockFMCGlobalDomainUUID, path)]
+	if !ok {
+		return ""
+	}
+	res, _ := json.Marshal(obj)
+	return string(res)
+}
+
+// Count returns the number of objects in the collection identified by path (relative to the Global domain).
+func (m *mockFMC) Count(path string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.collections[m.domainPath(mockFMCGlobalDomainUUID, path)])
+}
+
+// SetTaskStatus sets the status returned for the task with the given ID.
+func (m *mockFMC) SetTaskStatus(id, status string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tasks[id] = status
+}
+
+// Requests returns all requests received so far formatted as "METHOD path?query".
+func (m *mockFMC) Requests() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]string{}, m.requests...)
+}
+
+func (m *mockFMC) domainPath(domain, path string) string {
+	return "/api/fmc_config/v1/domain/" + domain + path
+}
+
+func (m *mockFMC) handle(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests = append(m.requests, strings.TrimSuffix(r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery, "?"))
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.URL.Path {
+	case "/api/fmc_platform/v1/auth/generatetoken":
+		user, pwd, ok := r.BasicAuth()
+		if r.Method != http.MethodPost || !ok || user != mockFMCUsername || pwd != mockFMCPassword {
+			m.writeError(w, http.StatusUnauthorized, "Invalid credentials")
+			return
+		}
+		m.issueToken(w)
+		return
+	case "/api/fmc_platform/v1/auth/refreshtoken":
+		if !m.tokens[r.Header.Get("X-auth-access-token")] {
+			m.writeError(w, http.StatusUnauthorized, "Invalid access token")
+			return
+		}
+		m.issueToken(w)
+		return
+	}
+
+	if !m.authenticated(r) {
+		m.writeError(w, http.StatusUnauthorized, "Access token invalid")
+		return
+	}
+
+	switch r.URL.Path {
+	case "/api/fmc_platform/v1/info/serverversion":
+		m.writeJSON(w, http.StatusOK, map[string]any{
+			"items": []any{map[string]any{"serverVersion": m.Version + " (build 1)", "type": "ServerVersion"}},
+		})
+		return
+	case "/api/fmc_platform/v1/info/domain":
+		var items []any
+		for name, id := range m.Domains {
+			items = append(items, map[string]any{"name": name, "uuid": id, "type": "Domain"})
+		}
+		m.writeJSON(w, http.StatusOK, map[string]any{"items": items, "paging": map[string]any{"count": len(items)}})
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 5 || segments[0] != "api" || segments[3] != "domain" {
+		m.writeError(w, http.StatusNotFound, "Unknown endpoint "+r.URL.Path)
+		return
+	}
+	if !m.knownDomain(segments[4]) {
+		m.writeError(w, http.StatusNotFound, "Unknown domain "+segments[4])
+		return
+	}
+
+	if len(segments) == 8 && segments[5] == "job" && segments[6] == "taskstatuses" {
+		status, ok := m.tasks[segments[7]]
+		if !ok {
+			status = "Success"
+		}
+		m.writeJSON(w, http.StatusOK, map[string]any{"id": segments[7], "type": "TaskStatus", "status": status})
+		return
+	}
+
+	if segments[len(segments)-1] == "hitcounts" && r.Method == http.MethodPut {
+		// Hit count refresh runs as a task, the counters themselves are set by the test through AddObject
+		m.writeJSON(w, http.StatusAccepted, map[string]any{"metadata": map[string]any{"task": map[string]any{"id": uuid.New().String()}}})
+		return
+	}
+
+	if strings.HasSuffix(r.URL.Path, "/object/operational/usage") && r.Method == http.MethodGet {
+		m.usage(w, r, segments[4])
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	if len(segments) >= 6 && segments[5] == "domains" {
+		m.handleDomain(w, r, segments, body)
+		return
+	}
+	last := segments[len(segments)-1]
+	if mockFMCObjectIdRegex.MatchString(last) {
+		m.handleObject(w, r, r.URL.Path, body)
+	} else {
+		m.handleCollection(w, r, r.URL.Path, body)
+	}
+}
+
+func (m *mockFMC) handleObject(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	obj, ok := m.objects[path]
+	if !ok {
+		m.writeError(w, http.StatusNotFound, "Object not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if i := strings.LastIndex(path, "/"); strings.HasSuffix(path[:i], "rules") {
+			metadata, _ := obj["metadata"].(map[string]any)
+			metadata = maps.Clone(metadata)
+			if metadata == nil {
+				metadata = map[string]any{}
+			}
+			metadata["ruleIndex"] = slices.Index(m.collections[path[:i]], path[i+1:]) + 1
+			obj = maps.Clone(obj)
+			obj["metadata"] = metadata
+		}
+		m.writeJSON(w, http.StatusOK, obj)
+	case http.MethodPut:
+		updated := map[string]any{}
+		if err := json.Unmarshal(body, &updated); err != nil {
+			m.writeError(w, http.StatusBadRequest, "Invalid JSON body")
+			return
+		}
+		updated["id"] = obj["id"]
+		updated["type"] = obj["type"]
+		updated["links"] = obj["links"]
+		m.objects[path] = updated
+		m.writeJSON(w, http.StatusOK, updated)
+	case http.MethodDelete:
+		if len(m.referencedBy(path)) > 0 {
+			m.writeError(w, http.StatusBadRequest, "Object is in use")
+			return
+		}
+		m.remove(path)
+		m.writeJSON(w, http.StatusOK, obj)
+	default:
+		m.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (m *mockFMC) handleCollection(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	query := r.URL.Query()
+	bulk := query.Get("bulk") == "true"
+
+	switch r.Method {
+	case http.MethodGet:
+		m.list(w, r, path)
+	case http.MethodPost:
+		if bulk {
+			var items []map[string]any
+			if err := json.Unmarshal(body, &items); err != nil {
+				m.writeError(w, http.StatusBadRequest, "Bulk request body must be a JSON array")
+				return
+			}
+			res := []any{}
+			ids := []string{}
+			for _, item := range items {
+				id := m.store(path, item)
+				ids = append(ids, id)
+				res = append(res, m.objects[path+"/"+id])
+			}
+			m.insert(path, ids, query)
+			m.writeJSON(w, http.StatusCreated, map[string]any{"items": res})
+			return
+		}
+		obj := map[string]any{}
+		if err := json.Unmarshal(body, &obj); err != nil {
+			m.writeError(w, http.StatusBadRequest, "Invalid JSON body")
+			return
+		}
+		id := m.store(path, obj)
+		m.insert(path, []string{id}, query)
+		m.writeJSON(w, http.StatusCreated, m.objects[path+"/"+id])
+	case http.MethodPut:
+		if !bulk {
+			m.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		var items []map[string]any
+		if err := json.Unmarshal(body, &items); err != nil {
+			m.writeError(w, http.StatusBadRequest, "Bulk request body must be a JSON array")
+			return
+		}
+		res := []any{}
+		for _, item := range items {
+			id, _ := item["id"].(string)
+			obj, ok := m.objects[path+"/"+id]
+			if !ok {
+				m.writeError(w, http.StatusNotFound, fmt.Sprintf("Object %q not found", id))
+				return
+			}
+			item["type"] = obj["type"]
+			item["links"] = obj["links"]
+			m.objects[path+"/"+id] = item
+			res = append(res, item)
+		}
+		m.writeJSON(w, http.StatusOK, map[string]any{"items": res})
+	case http.MethodDelete:
+		if !bulk {
+			m.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		ids := m.filter(query.Get("filter"))["ids"]
+		if ids == "" {
+			m.writeError(w, http.StatusBadRequest, "Bulk delete requires an ids filter")
+			return
+		}
+		for _, id := range strings.Split(ids, ",") {
+			if len(m.referencedBy(path+"/"+id)) > 0 {
+				if m.BulkDeleteErrorsWithoutIds {
+					m.writeError(w, http.StatusBadRequest, "One or more objects are in use")
+				} else {
+					m.writeError(w, http.StatusBadRequest, fmt.Sprintf("Object %q is in use", id))
+				}
+				return
+			}
+		}
+		res := []any{}
+		for _, id := range strings.Split(ids, ",") {
+			if obj, ok := m.objects[path+"/"+id]; ok {
+				res = append(res, obj)
+				m.remove(path + "/" + id)
+			}
+		}
+		m.writeJSON(w, http.StatusOK, map[string]any{"items": res})
+	default:
+		m.writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// handleDomain handles the domains collection and keeps Domains in sync, so that created domains are reported
+// at login and by the domain information endpoint. Domains with devices assigned cannot be deleted.
+func (m *mockFMC) handleDomain(w http.ResponseWriter, r *http.Request, segments []string, body []byte) {
+	path := r.URL.Path
+	if len(segments) == 6 {
+		if r.Method != http.MethodPost {
+			m.handleCollection(w, r, path, body)
+			return
+		}
+		obj := map[string]any{}
+		if err := json.Unmarshal(body, &obj); err != nil {
+			m.writeError(w, http.StatusBadRequest, "Invalid JSON body")
+			return
+		}
+		id := m.store(path, obj)
+		m.Domains[m.domainName(segments[4])+"/"+fmt.Sprint(obj["name"])] = id
+		m.writeJSON(w, http.StatusCreated, m.objects[path+"/"+id])
+		return
+	}
+
+	id := segments[6]
+	if _, ok := m.objects[path]; ok {
+		switch r.Method {
+		case http.MethodPut:
+			delete(m.Domains, m.domainName(id))
+			defer func() {
+				m.Domains[m.domainName(segments[4])+"/"+fmt.Sprint(m.objects[path]["name"])] = id
+			}()
+		case http.MethodDelete:
+			if len(m.collections[m.domainPath(id, "/devices/devicerecords")]) > 0 {
+				m.writeError(w, http.StatusBadRequest, "Domain has devices assigned")
+				return
+			}
+			delete(m.Domains, m.domainName(id))
+		}
+	}
+	m.handleObject(w, r, path, body)
+}
+
+// domainName returns the name of the domain with the given UUID.
+func (m *mockFMC) domainName(id string) string {
+	for name, v := range m.Domains {
+		if v == id {
+			return name
+		}
+	}
+	return ""
+}
+
+// list returns a page of the collection honouring expanded, offset, limit and name/ids/unusedOnly filters.
+func (m *mockFMC) list(w http.ResponseWriter, r *http.Request, path string) {
+	query := r.URL.Query()
+	filter := m.filter(query.Get("filter"))
+
+	var matching []map[string]any
+	for _, id := range m.collections[path] {
+		obj := m.objects[path+"/"+id]
+		if name, ok := filter["name"]; ok && obj["name"] != name {
+			continue
+		}
+		if ids, ok := filter["ids"]; ok && !strings.Contains(","+ids+",", ","+id+",") {
+			continue
+		}
+		if filter["unusedOnly"] == "true" && len(m.referencedBy(path+"/"+id)) > 0 {
+			continue
+		}
+		matching = append(matching, obj)
+	}
+
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = mockFMCDefaultPageLimit
+	}
+	offset = min(max(offset, 0), len(matching))
+	end := min(offset+limit, len(matching))
+
+	items := []any{}
+	for _, obj := range matching[offset:end] {
+		if query.Get("expanded") == "true" {
+			items = append(items, obj)
+		} else {
+			items = append(items, map[string]any{"id": obj["id"], "name": obj["name"], "type": obj["type"], "links": obj["links"]})
+		}
+	}
+
+	paging := map[string]any{
+		"offset": offset,
+		"limit":  limit,
+		"count":  len(matching),
+		"pages":  (len(matching) + limit - 1) / limit,
+	}
+	if end < len(matching) {
+		next := *r.URL
+		q := next.Query()
+		q.Set("offset", strconv.Itoa(end))
+		q.Set("limit", strconv.Itoa(limit))
+		next.RawQuery = q.Encode()
+		paging["next"] = []any{m.URL + next.RequestURI()}
+	}
+
+	res := map[string]any{"links": map[string]any{"self": m.URL + r.URL.RequestURI()}, "paging": paging}
+	if len(items) > 0 {
+		res["items"] = items
+	}
+	m.writeJSON(w, http.StatusOK, res)
+}
+
+// store adds obj to the collection at path, assigning an ID and type when not provided.
+func (m *mockFMC) store(path string, obj map[string]any) string {
+	id, _ := obj["id"].(string)
+	if !mockFMCObjectIdRegex.MatchString(id) {
+		id = strings.ToUpper(uuid.New().String())
+	}
+	obj["id"] = id
+	if _, ok := obj["type"]; !ok {
+		collection := path[strings.LastIndex(path, "/")+1:]
+		if t, ok := mockFMCTypes[collection]; ok {
+			obj["type"] = t
+		} else {
+			obj["type"] = strings.ToUpper(collection[:1]) + strings.TrimSuffix(collection[1:], "s")
+		}
+	}
+	obj["links"] = map[string]any{"self": m.URL + path + "/" + id}
+
+	if _, ok := m.objects[path+"/"+id]; !ok {
+		m.collections[path] = append(m.collections[path], id)
+	}
+	m.objects[path+"/"+id] = obj
+	return id
+}
+
+// insert moves newly stored ids, which are at the end of the collection, to the position requested by
+// the insertBefore or insertAfter query parameter (1-based index of an existing object), if any.
+func (m *mockFMC) insert(path string, ids []string, query url.Values) {
+	index, err := strconv.Atoi(query.Get("insertBefore"))
+	if err != nil {
+		if index, err = strconv.Atoi(query.Get("insertAfter")); err != nil {
+			return
+		}
+		index++
+	}
+	existing := m.collections[path][:len(m.collections[path])-len(ids)]
+	index = min(max(index-1, 0), len(existing))
+	m.collections[path] = slices.Concat(existing[:index], ids, existing[index:])
+}
+
+// remove deletes the object at path together with any nested collections below it.
+func (m *mockFMC) remove(path string) {
+	i := strings.LastIndex(path, "/")
+	collection, id := path[:i], path[i+1:]
+	ids := m.collections[collection]
+	for j := range ids {
+		if ids[j] == id {
+			m.collections[collection] = append(ids[:j:j], ids[j+1:]...)
+			break
+		}
+	}
+	delete(m.objects, path)
+	for k := range m.objects {
+		if strings.HasPrefix(k, path+"/") {
+			delete(m.objects, k)
+		}
+	}
+	for k := range m.collections {
+		if strings.HasPrefix(k, path+"/") {
+			delete(m.collections, k)
+		}
+	}
+}
+
+// usage implements the object usage (where used) endpoint for the object given by the uuid filter.
+func (m *mockFMC) usage(w http.ResponseWriter, r *http.Request, domain string) {
+	id := m.filter(r.URL.Query().Get("filter"))["uuid"]
+	items := []any{}
+	for path := range m.objects {
+		if strings.HasPrefix(path, m.domainPath(domain, "/")) && strings.HasSuffix(path, "/"+id) {
+			for _, obj := range m.referencedBy(path) {
+				items = append(items, map[string]any{"id": obj["id"], "name": obj["name"], "type": obj["type"], "links": obj["links"]})
+			}
+		}
+	}
+	m.writeJSON(w, http.StatusOK, map[string]any{"items": items, "paging": map[string]any{"count": len(items)}})
+}
+
+// referencedBy returns objects in the same domain, which reference the object at path by its ID, sorted by path.
+// Objects nested below path are not considered references.
+func (m *mockFMC) referencedBy(path string) []map[string]any {
+	i := strings.LastIndex(path, "/")
+	id := path[i+1:]
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 5 {
+		return nil
+	}
+	prefix := m.domainPath(segments[4], "/")
+
+	var paths []string
+	for k, obj := range m.objects {
+		if k == path || !strings.HasPrefix(k, prefix) || strings.HasPrefix(k, path+"/") {
+			continue
+		}
+		if mockFMCReferences(obj, id, true) {
+			paths = append(paths, k)
+		}
+	}
+	slices.Sort(paths)
+
+	var res []map[string]any
+	for _, k := range paths {
+		res = append(res, m.objects[k])
+	}
+	return res
+}
+
+// mockFMCReferences reports whether v contains an object with the given id. The top level id is skipped.
+func mockFMCReferences(v any, id string, top bool) bool {
+	switch v := v.(type) {
+	case map[string]any:
+		if !top && v["id"] == id {
+			return true
+		}
+		for k, e := range v {
+			if k != "links" && mockFMCReferences(e, id, false) {
+				return true
+			}
+		}
+	case []any:
+		for _, e := range v {
+			if mockFMCReferences(e, id, false) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filter parses an FMC filter query parameter of the form "key1:value1;key2:value2".
+func (m *mockFMC) filter(value string) map[string]string {
+	res := map[string]string{}
+	for _, part := range strings.Split(value, ";") {
+		if k, v, ok := strings.Cut(part, ":"); ok {
+			res[k] = strings.Trim(v, `"`)
+		}
+	}
+	return res
+}
+
+func (m *mockFMC) issueToken(w http.ResponseWriter) {
+	token := uuid.New().String()
+	m.tokens[token] = true
+
+	domains := []map[string]string{}
+	for name, id := range m.Domains {
+		domains = append(domains, map[string]string{"name": name, "uuid": id})
+	}
+	domainsJson, _ := json.Marshal(domains)
+
+	w.Header().Set("X-auth-access-token", token)
+	w.Header().Set("X-auth-refresh-token", uuid.New().String())
+	w.Header().Set("DOMAIN_UUID", mockFMCGlobalDomainUUID)
+	w.Header().Set("DOMAINS", string(domainsJson))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (m *mockFMC) authenticated(r *http.Request) bool {
+	if m.tokens[r.Header.Get("X-auth-access-token")] {
+		return true
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && m.tokens[token]
+}
+
+func (m *mockFMC) knownDomain(id string) bool {
+	for _, v := range m.Domains {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockFMC) writeJSON(w http.ResponseWriter, status int, body any) {
+	res, _ := json.Marshal(body)
+	w.WriteHeader(status)
+	_, _ = w.Write(res)
+}
+
+func (m *mockFMC) writeError(w http.ResponseWriter, status int, description string) {
+	body, _ := sjson.Set(`{"error":{"category":"FRAMEWORK","severity":"ERROR"}}`, "error.messages.0.description", description)
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(body))
+}
+
+// newMockFMCClient returns a client logged in to the mock server. Its rate limiter is disabled, so that
+// tests issuing many requests do not wait for tokens.
+func newMockFMCClient(t *testing.T, m *mockFMC) *fmc.Client {
+	t.Helper()
+
+	client, err := fmc.NewClient(m.URL, mockFMCUsername, mockFMCPassword, fmc.MaxRetries(0))
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+	client.RateLimiterBucket = ratelimit.NewBucketWithRate(unlimitedRate, unlimitedRateCapacity)
+	return &client
+}
+
+// testUnitResourceSchema returns the schema of resource r.
+func testUnitResourceSchema(ctx context.Context, r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// testUnitResourceUpdate calls Update of resource r with config, plan and prior state given as resource models.
+// If config is nil, the plan is used as configuration.
+func testUnitResourceUpdate(ctx context.Context, r resource.Resource, config, plan, state any) resource.UpdateResponse {
+	s := testUnitResourceSchema(ctx, r)
+	if config == nil {
+		config = plan
+	}
+	configState := tfsdk.State{Schema: s}
+	configState.Set(ctx, config)
+	req := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: configState.Raw},
+		Plan:   tfsdk.Plan{Schema: s},
+		State:  tfsdk.State{Schema: s},
+	}
+	req.Plan.Set(ctx, plan)
+	req.State.Set(ctx, state)
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: s}}
+	r.Update(ctx, req, &resp)
+	return resp
+}
+
+// testUnitResourceValidateConfig calls ValidateConfig of resource r with the configuration given as resource model.
+func testUnitResourceValidateConfig(ctx context.Context, r resource.ResourceWithValidateConfig, config any) resource.ValidateConfigResponse {
+	s := testUnitResourceSchema(ctx, r)
+	configState := tfsdk.State{Schema: s}
+	configState.Set(ctx, config)
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: configState.Raw}}, &resp)
+	return resp
+}
+
+// testUnitResourceDelete calls Delete of resource r with the prior state given as resource model.
+func testUnitResourceDelete(ctx context.Context, r resource.Resource, state any) resource.DeleteResponse {
+	req := resource.DeleteRequest{State: tfsdk.State{Schema: testUnitResourceSchema(ctx, r)}}
+	req.State.Set(ctx, state)
+	resp := resource.DeleteResponse{State: req.State}
+	r.Delete(ctx, req, &resp)
+	return resp
+}
+
+// testUnitDataSourceRead calls Read of data source d with the configuration given as data source model.
+func testUnitDataSourceRead(ctx context.Context, d datasource.DataSource, config any) datasource.ReadResponse {
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	configState.Set(ctx, config)
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw}}, &resp)
+	return resp
+}
+
+func TestUnitMockFMCAuthentication(t *testing.T) {
+	m := newMockFMC(t)
+
+	if _, err := fmc.NewClient(m.URL, mockFMCUsername, "wrong", fmc.MaxRetries(0)); err == nil {
+		t.Fatal("expected login with invalid credentials to fail")
+	}
+
+	leaf := m.AddDomain("Global/Leaf")
+	client := newMockFMCClient(t, m)
+	if client.FMCVersion != m.Version+" (build 1)" {
+		t.Errorf("unexpected FMC version %q", client.FMCVersion)
+	}
+	if client.DomainUUID != mockFMCGlobalDomainUUID {
+		t.Errorf("unexpected domain UUID %q", client.DomainUUID)
+	}
+	if client.Domains["Global/Leaf"] != leaf {
+		t.Errorf("leaf domain not returned at login, got %v", client.Domains)
+	}
+
+	if _, err := client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/hosts", fmc.DomainName("Global/Leaf")); err != nil {
+		t.Errorf("failed to read from leaf domain: %s", err)
+	}
+	if _, err := client.Get("/api/fmc_config/v1/domain/00000000-0000-0000-0000-000000000000/object/hosts"); err == nil {
+		t.Error("expected request to unknown domain to fail")
+	}
+}
+
+func TestUnitMockFMCObjectLifecycle(t *testing.T) {
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	path := "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/hosts"
+
+	res, err := client.Post(path, `{"name":"host1","value":"10.1.1.1"}`)
+	if err != nil {
+		t.Fatalf("failed to create object: %s", err)
+	}
+	id := res.Get("id").String()
+	if res.Get("type").String() != "Host" {
+		t.Errorf("unexpected type %q", res.Get("type").String())
+	}
+
+	if _, err := client.Put(path+"/"+id, `{"name":"host1","value":"10.1.1.2"}`); err != nil {
+		t.Fatalf("failed to update object: %s", err)
+	}
+	res, err = client.Get(path + "/" + id)
+	if err != nil {
+		t.Fatalf("failed to read object: %s", err)
+	}
+	if res.Get("value").String() != "10.1.1.2" || res.Get("id").String() != id {
+		t.Errorf("unexpected object after update: %s", res.String())
+	}
+
+	if _, err := client.Delete(path + "/" + id); err != nil {
+		t.Fatalf("failed to delete object: %s", err)
+	}
+	if _, err := client.Get(path + "/" + id); err == nil {
+		t.Error("expected read of deleted object to fail")
+	}
+	if m.Count("/object/hosts") != 0 {
+		t.Errorf("expected empty collection, got %d objects", m.Count("/object/hosts"))
+	}
+}
+
+func TestUnitMockFMCBulkAndPaging(t *testing.T) {
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	client.MaxItems = 10
+	path := "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/networks"
+
+	body := "[]"
+	for i := range 25 {
+		body, _ = sjson.Set(body, "-1", map[string]string{"name": fmt.Sprintf("net%d", i), "value": fmt.Sprintf("10.%d.0.0/16", i)})
+	}
+	res, err := client.Post(path+"?bulk=true", body)
+	if err != nil {
+		t.Fatalf("failed to bulk create objects: %s", err)
+	}
+	if n := len(res.Get("items").Array()); n != 25 {
+		t.Fatalf("expected 25 created objects, got %d", n)
+	}
+
+	res, err = client.Get(path + "?expanded=true")
+	if err != nil {
+		t.Fatalf("failed to list objects: %s", err)
+	}
+	if n := len(res.Get("items").Array()); n != 25 {
+		t.Errorf("expected 25 listed objects across pages, got %d", n)
+	}
+	if res.Get("items.24.value").String() != "10.24.0.0/16" {
+		t.Errorf("expected expanded items in creation order, got %s", res.Get("items.24").String())
+	}
+
+	res, err = client.Get(path + "?filter=name:net3")
+	if err != nil || res.Get("items.#").Int() != 1 || res.Get("items.0.value").Exists() {
+		t.Errorf("unexpected name filter result: %s, %v", res.String(), err)
+	}
+
+	ids := []string{}
+	for _, v := range res.Get("items.#.id").Array() {
+		ids = append(ids, v.String())
+	}
+	res, err = client.Delete(path + "?bulk=true&filter=ids:" + strings.Join(ids, ","))
+	if err != nil {
+		t.Fatalf("failed to bulk delete objects: %s", err)
+	}
+	if m.Count("/object/networks") != 24 {
+		t.Errorf("expected 24 remaining objects, got %d", m.Count("/object/networks"))
+	}
+}
+
+func TestUnitMockFMCTaskStatus(t *testing.T) {
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+
+	m.SetTaskStatus("12345", "Failed")
+	res, err := client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}/job/taskstatuses/12345")
+	if err != nil || res.Get("status").String() != "Failed" {
+		t.Errorf("unexpected task status: %s, %v", res.String(), err)
+	}
+	res, err = client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}/job/taskstatuses/67890")
+	if err != nil || res.Get("status").String() != "Success" {
+		t.Errorf("unexpected default task status: %s, %v", res.String(), err)
+	}
+}
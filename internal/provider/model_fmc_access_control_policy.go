@@ -57,6 +57,9 @@ type AccessControlPolicy struct {
 	DefaultActionIntrusionPolicyId  types.String                    `tfsdk:"default_action_intrusion_policy_id"`
 	DefaultActionVariableSetId      types.String                    `tfsdk:"default_action_variable_set_id"`
 	PrefilterPolicyId               types.String                    `tfsdk:"prefilter_policy_id"`
+	DecryptionPolicyId              types.String                    `tfsdk:"decryption_policy_id"`
+	DnsPolicyId                     types.String                    `tfsdk:"dns_policy_id"`
+	UmbrellaDnsPolicyId             types.String                    `tfsdk:"umbrella_dns_policy_id"`
 	ManageCategories                types.Bool                      `tfsdk:"manage_categories"`
 	Categories                      []AccessControlPolicyCategories `tfsdk:"categories"`
 	ManageRules                     types.Bool                      `tfsdk:"manage_rules"`
@@ -319,6 +322,15 @@ func (data AccessControlPolicy) toBody(ctx context.Context, state AccessControlP
 	if !data.PrefilterPolicyId.IsNull() {
 		body, _ = sjson.Set(body, "prefilterPolicySetting.id", data.PrefilterPolicyId.ValueString())
 	}
+	if !data.DecryptionPolicyId.IsNull() {
+		body, _ = sjson.Set(body, "sslPolicySetting.id", data.DecryptionPolicyId.ValueString())
+	}
+	if !data.DnsPolicyId.IsNull() {
+		body, _ = sjson.Set(body, "securityIntelligence.dnsPolicy.id", data.DnsPolicyId.ValueString())
+	}
+	if !data.UmbrellaDnsPolicyId.IsNull() {
+		body, _ = sjson.Set(body, "securityIntelligence.umbrellaDNSPolicy.id", data.UmbrellaDnsPolicyId.ValueString())
+	}
 	if !data.ManageCategories.IsNull() {
 		body, _ = sjson.Set(body, "dummy_manage_categories", data.ManageCategories.ValueBool())
 	}
@@ -847,6 +859,21 @@ func (data *AccessControlPolicy) fromBody(ctx context.Context, res gjson.Result)
 	} else {
 		data.PrefilterPolicyId = types.StringNull()
 	}
+	if value := res.Get("sslPolicySetting.id"); value.Exists() {
+		data.DecryptionPolicyId = types.StringValue(value.String())
+	} else {
+		data.DecryptionPolicyId = types.StringNull()
+	}
+	if value := res.Get("securityIntelligence.dnsPolicy.id"); value.Exists() {
+		data.DnsPolicyId = types.StringValue(value.String())
+	} else {
+		data.DnsPolicyId = types.StringNull()
+	}
+	if value := res.Get("securityIntelligence.umbrellaDNSPolicy.id"); value.Exists() {
+		data.UmbrellaDnsPolicyId = types.StringValue(value.String())
+	} else {
+		data.UmbrellaDnsPolicyId = types.StringNull()
+	}
 	if value := res.Get("dummy_manage_categories"); value.Exists() {
 		data.ManageCategories = types.BoolValue(value.Bool())
 	} else {
@@ -1562,6 +1589,21 @@ func (data *AccessControlPolicy) fromBodyPartial(ctx context.Context, res gjson.
 	} else {
 		data.PrefilterPolicyId = types.StringNull()
 	}
+	if value := res.Get("sslPolicySetting.id"); value.Exists() && !data.DecryptionPolicyId.IsNull() {
+		data.DecryptionPolicyId = types.StringValue(value.String())
+	} else {
+		data.DecryptionPolicyId = types.StringNull()
+	}
+	if value := res.Get("securityIntelligence.dnsPolicy.id"); value.Exists() && !data.DnsPolicyId.IsNull() {
+		data.DnsPolicyId = types.StringValue(value.String())
+	} else {
+		data.DnsPolicyId = types.StringNull()
+	}
+	if value := res.Get("securityIntelligence.umbrellaDNSPolicy.id"); value.Exists() && !data.UmbrellaDnsPolicyId.IsNull() {
+		data.UmbrellaDnsPolicyId = types.StringValue(value.String())
+	} else {
+		data.UmbrellaDnsPolicyId = types.StringNull()
+	}
 	if value := res.Get("dummy_manage_categories"); value.Exists() && !data.ManageCategories.IsNull() {
 		data.ManageCategories = types.BoolValue(value.Bool())
 	} else if data.ManageCategories.ValueBool() != true {
@@ -0,0 +1,155 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type AccessPolicyAnalysis struct {
+	Domain                types.String                   `tfsdk:"domain"`
+	AccessControlPolicyId types.String                   `tfsdk:"access_control_policy_id"`
+	Findings              []AccessPolicyAnalysisFindings `tfsdk:"findings"`
+}
+
+type AccessPolicyAnalysisFindings struct {
+	Kind             types.String `tfsdk:"kind"`
+	RuleId           types.String `tfsdk:"rule_id"`
+	RuleName         types.String `tfsdk:"rule_name"`
+	RuleIndex        types.Int64  `tfsdk:"rule_index"`
+	CoveringRuleId   types.String `tfsdk:"covering_rule_id"`
+	CoveringRuleName types.String `tfsdk:"covering_rule_name"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data AccessPolicyAnalysis) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/accesspolicies/%v/accessrules", url.QueryEscape(data.AccessControlPolicyId.ValueString()))
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *AccessPolicyAnalysis) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("findings"); value.Exists() {
+		data.Findings = make([]AccessPolicyAnalysisFindings, 0)
+		value.ForEach(func(k, res gjson.Result) bool {
+			parent := &data
+			data := AccessPolicyAnalysisFindings{}
+			if value := res.Get("kind"); value.Exists() {
+				data.Kind = types.StringValue(value.String())
+			} else {
+				data.Kind = types.StringNull()
+			}
+			if value := res.Get("ruleId"); value.Exists() {
+				data.RuleId = types.StringValue(value.String())
+			} else {
+				data.RuleId = types.StringNull()
+			}
+			if value := res.Get("ruleName"); value.Exists() {
+				data.RuleName = types.StringValue(value.String())
+			} else {
+				data.RuleName = types.StringNull()
+			}
+			if value := res.Get("ruleIndex"); value.Exists() {
+				data.RuleIndex = types.Int64Value(value.Int())
+			} else {
+				data.RuleIndex = types.Int64Null()
+			}
+			if value := res.Get("coveringRuleId"); value.Exists() {
+				data.CoveringRuleId = types.StringValue(value.String())
+			} else {
+				data.CoveringRuleId = types.StringNull()
+			}
+			if value := res.Get("coveringRuleName"); value.Exists() {
+				data.CoveringRuleName = types.StringValue(value.String())
+			} else {
+				data.CoveringRuleName = types.StringNull()
+			}
+			(*parent).Findings = append((*parent).Findings, data)
+			return true
+		})
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
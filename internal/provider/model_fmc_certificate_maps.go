@@ -36,9 +36,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type CertificateMaps struct {
-	Id     types.String                    `tfsdk:"id"`
-	Domain types.String                    `tfsdk:"domain"`
-	Items  map[string]CertificateMapsItems `tfsdk:"items"`
+	Id            types.String                    `tfsdk:"id"`
+	Domain        types.String                    `tfsdk:"domain"`
+	Items         map[string]CertificateMapsItems `tfsdk:"items"`
+	AdoptExisting types.Bool                      `tfsdk:"adopt_existing"`
 }
 
 type CertificateMapsItems struct {
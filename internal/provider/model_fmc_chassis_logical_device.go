@@ -25,6 +25,7 @@ import (
 	"slices"
 
 	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tidwall/gjson"
@@ -36,39 +37,42 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type ChassisLogicalDevice struct {
-	Id                    types.String                             `tfsdk:"id"`
-	Domain                types.String                             `tfsdk:"domain"`
-	ChassisId             types.String                             `tfsdk:"chassis_id"`
-	Type                  types.String                             `tfsdk:"type"`
-	DeviceId              types.String                             `tfsdk:"device_id"`
-	DeviceType            types.String                             `tfsdk:"device_type"`
-	Name                  types.String                             `tfsdk:"name"`
-	FtdVersion            types.String                             `tfsdk:"ftd_version"`
-	Ipv4Address           types.String                             `tfsdk:"ipv4_address"`
-	Ipv4Netmask           types.String                             `tfsdk:"ipv4_netmask"`
-	Ipv4Gateway           types.String                             `tfsdk:"ipv4_gateway"`
-	Ipv6Address           types.String                             `tfsdk:"ipv6_address"`
-	Ipv6Prefix            types.Int64                              `tfsdk:"ipv6_prefix"`
-	Ipv6Gateway           types.String                             `tfsdk:"ipv6_gateway"`
-	SearchDomain          types.String                             `tfsdk:"search_domain"`
-	Fqdn                  types.String                             `tfsdk:"fqdn"`
-	FirewallMode          types.String                             `tfsdk:"firewall_mode"`
-	DnsServers            types.String                             `tfsdk:"dns_servers"`
-	DevicePassword        types.String                             `tfsdk:"device_password"`
-	AdminState            types.String                             `tfsdk:"admin_state"`
-	PermitExpertMode      types.String                             `tfsdk:"permit_expert_mode"`
-	ResourceProfileId     types.String                             `tfsdk:"resource_profile_id"`
-	ResourceProfileName   types.String                             `tfsdk:"resource_profile_name"`
-	AssignedInterfaces    []ChassisLogicalDeviceAssignedInterfaces `tfsdk:"assigned_interfaces"`
-	DeviceGroupId         types.String                             `tfsdk:"device_group_id"`
-	AccessControlPolicyId types.String                             `tfsdk:"access_control_policy_id"`
-	PlatformSettingsId    types.String                             `tfsdk:"platform_settings_id"`
-	Licenses              types.Set                                `tfsdk:"licenses"`
-	ContainerId           types.String                             `tfsdk:"container_id"`
-	ContainerType         types.String                             `tfsdk:"container_type"`
-	ContainerName         types.String                             `tfsdk:"container_name"`
-	ContainerRole         types.String                             `tfsdk:"container_role"`
-	ContainerStatus       types.String                             `tfsdk:"container_status"`
+	Id                      types.String                             `tfsdk:"id"`
+	Domain                  types.String                             `tfsdk:"domain"`
+	ChassisId               types.String                             `tfsdk:"chassis_id"`
+	Type                    types.String                             `tfsdk:"type"`
+	DeviceId                types.String                             `tfsdk:"device_id"`
+	DeviceType              types.String                             `tfsdk:"device_type"`
+	Name                    types.String                             `tfsdk:"name"`
+	FtdVersion              types.String                             `tfsdk:"ftd_version"`
+	Ipv4Address             types.String                             `tfsdk:"ipv4_address"`
+	Ipv4Netmask             types.String                             `tfsdk:"ipv4_netmask"`
+	Ipv4Gateway             types.String                             `tfsdk:"ipv4_gateway"`
+	Ipv6Address             types.String                             `tfsdk:"ipv6_address"`
+	Ipv6Prefix              types.Int64                              `tfsdk:"ipv6_prefix"`
+	Ipv6Gateway             types.String                             `tfsdk:"ipv6_gateway"`
+	SearchDomain            types.String                             `tfsdk:"search_domain"`
+	Fqdn                    types.String                             `tfsdk:"fqdn"`
+	FirewallMode            types.String                             `tfsdk:"firewall_mode"`
+	DnsServers              types.String                             `tfsdk:"dns_servers"`
+	DevicePassword          types.String                             `tfsdk:"device_password"`
+	DevicePasswordWo        types.String                             `tfsdk:"device_password_wo"`
+	DevicePasswordWoVersion types.Int64                              `tfsdk:"device_password_wo_version"`
+	AdminState              types.String                             `tfsdk:"admin_state"`
+	PermitExpertMode        types.String                             `tfsdk:"permit_expert_mode"`
+	ResourceProfileId       types.String                             `tfsdk:"resource_profile_id"`
+	ResourceProfileName     types.String                             `tfsdk:"resource_profile_name"`
+	AssignedInterfaces      []ChassisLogicalDeviceAssignedInterfaces `tfsdk:"assigned_interfaces"`
+	DeviceGroupId           types.String                             `tfsdk:"device_group_id"`
+	AccessControlPolicyId   types.String                             `tfsdk:"access_control_policy_id"`
+	PlatformSettingsId      types.String                             `tfsdk:"platform_settings_id"`
+	Licenses                types.Set                                `tfsdk:"licenses"`
+	ContainerId             types.String                             `tfsdk:"container_id"`
+	ContainerType           types.String                             `tfsdk:"container_type"`
+	ContainerName           types.String                             `tfsdk:"container_name"`
+	ContainerRole           types.String                             `tfsdk:"container_role"`
+	ContainerStatus         types.String                             `tfsdk:"container_status"`
+	Timeouts                timeouts.Value                           `tfsdk:"timeouts"`
 }
 
 type ChassisLogicalDeviceAssignedInterfaces struct {
@@ -136,6 +140,9 @@ func (data ChassisLogicalDevice) toBody(ctx context.Context, state ChassisLogica
 	if !data.DevicePassword.IsNull() {
 		body, _ = sjson.Set(body, "managementBootstrap.adminPassword", data.DevicePassword.ValueString())
 	}
+	if !data.DevicePasswordWo.IsNull() {
+		body, _ = sjson.Set(body, "managementBootstrap.adminPassword", data.DevicePasswordWo.ValueString())
+	}
 	if !data.AdminState.IsNull() {
 		body, _ = sjson.Set(body, "adminState", data.AdminState.ValueString())
 	}
@@ -0,0 +1,345 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type DecryptionPolicy struct {
+	Id                                        types.String `tfsdk:"id"`
+	Domain                                    types.String `tfsdk:"domain"`
+	Name                                      types.String `tfsdk:"name"`
+	Description                               types.String `tfsdk:"description"`
+	Type                                      types.String `tfsdk:"type"`
+	DefaultAction                             types.String `tfsdk:"default_action"`
+	DefaultActionId                           types.String `tfsdk:"default_action_id"`
+	DefaultActionLogConnectionEnd             types.Bool   `tfsdk:"default_action_log_connection_end"`
+	DefaultActionSendEventsToFmc              types.Bool   `tfsdk:"default_action_send_events_to_fmc"`
+	DefaultActionSyslogAlertId                types.String `tfsdk:"default_action_syslog_alert_id"`
+	UndecryptableCompressedSessionAction      types.String `tfsdk:"undecryptable_compressed_session_action"`
+	UndecryptableSslv2SessionAction           types.String `tfsdk:"undecryptable_sslv2_session_action"`
+	UndecryptableUnknownCipherSuiteAction     types.String `tfsdk:"undecryptable_unknown_cipher_suite_action"`
+	UndecryptableUnsupportedCipherSuiteAction types.String `tfsdk:"undecryptable_unsupported_cipher_suite_action"`
+	UndecryptableSessionNotCachedAction       types.String `tfsdk:"undecryptable_session_not_cached_action"`
+	UndecryptableHandshakeErrorsAction        types.String `tfsdk:"undecryptable_handshake_errors_action"`
+	UndecryptableDecryptionErrorsAction       types.String `tfsdk:"undecryptable_decryption_errors_action"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+var minFMCVersionCreateDecryptionPolicy = version.Must(version.NewVersion("7.4"))
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data DecryptionPolicy) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/sslpolicies"
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+func (data DecryptionPolicy) toBody(ctx context.Context, state DecryptionPolicy) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	if !data.DefaultAction.IsNull() {
+		body, _ = sjson.Set(body, "defaultAction.action", data.DefaultAction.ValueString())
+	}
+	if !data.DefaultActionLogConnectionEnd.IsNull() {
+		body, _ = sjson.Set(body, "defaultAction.logEnd", data.DefaultActionLogConnectionEnd.ValueBool())
+	}
+	if !data.DefaultActionSendEventsToFmc.IsNull() {
+		body, _ = sjson.Set(body, "defaultAction.sendEventsToFMC", data.DefaultActionSendEventsToFmc.ValueBool())
+	}
+	if !data.DefaultActionSyslogAlertId.IsNull() {
+		body, _ = sjson.Set(body, "defaultAction.syslogConfig.id", data.DefaultActionSyslogAlertId.ValueString())
+	}
+	if !data.UndecryptableCompressedSessionAction.IsNull() {
+		body, _ = sjson.Set(body, "undecryptableActions.compressedSession", data.UndecryptableCompressedSessionAction.ValueString())
+	}
+	if !data.UndecryptableSslv2SessionAction.IsNull() {
+		body, _ = sjson.Set(body, "undecryptableActions.sslv2Session", data.UndecryptableSslv2SessionAction.ValueString())
+	}
+	if !data.UndecryptableUnknownCipherSuiteAction.IsNull() {
+		body, _ = sjson.Set(body, "undecryptableActions.unknownCipherSuite", data.UndecryptableUnknownCipherSuiteAction.ValueString())
+	}
+	if !data.UndecryptableUnsupportedCipherSuiteAction.IsNull() {
+		body, _ = sjson.Set(body, "undecryptableActions.unsupportedCipherSuite", data.UndecryptableUnsupportedCipherSuiteAction.ValueString())
+	}
+	if !data.UndecryptableSessionNotCachedAction.IsNull() {
+		body, _ = sjson.Set(body, "undecryptableActions.sessionNotCached", data.UndecryptableSessionNotCachedAction.ValueString())
+	}
+	if !data.UndecryptableHandshakeErrorsAction.IsNull() {
+		body, _ = sjson.Set(body, "undecryptableActions.handshakeErrors", data.UndecryptableHandshakeErrorsAction.ValueString())
+	}
+	if !data.UndecryptableDecryptionErrorsAction.IsNull() {
+		body, _ = sjson.Set(body, "undecryptableActions.decryptionErrors", data.UndecryptableDecryptionErrorsAction.ValueString())
+	}
+	return body
+}
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *DecryptionPolicy) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("type"); value.Exists() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	if value := res.Get("defaultAction.action"); value.Exists() {
+		data.DefaultAction = types.StringValue(value.String())
+	} else {
+		data.DefaultAction = types.StringNull()
+	}
+	if value := res.Get("defaultAction.id"); value.Exists() {
+		data.DefaultActionId = types.StringValue(value.String())
+	} else {
+		data.DefaultActionId = types.StringNull()
+	}
+	if value := res.Get("defaultAction.logEnd"); value.Exists() {
+		data.DefaultActionLogConnectionEnd = types.BoolValue(value.Bool())
+	} else {
+		data.DefaultActionLogConnectionEnd = types.BoolNull()
+	}
+	if value := res.Get("defaultAction.sendEventsToFMC"); value.Exists() {
+		data.DefaultActionSendEventsToFmc = types.BoolValue(value.Bool())
+	} else {
+		data.DefaultActionSendEventsToFmc = types.BoolNull()
+	}
+	if value := res.Get("defaultAction.syslogConfig.id"); value.Exists() {
+		data.DefaultActionSyslogAlertId = types.StringValue(value.String())
+	} else {
+		data.DefaultActionSyslogAlertId = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.compressedSession"); value.Exists() {
+		data.UndecryptableCompressedSessionAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableCompressedSessionAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.sslv2Session"); value.Exists() {
+		data.UndecryptableSslv2SessionAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableSslv2SessionAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.unknownCipherSuite"); value.Exists() {
+		data.UndecryptableUnknownCipherSuiteAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableUnknownCipherSuiteAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.unsupportedCipherSuite"); value.Exists() {
+		data.UndecryptableUnsupportedCipherSuiteAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableUnsupportedCipherSuiteAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.sessionNotCached"); value.Exists() {
+		data.UndecryptableSessionNotCachedAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableSessionNotCachedAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.handshakeErrors"); value.Exists() {
+		data.UndecryptableHandshakeErrorsAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableHandshakeErrorsAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.decryptionErrors"); value.Exists() {
+		data.UndecryptableDecryptionErrorsAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableDecryptionErrorsAction = types.StringNull()
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// fromBodyPartial reads values from a gjson.Result into a tfstate model. It ignores null attributes in order to
+// uncouple the provider from the exact values that the backend API might summon to replace nulls. (Such behavior might
+// easily change across versions of the backend API.) For List/Set/Map attributes, the func only updates the
+// "managed" elements, instead of all elements.
+func (data *DecryptionPolicy) fromBodyPartial(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	if value := res.Get("defaultAction.action"); value.Exists() && !data.DefaultAction.IsNull() {
+		data.DefaultAction = types.StringValue(value.String())
+	} else {
+		data.DefaultAction = types.StringNull()
+	}
+	if value := res.Get("defaultAction.id"); value.Exists() && !data.DefaultActionId.IsNull() {
+		data.DefaultActionId = types.StringValue(value.String())
+	} else {
+		data.DefaultActionId = types.StringNull()
+	}
+	if value := res.Get("defaultAction.logEnd"); value.Exists() && !data.DefaultActionLogConnectionEnd.IsNull() {
+		data.DefaultActionLogConnectionEnd = types.BoolValue(value.Bool())
+	} else {
+		data.DefaultActionLogConnectionEnd = types.BoolNull()
+	}
+	if value := res.Get("defaultAction.sendEventsToFMC"); value.Exists() && !data.DefaultActionSendEventsToFmc.IsNull() {
+		data.DefaultActionSendEventsToFmc = types.BoolValue(value.Bool())
+	} else {
+		data.DefaultActionSendEventsToFmc = types.BoolNull()
+	}
+	if value := res.Get("defaultAction.syslogConfig.id"); value.Exists() && !data.DefaultActionSyslogAlertId.IsNull() {
+		data.DefaultActionSyslogAlertId = types.StringValue(value.String())
+	} else {
+		data.DefaultActionSyslogAlertId = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.compressedSession"); value.Exists() && !data.UndecryptableCompressedSessionAction.IsNull() {
+		data.UndecryptableCompressedSessionAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableCompressedSessionAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.sslv2Session"); value.Exists() && !data.UndecryptableSslv2SessionAction.IsNull() {
+		data.UndecryptableSslv2SessionAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableSslv2SessionAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.unknownCipherSuite"); value.Exists() && !data.UndecryptableUnknownCipherSuiteAction.IsNull() {
+		data.UndecryptableUnknownCipherSuiteAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableUnknownCipherSuiteAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.unsupportedCipherSuite"); value.Exists() && !data.UndecryptableUnsupportedCipherSuiteAction.IsNull() {
+		data.UndecryptableUnsupportedCipherSuiteAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableUnsupportedCipherSuiteAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.sessionNotCached"); value.Exists() && !data.UndecryptableSessionNotCachedAction.IsNull() {
+		data.UndecryptableSessionNotCachedAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableSessionNotCachedAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.handshakeErrors"); value.Exists() && !data.UndecryptableHandshakeErrorsAction.IsNull() {
+		data.UndecryptableHandshakeErrorsAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableHandshakeErrorsAction = types.StringNull()
+	}
+	if value := res.Get("undecryptableActions.decryptionErrors"); value.Exists() && !data.UndecryptableDecryptionErrorsAction.IsNull() {
+		data.UndecryptableDecryptionErrorsAction = types.StringValue(value.String())
+	} else {
+		data.UndecryptableDecryptionErrorsAction = types.StringNull()
+	}
+}
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// fromBodyUnknowns updates the Unknown Computed tfstate values from a JSON.
+// Known values are not changed (usual for Computed attributes with UseStateForUnknown or with Default).
+func (data *DecryptionPolicy) fromBodyUnknowns(ctx context.Context, res gjson.Result) {
+	if data.Type.IsUnknown() {
+		if value := res.Get("type"); value.Exists() {
+			data.Type = types.StringValue(value.String())
+		} else {
+			data.Type = types.StringNull()
+		}
+	}
+	if data.DefaultActionId.IsUnknown() {
+		if value := res.Get("defaultAction.id"); value.Exists() {
+			data.DefaultActionId = types.StringValue(value.String())
+		} else {
+			data.DefaultActionId = types.StringNull()
+		}
+	}
+}
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
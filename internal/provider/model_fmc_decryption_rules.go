@@ -0,0 +1,909 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type DecryptionRules struct {
+	Id                 types.String           `tfsdk:"id"`
+	Domain             types.String           `tfsdk:"domain"`
+	DecryptionPolicyId types.String           `tfsdk:"decryption_policy_id"`
+	Items              []DecryptionRulesItems `tfsdk:"items"`
+}
+
+type DecryptionRulesItems struct {
+	Id                         types.String                                     `tfsdk:"id"`
+	Name                       types.String                                     `tfsdk:"name"`
+	Action                     types.String                                     `tfsdk:"action"`
+	Enabled                    types.Bool                                       `tfsdk:"enabled"`
+	CertificateAuthorityId     types.String                                     `tfsdk:"certificate_authority_id"`
+	ReplaceKeyOnly             types.Bool                                       `tfsdk:"replace_key_only"`
+	KnownKeyCertificates       []DecryptionRulesItemsKnownKeyCertificates       `tfsdk:"known_key_certificates"`
+	SourceZones                []DecryptionRulesItemsSourceZones                `tfsdk:"source_zones"`
+	DestinationZones           []DecryptionRulesItemsDestinationZones           `tfsdk:"destination_zones"`
+	SourceNetworkLiterals      []DecryptionRulesItemsSourceNetworkLiterals      `tfsdk:"source_network_literals"`
+	DestinationNetworkLiterals []DecryptionRulesItemsDestinationNetworkLiterals `tfsdk:"destination_network_literals"`
+	SourceNetworkObjects       []DecryptionRulesItemsSourceNetworkObjects       `tfsdk:"source_network_objects"`
+	DestinationNetworkObjects  []DecryptionRulesItemsDestinationNetworkObjects  `tfsdk:"destination_network_objects"`
+	DestinationPortObjects     []DecryptionRulesItemsDestinationPortObjects     `tfsdk:"destination_port_objects"`
+	LogConnectionEnd           types.Bool                                       `tfsdk:"log_connection_end"`
+	SendEventsToFmc            types.Bool                                       `tfsdk:"send_events_to_fmc"`
+	SyslogAlertId              types.String                                     `tfsdk:"syslog_alert_id"`
+}
+
+type DecryptionRulesItemsKnownKeyCertificates struct {
+	Id types.String `tfsdk:"id"`
+}
+type DecryptionRulesItemsSourceZones struct {
+	Id types.String `tfsdk:"id"`
+}
+type DecryptionRulesItemsDestinationZones struct {
+	Id types.String `tfsdk:"id"`
+}
+type DecryptionRulesItemsSourceNetworkLiterals struct {
+	Value types.String `tfsdk:"value"`
+}
+type DecryptionRulesItemsDestinationNetworkLiterals struct {
+	Value types.String `tfsdk:"value"`
+}
+type DecryptionRulesItemsSourceNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type DecryptionRulesItemsDestinationNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type DecryptionRulesItemsDestinationPortObjects struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+var minFMCVersionCreateDecryptionRules = version.Must(version.NewVersion("7.4"))
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data DecryptionRules) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/sslpolicies/%v/sslrules", url.QueryEscape(data.DecryptionPolicyId.ValueString()))
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+func (data DecryptionRules) toBody(ctx context.Context, state DecryptionRules) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if len(data.Items) > 0 {
+		body, _ = sjson.Set(body, "items", []any{})
+		for _, item := range data.Items {
+			itemBody := ""
+			if !item.Id.IsNull() && !item.Id.IsUnknown() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.Action.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "action", item.Action.ValueString())
+			}
+			if !item.Enabled.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "enabled", item.Enabled.ValueBool())
+			}
+			if !item.CertificateAuthorityId.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "internalCA.id", item.CertificateAuthorityId.ValueString())
+			}
+			if !item.ReplaceKeyOnly.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "replaceKey", item.ReplaceKeyOnly.ValueBool())
+			}
+			if len(item.KnownKeyCertificates) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "internalCertificates", []any{})
+				for _, childItem := range item.KnownKeyCertificates {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "InternalCertificate")
+					itemBody, _ = sjson.SetRaw(itemBody, "internalCertificates.-1", itemChildBody)
+				}
+			}
+			if len(item.SourceZones) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceZones.objects", []any{})
+				for _, childItem := range item.SourceZones {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "SecurityZone")
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceZones.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationZones) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "destinationZones.objects", []any{})
+				for _, childItem := range item.DestinationZones {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "SecurityZone")
+					itemBody, _ = sjson.SetRaw(itemBody, "destinationZones.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.SourceNetworkLiterals) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceNetworks.literals", []any{})
+				for _, childItem := range item.SourceNetworkLiterals {
+					itemChildBody := ""
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "AnyNonEmptyString")
+					if !childItem.Value.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "value", childItem.Value.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceNetworks.literals.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationNetworkLiterals) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "destinationNetworks.literals", []any{})
+				for _, childItem := range item.DestinationNetworkLiterals {
+					itemChildBody := ""
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "AnyNonEmptyString")
+					if !childItem.Value.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "value", childItem.Value.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "destinationNetworks.literals.-1", itemChildBody)
+				}
+			}
+			if len(item.SourceNetworkObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceNetworks.objects", []any{})
+				for _, childItem := range item.SourceNetworkObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceNetworks.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationNetworkObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "destinationNetworks.objects", []any{})
+				for _, childItem := range item.DestinationNetworkObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "destinationNetworks.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationPortObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "destinationPorts.objects", []any{})
+				for _, childItem := range item.DestinationPortObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "AnyNonEmptyString")
+					itemBody, _ = sjson.SetRaw(itemBody, "destinationPorts.objects.-1", itemChildBody)
+				}
+			}
+			if !item.LogConnectionEnd.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "logEnd", item.LogConnectionEnd.ValueBool())
+			}
+			if !item.SendEventsToFmc.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "sendEventsToFMC", item.SendEventsToFmc.ValueBool())
+			}
+			if !item.SyslogAlertId.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "syslogConfig.id", item.SyslogAlertId.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "items.-1", itemBody)
+		}
+	}
+	return body
+}
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *DecryptionRules) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("items"); value.Exists() {
+		data.Items = make([]DecryptionRulesItems, 0)
+		value.ForEach(func(k, res gjson.Result) bool {
+			parent := &data
+			data := DecryptionRulesItems{}
+			if value := res.Get("id"); value.Exists() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("name"); value.Exists() {
+				data.Name = types.StringValue(value.String())
+			} else {
+				data.Name = types.StringNull()
+			}
+			if value := res.Get("action"); value.Exists() {
+				data.Action = types.StringValue(value.String())
+			} else {
+				data.Action = types.StringNull()
+			}
+			if value := res.Get("enabled"); value.Exists() {
+				data.Enabled = types.BoolValue(value.Bool())
+			} else {
+				data.Enabled = types.BoolValue(true)
+			}
+			if value := res.Get("internalCA.id"); value.Exists() {
+				data.CertificateAuthorityId = types.StringValue(value.String())
+			} else {
+				data.CertificateAuthorityId = types.StringNull()
+			}
+			if value := res.Get("replaceKey"); value.Exists() {
+				data.ReplaceKeyOnly = types.BoolValue(value.Bool())
+			} else {
+				data.ReplaceKeyOnly = types.BoolNull()
+			}
+			if value := res.Get("internalCertificates"); value.Exists() {
+				data.KnownKeyCertificates = make([]DecryptionRulesItemsKnownKeyCertificates, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DecryptionRulesItemsKnownKeyCertificates{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					(*parent).KnownKeyCertificates = append((*parent).KnownKeyCertificates, data)
+					return true
+				})
+			}
+			if value := res.Get("sourceZones.objects"); value.Exists() {
+				data.SourceZones = make([]DecryptionRulesItemsSourceZones, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DecryptionRulesItemsSourceZones{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					(*parent).SourceZones = append((*parent).SourceZones, data)
+					return true
+				})
+			}
+			if value := res.Get("destinationZones.objects"); value.Exists() {
+				data.DestinationZones = make([]DecryptionRulesItemsDestinationZones, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DecryptionRulesItemsDestinationZones{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					(*parent).DestinationZones = append((*parent).DestinationZones, data)
+					return true
+				})
+			}
+			if value := res.Get("sourceNetworks.literals"); value.Exists() {
+				data.SourceNetworkLiterals = make([]DecryptionRulesItemsSourceNetworkLiterals, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DecryptionRulesItemsSourceNetworkLiterals{}
+					if value := res.Get("value"); value.Exists() {
+						data.Value = types.StringValue(value.String())
+					} else {
+						data.Value = types.StringNull()
+					}
+					(*parent).SourceNetworkLiterals = append((*parent).SourceNetworkLiterals, data)
+					return true
+				})
+			}
+			if value := res.Get("destinationNetworks.literals"); value.Exists() {
+				data.DestinationNetworkLiterals = make([]DecryptionRulesItemsDestinationNetworkLiterals, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DecryptionRulesItemsDestinationNetworkLiterals{}
+					if value := res.Get("value"); value.Exists() {
+						data.Value = types.StringValue(value.String())
+					} else {
+						data.Value = types.StringNull()
+					}
+					(*parent).DestinationNetworkLiterals = append((*parent).DestinationNetworkLiterals, data)
+					return true
+				})
+			}
+			if value := res.Get("sourceNetworks.objects"); value.Exists() {
+				data.SourceNetworkObjects = make([]DecryptionRulesItemsSourceNetworkObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DecryptionRulesItemsSourceNetworkObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).SourceNetworkObjects = append((*parent).SourceNetworkObjects, data)
+					return true
+				})
+			}
+			if value := res.Get("destinationNetworks.objects"); value.Exists() {
+				data.DestinationNetworkObjects = make([]DecryptionRulesItemsDestinationNetworkObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DecryptionRulesItemsDestinationNetworkObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).DestinationNetworkObjects = append((*parent).DestinationNetworkObjects, data)
+					return true
+				})
+			}
+			if value := res.Get("destinationPorts.objects"); value.Exists() {
+				data.DestinationPortObjects = make([]DecryptionRulesItemsDestinationPortObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DecryptionRulesItemsDestinationPortObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					(*parent).DestinationPortObjects = append((*parent).DestinationPortObjects, data)
+					return true
+				})
+			}
+			if value := res.Get("logEnd"); value.Exists() {
+				data.LogConnectionEnd = types.BoolValue(value.Bool())
+			} else {
+				data.LogConnectionEnd = types.BoolValue(false)
+			}
+			if value := res.Get("sendEventsToFMC"); value.Exists() {
+				data.SendEventsToFmc = types.BoolValue(value.Bool())
+			} else {
+				data.SendEventsToFmc = types.BoolValue(false)
+			}
+			if value := res.Get("syslogConfig.id"); value.Exists() {
+				data.SyslogAlertId = types.StringValue(value.String())
+			} else {
+				data.SyslogAlertId = types.StringNull()
+			}
+			(*parent).Items = append((*parent).Items, data)
+			return true
+		})
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// fromBodyPartial reads values from a gjson.Result into a tfstate model. It ignores null attributes in order to
+// uncouple the provider from the exact values that the backend API might summon to replace nulls. (Such behavior might
+// easily change across versions of the backend API.) For List/Set/Map attributes, the func only updates the
+// "managed" elements, instead of all elements.
+func (data *DecryptionRules) fromBodyPartial(ctx context.Context, res gjson.Result) {
+	{
+		l := len(res.Get("items").Array())
+		tflog.Debug(ctx, fmt.Sprintf("items array resizing from %d to %d", len(data.Items), l))
+		for i := len(data.Items); i < l; i++ {
+			data.Items = append(data.Items, DecryptionRulesItems{})
+		}
+		if len(data.Items) > l {
+			data.Items = data.Items[:l]
+		}
+	}
+	for i := range data.Items {
+		parent := &data
+		data := (*parent).Items[i]
+		parentRes := &res
+		res := parentRes.Get(fmt.Sprintf("items.%d", i))
+		if value := res.Get("id"); value.Exists() {
+			data.Id = types.StringValue(value.String())
+		} else {
+			data.Id = types.StringNull()
+		}
+		if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+			data.Name = types.StringValue(value.String())
+		} else {
+			data.Name = types.StringNull()
+		}
+		if value := res.Get("action"); value.Exists() && !data.Action.IsNull() {
+			data.Action = types.StringValue(value.String())
+		} else {
+			data.Action = types.StringNull()
+		}
+		if value := res.Get("enabled"); value.Exists() && !data.Enabled.IsNull() {
+			data.Enabled = types.BoolValue(value.Bool())
+		} else if data.Enabled.ValueBool() != true {
+			data.Enabled = types.BoolNull()
+		}
+		if value := res.Get("internalCA.id"); value.Exists() && !data.CertificateAuthorityId.IsNull() {
+			data.CertificateAuthorityId = types.StringValue(value.String())
+		} else {
+			data.CertificateAuthorityId = types.StringNull()
+		}
+		if value := res.Get("replaceKey"); value.Exists() && !data.ReplaceKeyOnly.IsNull() {
+			data.ReplaceKeyOnly = types.BoolValue(value.Bool())
+		} else {
+			data.ReplaceKeyOnly = types.BoolNull()
+		}
+		for i := 0; i < len(data.KnownKeyCertificates); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.KnownKeyCertificates[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).KnownKeyCertificates[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("internalCertificates").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing KnownKeyCertificates[%d] = %+v",
+					i,
+					(*parent).KnownKeyCertificates[i],
+				))
+				(*parent).KnownKeyCertificates = slices.Delete((*parent).KnownKeyCertificates, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).KnownKeyCertificates[i] = data
+		}
+		for i := 0; i < len(data.SourceZones); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.SourceZones[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceZones[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceZones.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceZones[%d] = %+v",
+					i,
+					(*parent).SourceZones[i],
+				))
+				(*parent).SourceZones = slices.Delete((*parent).SourceZones, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).SourceZones[i] = data
+		}
+		for i := 0; i < len(data.DestinationZones); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.DestinationZones[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationZones[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("destinationZones.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationZones[%d] = %+v",
+					i,
+					(*parent).DestinationZones[i],
+				))
+				(*parent).DestinationZones = slices.Delete((*parent).DestinationZones, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).DestinationZones[i] = data
+		}
+		for i := 0; i < len(data.SourceNetworkLiterals); i++ {
+			keys := [...]string{"value"}
+			keyValues := [...]string{data.SourceNetworkLiterals[i].Value.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceNetworkLiterals[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceNetworks.literals").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceNetworkLiterals[%d] = %+v",
+					i,
+					(*parent).SourceNetworkLiterals[i],
+				))
+				(*parent).SourceNetworkLiterals = slices.Delete((*parent).SourceNetworkLiterals, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("value"); value.Exists() && !data.Value.IsNull() {
+				data.Value = types.StringValue(value.String())
+			} else {
+				data.Value = types.StringNull()
+			}
+			(*parent).SourceNetworkLiterals[i] = data
+		}
+		for i := 0; i < len(data.DestinationNetworkLiterals); i++ {
+			keys := [...]string{"value"}
+			keyValues := [...]string{data.DestinationNetworkLiterals[i].Value.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationNetworkLiterals[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("destinationNetworks.literals").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationNetworkLiterals[%d] = %+v",
+					i,
+					(*parent).DestinationNetworkLiterals[i],
+				))
+				(*parent).DestinationNetworkLiterals = slices.Delete((*parent).DestinationNetworkLiterals, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("value"); value.Exists() && !data.Value.IsNull() {
+				data.Value = types.StringValue(value.String())
+			} else {
+				data.Value = types.StringNull()
+			}
+			(*parent).DestinationNetworkLiterals[i] = data
+		}
+		for i := 0; i < len(data.SourceNetworkObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.SourceNetworkObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceNetworkObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceNetworks.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceNetworkObjects[%d] = %+v",
+					i,
+					(*parent).SourceNetworkObjects[i],
+				))
+				(*parent).SourceNetworkObjects = slices.Delete((*parent).SourceNetworkObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).SourceNetworkObjects[i] = data
+		}
+		for i := 0; i < len(data.DestinationNetworkObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.DestinationNetworkObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationNetworkObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("destinationNetworks.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationNetworkObjects[%d] = %+v",
+					i,
+					(*parent).DestinationNetworkObjects[i],
+				))
+				(*parent).DestinationNetworkObjects = slices.Delete((*parent).DestinationNetworkObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).DestinationNetworkObjects[i] = data
+		}
+		for i := 0; i < len(data.DestinationPortObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.DestinationPortObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationPortObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("destinationPorts.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationPortObjects[%d] = %+v",
+					i,
+					(*parent).DestinationPortObjects[i],
+				))
+				(*parent).DestinationPortObjects = slices.Delete((*parent).DestinationPortObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).DestinationPortObjects[i] = data
+		}
+		if value := res.Get("logEnd"); value.Exists() && !data.LogConnectionEnd.IsNull() {
+			data.LogConnectionEnd = types.BoolValue(value.Bool())
+		} else if data.LogConnectionEnd.ValueBool() != false {
+			data.LogConnectionEnd = types.BoolNull()
+		}
+		if value := res.Get("sendEventsToFMC"); value.Exists() && !data.SendEventsToFmc.IsNull() {
+			data.SendEventsToFmc = types.BoolValue(value.Bool())
+		} else if data.SendEventsToFmc.ValueBool() != false {
+			data.SendEventsToFmc = types.BoolNull()
+		}
+		if value := res.Get("syslogConfig.id"); value.Exists() && !data.SyslogAlertId.IsNull() {
+			data.SyslogAlertId = types.StringValue(value.String())
+		} else {
+			data.SyslogAlertId = types.StringNull()
+		}
+		(*parent).Items[i] = data
+	}
+}
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// fromBodyUnknowns updates the Unknown Computed tfstate values from a JSON.
+// Known values are not changed (usual for Computed attributes with UseStateForUnknown or with Default).
+func (data *DecryptionRules) fromBodyUnknowns(ctx context.Context, res gjson.Result) {
+	for i := range data.Items {
+		r := res.Get(fmt.Sprintf("items.%d", i))
+		if v := data.Items[i]; v.Id.IsUnknown() {
+			if value := r.Get("id"); value.Exists() {
+				v.Id = types.StringValue(value.String())
+			} else {
+				v.Id = types.StringNull()
+			}
+			data.Items[i] = v
+		}
+	}
+}
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
@@ -23,6 +23,7 @@ import (
 	"fmt"
 
 	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tidwall/gjson"
@@ -34,29 +35,32 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type Device struct {
-	Id                     types.String `tfsdk:"id"`
-	Domain                 types.String `tfsdk:"domain"`
-	Name                   types.String `tfsdk:"name"`
-	Type                   types.String `tfsdk:"type"`
-	Host                   types.String `tfsdk:"host"`
-	NatId                  types.String `tfsdk:"nat_id"`
-	Licenses               types.Set    `tfsdk:"licenses"`
-	RegistrationKey        types.String `tfsdk:"registration_key"`
-	DeviceGroupId          types.String `tfsdk:"device_group_id"`
-	ProhibitPacketTransfer types.Bool   `tfsdk:"prohibit_packet_transfer"`
-	PerformanceTier        types.String `tfsdk:"performance_tier"`
-	SnortEngine            types.String `tfsdk:"snort_engine"`
-	ObjectGroupSearch      types.Bool   `tfsdk:"object_group_search"`
-	AccessControlPolicyId  types.String `tfsdk:"access_control_policy_id"`
-	NatPolicyId            types.String `tfsdk:"nat_policy_id"`
-	HealthPolicyId         types.String `tfsdk:"health_policy_id"`
-	ContainerId            types.String `tfsdk:"container_id"`
-	ContainerType          types.String `tfsdk:"container_type"`
-	ContainerName          types.String `tfsdk:"container_name"`
-	ContainerRole          types.String `tfsdk:"container_role"`
-	ContainerStatus        types.String `tfsdk:"container_status"`
-	IsPartOfContainer      types.Bool   `tfsdk:"is_part_of_container"`
-	IsMultiInstance        types.Bool   `tfsdk:"is_multi_instance"`
+	Id                       types.String   `tfsdk:"id"`
+	Domain                   types.String   `tfsdk:"domain"`
+	Name                     types.String   `tfsdk:"name"`
+	Type                     types.String   `tfsdk:"type"`
+	Host                     types.String   `tfsdk:"host"`
+	NatId                    types.String   `tfsdk:"nat_id"`
+	Licenses                 types.Set      `tfsdk:"licenses"`
+	RegistrationKey          types.String   `tfsdk:"registration_key"`
+	RegistrationKeyWo        types.String   `tfsdk:"registration_key_wo"`
+	RegistrationKeyWoVersion types.Int64    `tfsdk:"registration_key_wo_version"`
+	DeviceGroupId            types.String   `tfsdk:"device_group_id"`
+	ProhibitPacketTransfer   types.Bool     `tfsdk:"prohibit_packet_transfer"`
+	PerformanceTier          types.String   `tfsdk:"performance_tier"`
+	SnortEngine              types.String   `tfsdk:"snort_engine"`
+	ObjectGroupSearch        types.Bool     `tfsdk:"object_group_search"`
+	AccessControlPolicyId    types.String   `tfsdk:"access_control_policy_id"`
+	NatPolicyId              types.String   `tfsdk:"nat_policy_id"`
+	HealthPolicyId           types.String   `tfsdk:"health_policy_id"`
+	ContainerId              types.String   `tfsdk:"container_id"`
+	ContainerType            types.String   `tfsdk:"container_type"`
+	ContainerName            types.String   `tfsdk:"container_name"`
+	ContainerRole            types.String   `tfsdk:"container_role"`
+	ContainerStatus          types.String   `tfsdk:"container_status"`
+	IsPartOfContainer        types.Bool     `tfsdk:"is_part_of_container"`
+	IsMultiInstance          types.Bool     `tfsdk:"is_multi_instance"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 // End of section. //template:end types
@@ -98,6 +102,9 @@ func (data Device) toBody(ctx context.Context, state Device) string {
 	if !data.RegistrationKey.IsNull() {
 		body, _ = sjson.Set(body, "regKey", data.RegistrationKey.ValueString())
 	}
+	if !data.RegistrationKeyWo.IsNull() {
+		body, _ = sjson.Set(body, "regKey", data.RegistrationKeyWo.ValueString())
+	}
 	if !data.DeviceGroupId.IsNull() {
 		body, _ = sjson.Set(body, "deviceGroup.id", data.DeviceGroupId.ValueString())
 	}
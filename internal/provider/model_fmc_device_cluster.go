@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tidwall/gjson"
@@ -48,6 +49,7 @@ type DeviceCluster struct {
 	ControlNodeCclIpv4Address types.String             `tfsdk:"control_node_ccl_ipv4_address"`
 	ControlNodePriority       types.Int64              `tfsdk:"control_node_priority"`
 	DataNodes                 []DeviceClusterDataNodes `tfsdk:"data_nodes"`
+	Timeouts                  timeouts.Value           `tfsdk:"timeouts"`
 }
 
 type DeviceClusterDataNodes struct {
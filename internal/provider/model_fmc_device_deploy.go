@@ -21,6 +21,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tidwall/sjson"
 )
@@ -30,12 +31,13 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type DeviceDeploy struct {
-	Id             types.String `tfsdk:"id"`
-	Domain         types.String `tfsdk:"domain"`
-	Version        types.String `tfsdk:"version"`
-	IgnoreWarning  types.Bool   `tfsdk:"ignore_warning"`
-	DeviceIdList   types.List   `tfsdk:"device_id_list"`
-	DeploymentNote types.String `tfsdk:"deployment_note"`
+	Id             types.String   `tfsdk:"id"`
+	Domain         types.String   `tfsdk:"domain"`
+	Version        types.String   `tfsdk:"version"`
+	IgnoreWarning  types.Bool     `tfsdk:"ignore_warning"`
+	DeviceIdList   types.List     `tfsdk:"device_id_list"`
+	DeploymentNote types.String   `tfsdk:"deployment_note"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 // End of section. //template:end types
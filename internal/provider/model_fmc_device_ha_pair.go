@@ -21,6 +21,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
@@ -31,42 +32,43 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type DeviceHAPair struct {
-	Id                            types.String `tfsdk:"id"`
-	Domain                        types.String `tfsdk:"domain"`
-	Name                          types.String `tfsdk:"name"`
-	Type                          types.String `tfsdk:"type"`
-	PrimaryDeviceId               types.String `tfsdk:"primary_device_id"`
-	SecondaryDeviceId             types.String `tfsdk:"secondary_device_id"`
-	HaLinkInterfaceId             types.String `tfsdk:"ha_link_interface_id"`
-	HaLinkInterfaceName           types.String `tfsdk:"ha_link_interface_name"`
-	HaLinkInterfaceType           types.String `tfsdk:"ha_link_interface_type"`
-	HaLinkLogicalName             types.String `tfsdk:"ha_link_logical_name"`
-	HaLinkUseIpv6                 types.Bool   `tfsdk:"ha_link_use_ipv6"`
-	HaLinkPrimaryIp               types.String `tfsdk:"ha_link_primary_ip"`
-	HaLinkSecondaryIp             types.String `tfsdk:"ha_link_secondary_ip"`
-	HaLinkNetmask                 types.String `tfsdk:"ha_link_netmask"`
-	StateLinkUseSameAsHa          types.Bool   `tfsdk:"state_link_use_same_as_ha"`
-	StateLinkInterfaceId          types.String `tfsdk:"state_link_interface_id"`
-	StateLinkInterfaceName        types.String `tfsdk:"state_link_interface_name"`
-	StateLinkInterfaceType        types.String `tfsdk:"state_link_interface_type"`
-	StateLinkLogicalName          types.String `tfsdk:"state_link_logical_name"`
-	StateLinkUseIpv6              types.Bool   `tfsdk:"state_link_use_ipv6"`
-	StateLinkPrimaryIp            types.String `tfsdk:"state_link_primary_ip"`
-	StateLinkSecondaryIp          types.String `tfsdk:"state_link_secondary_ip"`
-	StateLinkNetmask              types.String `tfsdk:"state_link_netmask"`
-	EncryptionEnabled             types.Bool   `tfsdk:"encryption_enabled"`
-	EncryptionKeyGenerationScheme types.String `tfsdk:"encryption_key_generation_scheme"`
-	EncryptionKey                 types.String `tfsdk:"encryption_key"`
-	FailedInterfacesPercent       types.Int64  `tfsdk:"failed_interfaces_percent"`
-	FailedInterfacesLimit         types.Int64  `tfsdk:"failed_interfaces_limit"`
-	PeerPollTime                  types.Int64  `tfsdk:"peer_poll_time"`
-	PeerPollTimeUnit              types.String `tfsdk:"peer_poll_time_unit"`
-	PeerHoldTime                  types.Int64  `tfsdk:"peer_hold_time"`
-	PeerHoldTimeUnit              types.String `tfsdk:"peer_hold_time_unit"`
-	InterfacePollTime             types.Int64  `tfsdk:"interface_poll_time"`
-	InterfacePollTimeUnit         types.String `tfsdk:"interface_poll_time_unit"`
-	InterfaceHoldTime             types.Int64  `tfsdk:"interface_hold_time"`
-	Action                        types.String `tfsdk:"action"`
+	Id                            types.String   `tfsdk:"id"`
+	Domain                        types.String   `tfsdk:"domain"`
+	Name                          types.String   `tfsdk:"name"`
+	Type                          types.String   `tfsdk:"type"`
+	PrimaryDeviceId               types.String   `tfsdk:"primary_device_id"`
+	SecondaryDeviceId             types.String   `tfsdk:"secondary_device_id"`
+	HaLinkInterfaceId             types.String   `tfsdk:"ha_link_interface_id"`
+	HaLinkInterfaceName           types.String   `tfsdk:"ha_link_interface_name"`
+	HaLinkInterfaceType           types.String   `tfsdk:"ha_link_interface_type"`
+	HaLinkLogicalName             types.String   `tfsdk:"ha_link_logical_name"`
+	HaLinkUseIpv6                 types.Bool     `tfsdk:"ha_link_use_ipv6"`
+	HaLinkPrimaryIp               types.String   `tfsdk:"ha_link_primary_ip"`
+	HaLinkSecondaryIp             types.String   `tfsdk:"ha_link_secondary_ip"`
+	HaLinkNetmask                 types.String   `tfsdk:"ha_link_netmask"`
+	StateLinkUseSameAsHa          types.Bool     `tfsdk:"state_link_use_same_as_ha"`
+	StateLinkInterfaceId          types.String   `tfsdk:"state_link_interface_id"`
+	StateLinkInterfaceName        types.String   `tfsdk:"state_link_interface_name"`
+	StateLinkInterfaceType        types.String   `tfsdk:"state_link_interface_type"`
+	StateLinkLogicalName          types.String   `tfsdk:"state_link_logical_name"`
+	StateLinkUseIpv6              types.Bool     `tfsdk:"state_link_use_ipv6"`
+	StateLinkPrimaryIp            types.String   `tfsdk:"state_link_primary_ip"`
+	StateLinkSecondaryIp          types.String   `tfsdk:"state_link_secondary_ip"`
+	StateLinkNetmask              types.String   `tfsdk:"state_link_netmask"`
+	EncryptionEnabled             types.Bool     `tfsdk:"encryption_enabled"`
+	EncryptionKeyGenerationScheme types.String   `tfsdk:"encryption_key_generation_scheme"`
+	EncryptionKey                 types.String   `tfsdk:"encryption_key"`
+	FailedInterfacesPercent       types.Int64    `tfsdk:"failed_interfaces_percent"`
+	FailedInterfacesLimit         types.Int64    `tfsdk:"failed_interfaces_limit"`
+	PeerPollTime                  types.Int64    `tfsdk:"peer_poll_time"`
+	PeerPollTimeUnit              types.String   `tfsdk:"peer_poll_time_unit"`
+	PeerHoldTime                  types.Int64    `tfsdk:"peer_hold_time"`
+	PeerHoldTimeUnit              types.String   `tfsdk:"peer_hold_time_unit"`
+	InterfacePollTime             types.Int64    `tfsdk:"interface_poll_time"`
+	InterfacePollTimeUnit         types.String   `tfsdk:"interface_poll_time_unit"`
+	InterfaceHoldTime             types.Int64    `tfsdk:"interface_hold_time"`
+	Action                        types.String   `tfsdk:"action"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // End of section. //template:end types
@@ -0,0 +1,563 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type DNSRules struct {
+	Id          types.String    `tfsdk:"id"`
+	Domain      types.String    `tfsdk:"domain"`
+	DnsPolicyId types.String    `tfsdk:"dns_policy_id"`
+	Items       []DNSRulesItems `tfsdk:"items"`
+}
+
+type DNSRulesItems struct {
+	Id                    types.String                         `tfsdk:"id"`
+	Name                  types.String                         `tfsdk:"name"`
+	Action                types.String                         `tfsdk:"action"`
+	Enabled               types.Bool                           `tfsdk:"enabled"`
+	SinkholeId            types.String                         `tfsdk:"sinkhole_id"`
+	DnsLists              []DNSRulesItemsDnsLists              `tfsdk:"dns_lists"`
+	SourceZones           []DNSRulesItemsSourceZones           `tfsdk:"source_zones"`
+	SourceNetworkLiterals []DNSRulesItemsSourceNetworkLiterals `tfsdk:"source_network_literals"`
+	SourceNetworkObjects  []DNSRulesItemsSourceNetworkObjects  `tfsdk:"source_network_objects"`
+}
+
+type DNSRulesItemsDnsLists struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type DNSRulesItemsSourceZones struct {
+	Id types.String `tfsdk:"id"`
+}
+type DNSRulesItemsSourceNetworkLiterals struct {
+	Value types.String `tfsdk:"value"`
+}
+type DNSRulesItemsSourceNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data DNSRules) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/dnspolicies/%v/dnsrules", url.QueryEscape(data.DnsPolicyId.ValueString()))
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+func (data DNSRules) toBody(ctx context.Context, state DNSRules) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if len(data.Items) > 0 {
+		body, _ = sjson.Set(body, "items", []any{})
+		for _, item := range data.Items {
+			itemBody := ""
+			if !item.Id.IsNull() && !item.Id.IsUnknown() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.Action.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "action", item.Action.ValueString())
+			}
+			if !item.Enabled.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "enabled", item.Enabled.ValueBool())
+			}
+			if !item.SinkholeId.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "sinkhole.id", item.SinkholeId.ValueString())
+			}
+			if len(item.DnsLists) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "dnsLists.objects", []any{})
+				for _, childItem := range item.DnsLists {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "dnsLists.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.SourceZones) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceZones.objects", []any{})
+				for _, childItem := range item.SourceZones {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "SecurityZone")
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceZones.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.SourceNetworkLiterals) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceNetworks.literals", []any{})
+				for _, childItem := range item.SourceNetworkLiterals {
+					itemChildBody := ""
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "AnyNonEmptyString")
+					if !childItem.Value.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "value", childItem.Value.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceNetworks.literals.-1", itemChildBody)
+				}
+			}
+			if len(item.SourceNetworkObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceNetworks.objects", []any{})
+				for _, childItem := range item.SourceNetworkObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceNetworks.objects.-1", itemChildBody)
+				}
+			}
+			body, _ = sjson.SetRaw(body, "items.-1", itemBody)
+		}
+	}
+	return body
+}
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *DNSRules) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("items"); value.Exists() {
+		data.Items = make([]DNSRulesItems, 0)
+		value.ForEach(func(k, res gjson.Result) bool {
+			parent := &data
+			data := DNSRulesItems{}
+			if value := res.Get("id"); value.Exists() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("name"); value.Exists() {
+				data.Name = types.StringValue(value.String())
+			} else {
+				data.Name = types.StringNull()
+			}
+			if value := res.Get("action"); value.Exists() {
+				data.Action = types.StringValue(value.String())
+			} else {
+				data.Action = types.StringNull()
+			}
+			if value := res.Get("enabled"); value.Exists() {
+				data.Enabled = types.BoolValue(value.Bool())
+			} else {
+				data.Enabled = types.BoolValue(true)
+			}
+			if value := res.Get("sinkhole.id"); value.Exists() {
+				data.SinkholeId = types.StringValue(value.String())
+			} else {
+				data.SinkholeId = types.StringNull()
+			}
+			if value := res.Get("dnsLists.objects"); value.Exists() {
+				data.DnsLists = make([]DNSRulesItemsDnsLists, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DNSRulesItemsDnsLists{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).DnsLists = append((*parent).DnsLists, data)
+					return true
+				})
+			}
+			if value := res.Get("sourceZones.objects"); value.Exists() {
+				data.SourceZones = make([]DNSRulesItemsSourceZones, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DNSRulesItemsSourceZones{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					(*parent).SourceZones = append((*parent).SourceZones, data)
+					return true
+				})
+			}
+			if value := res.Get("sourceNetworks.literals"); value.Exists() {
+				data.SourceNetworkLiterals = make([]DNSRulesItemsSourceNetworkLiterals, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DNSRulesItemsSourceNetworkLiterals{}
+					if value := res.Get("value"); value.Exists() {
+						data.Value = types.StringValue(value.String())
+					} else {
+						data.Value = types.StringNull()
+					}
+					(*parent).SourceNetworkLiterals = append((*parent).SourceNetworkLiterals, data)
+					return true
+				})
+			}
+			if value := res.Get("sourceNetworks.objects"); value.Exists() {
+				data.SourceNetworkObjects = make([]DNSRulesItemsSourceNetworkObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := DNSRulesItemsSourceNetworkObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).SourceNetworkObjects = append((*parent).SourceNetworkObjects, data)
+					return true
+				})
+			}
+			(*parent).Items = append((*parent).Items, data)
+			return true
+		})
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// fromBodyPartial reads values from a gjson.Result into a tfstate model. It ignores null attributes in order to
+// uncouple the provider from the exact values that the backend API might summon to replace nulls. (Such behavior might
+// easily change across versions of the backend API.) For List/Set/Map attributes, the func only updates the
+// "managed" elements, instead of all elements.
+func (data *DNSRules) fromBodyPartial(ctx context.Context, res gjson.Result) {
+	{
+		l := len(res.Get("items").Array())
+		tflog.Debug(ctx, fmt.Sprintf("items array resizing from %d to %d", len(data.Items), l))
+		for i := len(data.Items); i < l; i++ {
+			data.Items = append(data.Items, DNSRulesItems{})
+		}
+		if len(data.Items) > l {
+			data.Items = data.Items[:l]
+		}
+	}
+	for i := range data.Items {
+		parent := &data
+		data := (*parent).Items[i]
+		parentRes := &res
+		res := parentRes.Get(fmt.Sprintf("items.%d", i))
+		if value := res.Get("id"); value.Exists() {
+			data.Id = types.StringValue(value.String())
+		} else {
+			data.Id = types.StringNull()
+		}
+		if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+			data.Name = types.StringValue(value.String())
+		} else {
+			data.Name = types.StringNull()
+		}
+		if value := res.Get("action"); value.Exists() && !data.Action.IsNull() {
+			data.Action = types.StringValue(value.String())
+		} else {
+			data.Action = types.StringNull()
+		}
+		if value := res.Get("enabled"); value.Exists() && !data.Enabled.IsNull() {
+			data.Enabled = types.BoolValue(value.Bool())
+		} else if data.Enabled.ValueBool() != true {
+			data.Enabled = types.BoolNull()
+		}
+		if value := res.Get("sinkhole.id"); value.Exists() && !data.SinkholeId.IsNull() {
+			data.SinkholeId = types.StringValue(value.String())
+		} else {
+			data.SinkholeId = types.StringNull()
+		}
+		for i := 0; i < len(data.DnsLists); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.DnsLists[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).DnsLists[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("dnsLists.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DnsLists[%d] = %+v",
+					i,
+					(*parent).DnsLists[i],
+				))
+				(*parent).DnsLists = slices.Delete((*parent).DnsLists, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).DnsLists[i] = data
+		}
+		for i := 0; i < len(data.SourceZones); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.SourceZones[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceZones[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceZones.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceZones[%d] = %+v",
+					i,
+					(*parent).SourceZones[i],
+				))
+				(*parent).SourceZones = slices.Delete((*parent).SourceZones, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).SourceZones[i] = data
+		}
+		for i := 0; i < len(data.SourceNetworkLiterals); i++ {
+			keys := [...]string{"value"}
+			keyValues := [...]string{data.SourceNetworkLiterals[i].Value.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceNetworkLiterals[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceNetworks.literals").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceNetworkLiterals[%d] = %+v",
+					i,
+					(*parent).SourceNetworkLiterals[i],
+				))
+				(*parent).SourceNetworkLiterals = slices.Delete((*parent).SourceNetworkLiterals, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("value"); value.Exists() && !data.Value.IsNull() {
+				data.Value = types.StringValue(value.String())
+			} else {
+				data.Value = types.StringNull()
+			}
+			(*parent).SourceNetworkLiterals[i] = data
+		}
+		for i := 0; i < len(data.SourceNetworkObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.SourceNetworkObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceNetworkObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceNetworks.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceNetworkObjects[%d] = %+v",
+					i,
+					(*parent).SourceNetworkObjects[i],
+				))
+				(*parent).SourceNetworkObjects = slices.Delete((*parent).SourceNetworkObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).SourceNetworkObjects[i] = data
+		}
+		(*parent).Items[i] = data
+	}
+}
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// fromBodyUnknowns updates the Unknown Computed tfstate values from a JSON.
+// Known values are not changed (usual for Computed attributes with UseStateForUnknown or with Default).
+func (data *DNSRules) fromBodyUnknowns(ctx context.Context, res gjson.Result) {
+	for i := range data.Items {
+		r := res.Get(fmt.Sprintf("items.%d", i))
+		if v := data.Items[i]; v.Id.IsUnknown() {
+			if value := r.Get("id"); value.Exists() {
+				v.Id = types.StringValue(value.String())
+			} else {
+				v.Id = types.StringNull()
+			}
+			data.Items[i] = v
+		}
+	}
+}
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
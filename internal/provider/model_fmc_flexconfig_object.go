@@ -0,0 +1,316 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type FlexConfigObject struct {
+	Id             types.String                `tfsdk:"id"`
+	Domain         types.String                `tfsdk:"domain"`
+	Name           types.String                `tfsdk:"name"`
+	Description    types.String                `tfsdk:"description"`
+	Type           types.String                `tfsdk:"type"`
+	Deployment     types.String                `tfsdk:"deployment"`
+	FlexconfigType types.String                `tfsdk:"flexconfig_type"`
+	Body           types.String                `tfsdk:"body"`
+	Variables      []FlexConfigObjectVariables `tfsdk:"variables"`
+}
+
+type FlexConfigObjectVariables struct {
+	Name       types.String `tfsdk:"name"`
+	ObjectId   types.String `tfsdk:"object_id"`
+	ObjectType types.String `tfsdk:"object_type"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data FlexConfigObject) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/flexconfigobjects"
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+func (data FlexConfigObject) toBody(ctx context.Context, state FlexConfigObject) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	if !data.Deployment.IsNull() {
+		body, _ = sjson.Set(body, "deployment", data.Deployment.ValueString())
+	}
+	if !data.FlexconfigType.IsNull() {
+		body, _ = sjson.Set(body, "flexConfigType", data.FlexconfigType.ValueString())
+	}
+	if !data.Body.IsNull() {
+		body, _ = sjson.Set(body, "objectBody", data.Body.ValueString())
+	}
+	if len(data.Variables) > 0 {
+		body, _ = sjson.Set(body, "variables", []any{})
+		for _, item := range data.Variables {
+			itemBody := ""
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.ObjectId.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "value.id", item.ObjectId.ValueString())
+			}
+			if !item.ObjectType.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "value.type", item.ObjectType.ValueString())
+			}
+			body, _ = sjson.SetRaw(body, "variables.-1", itemBody)
+		}
+	}
+	return body
+}
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *FlexConfigObject) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("type"); value.Exists() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	if value := res.Get("deployment"); value.Exists() {
+		data.Deployment = types.StringValue(value.String())
+	} else {
+		data.Deployment = types.StringNull()
+	}
+	if value := res.Get("flexConfigType"); value.Exists() {
+		data.FlexconfigType = types.StringValue(value.String())
+	} else {
+		data.FlexconfigType = types.StringNull()
+	}
+	if value := res.Get("objectBody"); value.Exists() {
+		data.Body = types.StringValue(value.String())
+	} else {
+		data.Body = types.StringNull()
+	}
+	if value := res.Get("variables"); value.Exists() {
+		data.Variables = make([]FlexConfigObjectVariables, 0)
+		value.ForEach(func(k, res gjson.Result) bool {
+			parent := &data
+			data := FlexConfigObjectVariables{}
+			if value := res.Get("name"); value.Exists() {
+				data.Name = types.StringValue(value.String())
+			} else {
+				data.Name = types.StringNull()
+			}
+			if value := res.Get("value.id"); value.Exists() {
+				data.ObjectId = types.StringValue(value.String())
+			} else {
+				data.ObjectId = types.StringNull()
+			}
+			if value := res.Get("value.type"); value.Exists() {
+				data.ObjectType = types.StringValue(value.String())
+			} else {
+				data.ObjectType = types.StringNull()
+			}
+			(*parent).Variables = append((*parent).Variables, data)
+			return true
+		})
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// fromBodyPartial reads values from a gjson.Result into a tfstate model. It ignores null attributes in order to
+// uncouple the provider from the exact values that the backend API might summon to replace nulls. (Such behavior might
+// easily change across versions of the backend API.) For List/Set/Map attributes, the func only updates the
+// "managed" elements, instead of all elements.
+func (data *FlexConfigObject) fromBodyPartial(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	if value := res.Get("deployment"); value.Exists() && !data.Deployment.IsNull() {
+		data.Deployment = types.StringValue(value.String())
+	} else {
+		data.Deployment = types.StringNull()
+	}
+	if value := res.Get("flexConfigType"); value.Exists() && !data.FlexconfigType.IsNull() {
+		data.FlexconfigType = types.StringValue(value.String())
+	} else {
+		data.FlexconfigType = types.StringNull()
+	}
+	if value := res.Get("objectBody"); value.Exists() && !data.Body.IsNull() {
+		data.Body = types.StringValue(value.String())
+	} else {
+		data.Body = types.StringNull()
+	}
+	for i := 0; i < len(data.Variables); i++ {
+		keys := [...]string{"name"}
+		keyValues := [...]string{data.Variables[i].Name.ValueString()}
+
+		parent := &data
+		data := (*parent).Variables[i]
+		parentRes := &res
+		var res gjson.Result
+
+		parentRes.Get("variables").ForEach(
+			func(_, v gjson.Result) bool {
+				found := false
+				for ik := range keys {
+					if v.Get(keys[ik]).String() != keyValues[ik] {
+						found = false
+						break
+					}
+					found = true
+				}
+				if found {
+					res = v
+					return false
+				}
+				return true
+			},
+		)
+		if !res.Exists() {
+			tflog.Debug(ctx, fmt.Sprintf("removing Variables[%d] = %+v",
+				i,
+				(*parent).Variables[i],
+			))
+			(*parent).Variables = slices.Delete((*parent).Variables, i, i+1)
+			i--
+
+			continue
+		}
+		if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+			data.Name = types.StringValue(value.String())
+		} else {
+			data.Name = types.StringNull()
+		}
+		if value := res.Get("value.id"); value.Exists() && !data.ObjectId.IsNull() {
+			data.ObjectId = types.StringValue(value.String())
+		} else {
+			data.ObjectId = types.StringNull()
+		}
+		if value := res.Get("value.type"); value.Exists() && !data.ObjectType.IsNull() {
+			data.ObjectType = types.StringValue(value.String())
+		} else {
+			data.ObjectType = types.StringNull()
+		}
+		(*parent).Variables[i] = data
+	}
+}
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// fromBodyUnknowns updates the Unknown Computed tfstate values from a JSON.
+// Known values are not changed (usual for Computed attributes with UseStateForUnknown or with Default).
+func (data *FlexConfigObject) fromBodyUnknowns(ctx context.Context, res gjson.Result) {
+	if data.Type.IsUnknown() {
+		if value := res.Get("type"); value.Exists() {
+			data.Type = types.StringValue(value.String())
+		} else {
+			data.Type = types.StringNull()
+		}
+	}
+}
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
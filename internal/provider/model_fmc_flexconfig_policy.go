@@ -0,0 +1,277 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type FlexConfigPolicy struct {
+	Id             types.String                     `tfsdk:"id"`
+	Domain         types.String                     `tfsdk:"domain"`
+	Name           types.String                     `tfsdk:"name"`
+	Description    types.String                     `tfsdk:"description"`
+	Type           types.String                     `tfsdk:"type"`
+	PrependObjects []FlexConfigPolicyPrependObjects `tfsdk:"prepend_objects"`
+	AppendObjects  []FlexConfigPolicyAppendObjects  `tfsdk:"append_objects"`
+}
+
+type FlexConfigPolicyPrependObjects struct {
+	Id types.String `tfsdk:"id"`
+}
+
+type FlexConfigPolicyAppendObjects struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data FlexConfigPolicy) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/flexconfigpolicies"
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+func (data FlexConfigPolicy) toBody(ctx context.Context, state FlexConfigPolicy) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	if len(data.PrependObjects) > 0 {
+		body, _ = sjson.Set(body, "prependFlexConfigs", []any{})
+		for _, item := range data.PrependObjects {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			itemBody, _ = sjson.Set(itemBody, "type", "FlexConfigObject")
+			body, _ = sjson.SetRaw(body, "prependFlexConfigs.-1", itemBody)
+		}
+	}
+	if len(data.AppendObjects) > 0 {
+		body, _ = sjson.Set(body, "appendFlexConfigs", []any{})
+		for _, item := range data.AppendObjects {
+			itemBody := ""
+			if !item.Id.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			itemBody, _ = sjson.Set(itemBody, "type", "FlexConfigObject")
+			body, _ = sjson.SetRaw(body, "appendFlexConfigs.-1", itemBody)
+		}
+	}
+	return body
+}
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *FlexConfigPolicy) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("type"); value.Exists() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	if value := res.Get("prependFlexConfigs"); value.Exists() {
+		data.PrependObjects = make([]FlexConfigPolicyPrependObjects, 0)
+		value.ForEach(func(k, res gjson.Result) bool {
+			parent := &data
+			data := FlexConfigPolicyPrependObjects{}
+			if value := res.Get("id"); value.Exists() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).PrependObjects = append((*parent).PrependObjects, data)
+			return true
+		})
+	}
+	if value := res.Get("appendFlexConfigs"); value.Exists() {
+		data.AppendObjects = make([]FlexConfigPolicyAppendObjects, 0)
+		value.ForEach(func(k, res gjson.Result) bool {
+			parent := &data
+			data := FlexConfigPolicyAppendObjects{}
+			if value := res.Get("id"); value.Exists() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).AppendObjects = append((*parent).AppendObjects, data)
+			return true
+		})
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// fromBodyPartial reads values from a gjson.Result into a tfstate model. It ignores null attributes in order to
+// uncouple the provider from the exact values that the backend API might summon to replace nulls. (Such behavior might
+// easily change across versions of the backend API.) For List/Set/Map attributes, the func only updates the
+// "managed" elements, instead of all elements.
+func (data *FlexConfigPolicy) fromBodyPartial(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	{
+		l := len(res.Get("prependFlexConfigs").Array())
+		tflog.Debug(ctx, fmt.Sprintf("prependFlexConfigs array resizing from %d to %d", len(data.PrependObjects), l))
+		for i := len(data.PrependObjects); i < l; i++ {
+			data.PrependObjects = append(data.PrependObjects, FlexConfigPolicyPrependObjects{})
+		}
+		if len(data.PrependObjects) > l {
+			data.PrependObjects = data.PrependObjects[:l]
+		}
+	}
+	for i := range data.PrependObjects {
+		parent := &data
+		data := (*parent).PrependObjects[i]
+		parentRes := &res
+		res := parentRes.Get(fmt.Sprintf("prependFlexConfigs.%d", i))
+		if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+			data.Id = types.StringValue(value.String())
+		} else {
+			data.Id = types.StringNull()
+		}
+		(*parent).PrependObjects[i] = data
+	}
+	{
+		l := len(res.Get("appendFlexConfigs").Array())
+		tflog.Debug(ctx, fmt.Sprintf("appendFlexConfigs array resizing from %d to %d", len(data.AppendObjects), l))
+		for i := len(data.AppendObjects); i < l; i++ {
+			data.AppendObjects = append(data.AppendObjects, FlexConfigPolicyAppendObjects{})
+		}
+		if len(data.AppendObjects) > l {
+			data.AppendObjects = data.AppendObjects[:l]
+		}
+	}
+	for i := range data.AppendObjects {
+		parent := &data
+		data := (*parent).AppendObjects[i]
+		parentRes := &res
+		res := parentRes.Get(fmt.Sprintf("appendFlexConfigs.%d", i))
+		if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+			data.Id = types.StringValue(value.String())
+		} else {
+			data.Id = types.StringNull()
+		}
+		(*parent).AppendObjects[i] = data
+	}
+}
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// fromBodyUnknowns updates the Unknown Computed tfstate values from a JSON.
+// Known values are not changed (usual for Computed attributes with UseStateForUnknown or with Default).
+func (data *FlexConfigPolicy) fromBodyUnknowns(ctx context.Context, res gjson.Result) {
+	if data.Type.IsUnknown() {
+		if value := res.Get("type"); value.Exists() {
+			data.Type = types.StringValue(value.String())
+		} else {
+			data.Type = types.StringNull()
+		}
+	}
+}
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type GroupExpansion struct {
+	Domain     types.String `tfsdk:"domain"`
+	GroupId    types.String `tfsdk:"group_id"`
+	GroupType  types.String `tfsdk:"group_type"`
+	DeviceId   types.String `tfsdk:"device_id"`
+	Cidrs      types.List   `tfsdk:"cidrs"`
+	Fqdns      types.List   `tfsdk:"fqdns"`
+	Ports      types.List   `tfsdk:"ports"`
+	Urls       types.List   `tfsdk:"urls"`
+	Unresolved types.List   `tfsdk:"unresolved"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data GroupExpansion) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/object"
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *GroupExpansion) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("cidrs"); value.Exists() {
+		data.Cidrs = helpers.GetStringList(value.Array())
+	} else {
+		data.Cidrs = types.ListNull(types.StringType)
+	}
+	if value := res.Get("fqdns"); value.Exists() {
+		data.Fqdns = helpers.GetStringList(value.Array())
+	} else {
+		data.Fqdns = types.ListNull(types.StringType)
+	}
+	if value := res.Get("ports"); value.Exists() {
+		data.Ports = helpers.GetStringList(value.Array())
+	} else {
+		data.Ports = types.ListNull(types.StringType)
+	}
+	if value := res.Get("urls"); value.Exists() {
+		data.Urls = helpers.GetStringList(value.Array())
+	} else {
+		data.Urls = types.ListNull(types.StringType)
+	}
+	if value := res.Get("unresolved"); value.Exists() {
+		data.Unresolved = helpers.GetStringList(value.Array())
+	} else {
+		data.Unresolved = types.ListNull(types.StringType)
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
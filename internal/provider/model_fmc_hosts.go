@@ -35,9 +35,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type Hosts struct {
-	Id     types.String          `tfsdk:"id"`
-	Domain types.String          `tfsdk:"domain"`
-	Items  map[string]HostsItems `tfsdk:"items"`
+	Id            types.String          `tfsdk:"id"`
+	Domain        types.String          `tfsdk:"domain"`
+	Items         map[string]HostsItems `tfsdk:"items"`
+	AdoptExisting types.Bool            `tfsdk:"adopt_existing"`
 }
 
 type HostsItems struct {
@@ -52,6 +53,7 @@ type HostsItems struct {
 
 // Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
 var minFMCVersionBulkDeleteHosts = version.Must(version.NewVersion("7.4"))
+var minFMCVersionBulkUpdateHosts = version.Must(version.NewVersion("7.4"))
 
 // End of section. //template:end minimumVersions
 
@@ -35,9 +35,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type ICMPv4s struct {
-	Id     types.String            `tfsdk:"id"`
-	Domain types.String            `tfsdk:"domain"`
-	Items  map[string]ICMPv4sItems `tfsdk:"items"`
+	Id            types.String            `tfsdk:"id"`
+	Domain        types.String            `tfsdk:"domain"`
+	Items         map[string]ICMPv4sItems `tfsdk:"items"`
+	AdoptExisting types.Bool              `tfsdk:"adopt_existing"`
 }
 
 type ICMPv4sItems struct {
@@ -35,9 +35,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type ICMPv6s struct {
-	Id     types.String            `tfsdk:"id"`
-	Domain types.String            `tfsdk:"domain"`
-	Items  map[string]ICMPv6sItems `tfsdk:"items"`
+	Id            types.String            `tfsdk:"id"`
+	Domain        types.String            `tfsdk:"domain"`
+	Items         map[string]ICMPv6sItems `tfsdk:"items"`
+	AdoptExisting types.Bool              `tfsdk:"adopt_existing"`
 }
 
 type ICMPv6sItems struct {
@@ -36,9 +36,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type IKEv2IPsecProposals struct {
-	Id     types.String                        `tfsdk:"id"`
-	Domain types.String                        `tfsdk:"domain"`
-	Items  map[string]IKEv2IPsecProposalsItems `tfsdk:"items"`
+	Id            types.String                        `tfsdk:"id"`
+	Domain        types.String                        `tfsdk:"domain"`
+	Items         map[string]IKEv2IPsecProposalsItems `tfsdk:"items"`
+	AdoptExisting types.Bool                          `tfsdk:"adopt_existing"`
 }
 
 type IKEv2IPsecProposalsItems struct {
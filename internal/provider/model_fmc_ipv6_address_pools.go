@@ -35,9 +35,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type IPv6AddressPools struct {
-	Id     types.String                     `tfsdk:"id"`
-	Domain types.String                     `tfsdk:"domain"`
-	Items  map[string]IPv6AddressPoolsItems `tfsdk:"items"`
+	Id            types.String                     `tfsdk:"id"`
+	Domain        types.String                     `tfsdk:"domain"`
+	Items         map[string]IPv6AddressPoolsItems `tfsdk:"items"`
+	AdoptExisting types.Bool                       `tfsdk:"adopt_existing"`
 }
 
 type IPv6AddressPoolsItems struct {
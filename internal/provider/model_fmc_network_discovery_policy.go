@@ -0,0 +1,768 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type NetworkDiscoveryPolicy struct {
+	Id                                  types.String                  `tfsdk:"id"`
+	Domain                              types.String                  `tfsdk:"domain"`
+	Type                                types.String                  `tfsdk:"type"`
+	Rules                               []NetworkDiscoveryPolicyRules `tfsdk:"rules"`
+	CaptureBanners                      types.Bool                    `tfsdk:"capture_banners"`
+	UpdateInterval                      types.Int64                   `tfsdk:"update_interval"`
+	IdentityConflictGenerateEvent       types.Bool                    `tfsdk:"identity_conflict_generate_event"`
+	IdentityConflictAutomaticResolution types.String                  `tfsdk:"identity_conflict_automatic_resolution"`
+	DataStorageHostLimitAction          types.String                  `tfsdk:"data_storage_host_limit_action"`
+	DataStorageHostTimeout              types.Int64                   `tfsdk:"data_storage_host_timeout"`
+	DataStorageServerTimeout            types.Int64                   `tfsdk:"data_storage_server_timeout"`
+	DataStorageClientApplicationTimeout types.Int64                   `tfsdk:"data_storage_client_application_timeout"`
+	LoggedEvents                        types.Set                     `tfsdk:"logged_events"`
+}
+
+type NetworkDiscoveryPolicyRules struct {
+	Action                    types.String                                           `tfsdk:"action"`
+	NetworkLiterals           []NetworkDiscoveryPolicyRulesNetworkLiterals           `tfsdk:"network_literals"`
+	NetworkObjects            []NetworkDiscoveryPolicyRulesNetworkObjects            `tfsdk:"network_objects"`
+	Zones                     []NetworkDiscoveryPolicyRulesZones                     `tfsdk:"zones"`
+	SourcePortExclusions      []NetworkDiscoveryPolicyRulesSourcePortExclusions      `tfsdk:"source_port_exclusions"`
+	DestinationPortExclusions []NetworkDiscoveryPolicyRulesDestinationPortExclusions `tfsdk:"destination_port_exclusions"`
+	DiscoverHosts             types.Bool                                             `tfsdk:"discover_hosts"`
+	DiscoverUsers             types.Bool                                             `tfsdk:"discover_users"`
+	DiscoverApplications      types.Bool                                             `tfsdk:"discover_applications"`
+}
+
+type NetworkDiscoveryPolicyRulesNetworkLiterals struct {
+	Value types.String `tfsdk:"value"`
+}
+type NetworkDiscoveryPolicyRulesNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type NetworkDiscoveryPolicyRulesZones struct {
+	Id types.String `tfsdk:"id"`
+}
+type NetworkDiscoveryPolicyRulesSourcePortExclusions struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type NetworkDiscoveryPolicyRulesDestinationPortExclusions struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data NetworkDiscoveryPolicy) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/networkdiscoverypolicies"
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+func (data NetworkDiscoveryPolicy) toBody(ctx context.Context, state NetworkDiscoveryPolicy) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if len(data.Rules) > 0 {
+		body, _ = sjson.Set(body, "rules", []any{})
+		for _, item := range data.Rules {
+			itemBody := ""
+			if !item.Action.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "action", item.Action.ValueString())
+			}
+			if len(item.NetworkLiterals) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "networks.literals", []any{})
+				for _, childItem := range item.NetworkLiterals {
+					itemChildBody := ""
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "AnyNonEmptyString")
+					if !childItem.Value.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "value", childItem.Value.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "networks.literals.-1", itemChildBody)
+				}
+			}
+			if len(item.NetworkObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "networks.objects", []any{})
+				for _, childItem := range item.NetworkObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "networks.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.Zones) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "zones.objects", []any{})
+				for _, childItem := range item.Zones {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "SecurityZone")
+					itemBody, _ = sjson.SetRaw(itemBody, "zones.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.SourcePortExclusions) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "portExclusions.sourcePorts.objects", []any{})
+				for _, childItem := range item.SourcePortExclusions {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "portExclusions.sourcePorts.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationPortExclusions) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "portExclusions.destinationPorts.objects", []any{})
+				for _, childItem := range item.DestinationPortExclusions {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "portExclusions.destinationPorts.objects.-1", itemChildBody)
+				}
+			}
+			if !item.DiscoverHosts.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "discoverHosts", item.DiscoverHosts.ValueBool())
+			}
+			if !item.DiscoverUsers.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "discoverUsers", item.DiscoverUsers.ValueBool())
+			}
+			if !item.DiscoverApplications.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "discoverApplications", item.DiscoverApplications.ValueBool())
+			}
+			body, _ = sjson.SetRaw(body, "rules.-1", itemBody)
+		}
+	}
+	if !data.CaptureBanners.IsNull() {
+		body, _ = sjson.Set(body, "advancedSettings.captureBanners", data.CaptureBanners.ValueBool())
+	}
+	if !data.UpdateInterval.IsNull() {
+		body, _ = sjson.Set(body, "advancedSettings.updateInterval", data.UpdateInterval.ValueInt64())
+	}
+	if !data.IdentityConflictGenerateEvent.IsNull() {
+		body, _ = sjson.Set(body, "advancedSettings.identityConflict.generateEvent", data.IdentityConflictGenerateEvent.ValueBool())
+	}
+	if !data.IdentityConflictAutomaticResolution.IsNull() {
+		body, _ = sjson.Set(body, "advancedSettings.identityConflict.automaticResolution", data.IdentityConflictAutomaticResolution.ValueString())
+	}
+	if !data.DataStorageHostLimitAction.IsNull() {
+		body, _ = sjson.Set(body, "advancedSettings.dataStorage.hostLimitReachedAction", data.DataStorageHostLimitAction.ValueString())
+	}
+	if !data.DataStorageHostTimeout.IsNull() {
+		body, _ = sjson.Set(body, "advancedSettings.dataStorage.hostTimeout", data.DataStorageHostTimeout.ValueInt64())
+	}
+	if !data.DataStorageServerTimeout.IsNull() {
+		body, _ = sjson.Set(body, "advancedSettings.dataStorage.serverTimeout", data.DataStorageServerTimeout.ValueInt64())
+	}
+	if !data.DataStorageClientApplicationTimeout.IsNull() {
+		body, _ = sjson.Set(body, "advancedSettings.dataStorage.clientApplicationTimeout", data.DataStorageClientApplicationTimeout.ValueInt64())
+	}
+	if !data.LoggedEvents.IsNull() {
+		var values []string
+		data.LoggedEvents.ElementsAs(ctx, &values, false)
+		body, _ = sjson.Set(body, "advancedSettings.eventLogging.events", values)
+	}
+	return body
+}
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *NetworkDiscoveryPolicy) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("type"); value.Exists() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	if value := res.Get("rules"); value.Exists() {
+		data.Rules = make([]NetworkDiscoveryPolicyRules, 0)
+		value.ForEach(func(k, res gjson.Result) bool {
+			parent := &data
+			data := NetworkDiscoveryPolicyRules{}
+			if value := res.Get("action"); value.Exists() {
+				data.Action = types.StringValue(value.String())
+			} else {
+				data.Action = types.StringNull()
+			}
+			if value := res.Get("networks.literals"); value.Exists() {
+				data.NetworkLiterals = make([]NetworkDiscoveryPolicyRulesNetworkLiterals, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := NetworkDiscoveryPolicyRulesNetworkLiterals{}
+					if value := res.Get("value"); value.Exists() {
+						data.Value = types.StringValue(value.String())
+					} else {
+						data.Value = types.StringNull()
+					}
+					(*parent).NetworkLiterals = append((*parent).NetworkLiterals, data)
+					return true
+				})
+			}
+			if value := res.Get("networks.objects"); value.Exists() {
+				data.NetworkObjects = make([]NetworkDiscoveryPolicyRulesNetworkObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := NetworkDiscoveryPolicyRulesNetworkObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).NetworkObjects = append((*parent).NetworkObjects, data)
+					return true
+				})
+			}
+			if value := res.Get("zones.objects"); value.Exists() {
+				data.Zones = make([]NetworkDiscoveryPolicyRulesZones, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := NetworkDiscoveryPolicyRulesZones{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					(*parent).Zones = append((*parent).Zones, data)
+					return true
+				})
+			}
+			if value := res.Get("portExclusions.sourcePorts.objects"); value.Exists() {
+				data.SourcePortExclusions = make([]NetworkDiscoveryPolicyRulesSourcePortExclusions, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := NetworkDiscoveryPolicyRulesSourcePortExclusions{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).SourcePortExclusions = append((*parent).SourcePortExclusions, data)
+					return true
+				})
+			}
+			if value := res.Get("portExclusions.destinationPorts.objects"); value.Exists() {
+				data.DestinationPortExclusions = make([]NetworkDiscoveryPolicyRulesDestinationPortExclusions, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := NetworkDiscoveryPolicyRulesDestinationPortExclusions{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).DestinationPortExclusions = append((*parent).DestinationPortExclusions, data)
+					return true
+				})
+			}
+			if value := res.Get("discoverHosts"); value.Exists() {
+				data.DiscoverHosts = types.BoolValue(value.Bool())
+			} else {
+				data.DiscoverHosts = types.BoolValue(true)
+			}
+			if value := res.Get("discoverUsers"); value.Exists() {
+				data.DiscoverUsers = types.BoolValue(value.Bool())
+			} else {
+				data.DiscoverUsers = types.BoolValue(false)
+			}
+			if value := res.Get("discoverApplications"); value.Exists() {
+				data.DiscoverApplications = types.BoolValue(value.Bool())
+			} else {
+				data.DiscoverApplications = types.BoolValue(true)
+			}
+			(*parent).Rules = append((*parent).Rules, data)
+			return true
+		})
+	}
+	if value := res.Get("advancedSettings.captureBanners"); value.Exists() {
+		data.CaptureBanners = types.BoolValue(value.Bool())
+	} else {
+		data.CaptureBanners = types.BoolNull()
+	}
+	if value := res.Get("advancedSettings.updateInterval"); value.Exists() {
+		data.UpdateInterval = types.Int64Value(value.Int())
+	} else {
+		data.UpdateInterval = types.Int64Null()
+	}
+	if value := res.Get("advancedSettings.identityConflict.generateEvent"); value.Exists() {
+		data.IdentityConflictGenerateEvent = types.BoolValue(value.Bool())
+	} else {
+		data.IdentityConflictGenerateEvent = types.BoolNull()
+	}
+	if value := res.Get("advancedSettings.identityConflict.automaticResolution"); value.Exists() {
+		data.IdentityConflictAutomaticResolution = types.StringValue(value.String())
+	} else {
+		data.IdentityConflictAutomaticResolution = types.StringNull()
+	}
+	if value := res.Get("advancedSettings.dataStorage.hostLimitReachedAction"); value.Exists() {
+		data.DataStorageHostLimitAction = types.StringValue(value.String())
+	} else {
+		data.DataStorageHostLimitAction = types.StringNull()
+	}
+	if value := res.Get("advancedSettings.dataStorage.hostTimeout"); value.Exists() {
+		data.DataStorageHostTimeout = types.Int64Value(value.Int())
+	} else {
+		data.DataStorageHostTimeout = types.Int64Null()
+	}
+	if value := res.Get("advancedSettings.dataStorage.serverTimeout"); value.Exists() {
+		data.DataStorageServerTimeout = types.Int64Value(value.Int())
+	} else {
+		data.DataStorageServerTimeout = types.Int64Null()
+	}
+	if value := res.Get("advancedSettings.dataStorage.clientApplicationTimeout"); value.Exists() {
+		data.DataStorageClientApplicationTimeout = types.Int64Value(value.Int())
+	} else {
+		data.DataStorageClientApplicationTimeout = types.Int64Null()
+	}
+	if value := res.Get("advancedSettings.eventLogging.events"); value.Exists() {
+		data.LoggedEvents = helpers.GetStringSet(value.Array())
+	} else {
+		data.LoggedEvents = types.SetNull(types.StringType)
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// fromBodyPartial reads values from a gjson.Result into a tfstate model. It ignores null attributes in order to
+// uncouple the provider from the exact values that the backend API might summon to replace nulls. (Such behavior might
+// easily change across versions of the backend API.) For List/Set/Map attributes, the func only updates the
+// "managed" elements, instead of all elements.
+func (data *NetworkDiscoveryPolicy) fromBodyPartial(ctx context.Context, res gjson.Result) {
+	if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	{
+		l := len(res.Get("rules").Array())
+		tflog.Debug(ctx, fmt.Sprintf("rules array resizing from %d to %d", len(data.Rules), l))
+		for i := len(data.Rules); i < l; i++ {
+			data.Rules = append(data.Rules, NetworkDiscoveryPolicyRules{})
+		}
+		if len(data.Rules) > l {
+			data.Rules = data.Rules[:l]
+		}
+	}
+	for i := range data.Rules {
+		parent := &data
+		data := (*parent).Rules[i]
+		parentRes := &res
+		res := parentRes.Get(fmt.Sprintf("rules.%d", i))
+		if value := res.Get("action"); value.Exists() && !data.Action.IsNull() {
+			data.Action = types.StringValue(value.String())
+		} else {
+			data.Action = types.StringNull()
+		}
+		for i := 0; i < len(data.NetworkLiterals); i++ {
+			keys := [...]string{"value"}
+			keyValues := [...]string{data.NetworkLiterals[i].Value.ValueString()}
+
+			parent := &data
+			data := (*parent).NetworkLiterals[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("networks.literals").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing NetworkLiterals[%d] = %+v",
+					i,
+					(*parent).NetworkLiterals[i],
+				))
+				(*parent).NetworkLiterals = slices.Delete((*parent).NetworkLiterals, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("value"); value.Exists() && !data.Value.IsNull() {
+				data.Value = types.StringValue(value.String())
+			} else {
+				data.Value = types.StringNull()
+			}
+			(*parent).NetworkLiterals[i] = data
+		}
+		for i := 0; i < len(data.NetworkObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.NetworkObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).NetworkObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("networks.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing NetworkObjects[%d] = %+v",
+					i,
+					(*parent).NetworkObjects[i],
+				))
+				(*parent).NetworkObjects = slices.Delete((*parent).NetworkObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).NetworkObjects[i] = data
+		}
+		for i := 0; i < len(data.Zones); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.Zones[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).Zones[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("zones.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing Zones[%d] = %+v",
+					i,
+					(*parent).Zones[i],
+				))
+				(*parent).Zones = slices.Delete((*parent).Zones, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).Zones[i] = data
+		}
+		for i := 0; i < len(data.SourcePortExclusions); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.SourcePortExclusions[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).SourcePortExclusions[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("portExclusions.sourcePorts.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourcePortExclusions[%d] = %+v",
+					i,
+					(*parent).SourcePortExclusions[i],
+				))
+				(*parent).SourcePortExclusions = slices.Delete((*parent).SourcePortExclusions, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).SourcePortExclusions[i] = data
+		}
+		for i := 0; i < len(data.DestinationPortExclusions); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.DestinationPortExclusions[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationPortExclusions[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("portExclusions.destinationPorts.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationPortExclusions[%d] = %+v",
+					i,
+					(*parent).DestinationPortExclusions[i],
+				))
+				(*parent).DestinationPortExclusions = slices.Delete((*parent).DestinationPortExclusions, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).DestinationPortExclusions[i] = data
+		}
+		if value := res.Get("discoverHosts"); value.Exists() && !data.DiscoverHosts.IsNull() {
+			data.DiscoverHosts = types.BoolValue(value.Bool())
+		} else if data.DiscoverHosts.ValueBool() != true {
+			data.DiscoverHosts = types.BoolNull()
+		}
+		if value := res.Get("discoverUsers"); value.Exists() && !data.DiscoverUsers.IsNull() {
+			data.DiscoverUsers = types.BoolValue(value.Bool())
+		} else if data.DiscoverUsers.ValueBool() != false {
+			data.DiscoverUsers = types.BoolNull()
+		}
+		if value := res.Get("discoverApplications"); value.Exists() && !data.DiscoverApplications.IsNull() {
+			data.DiscoverApplications = types.BoolValue(value.Bool())
+		} else if data.DiscoverApplications.ValueBool() != true {
+			data.DiscoverApplications = types.BoolNull()
+		}
+		(*parent).Rules[i] = data
+	}
+	if value := res.Get("advancedSettings.captureBanners"); value.Exists() && !data.CaptureBanners.IsNull() {
+		data.CaptureBanners = types.BoolValue(value.Bool())
+	} else {
+		data.CaptureBanners = types.BoolNull()
+	}
+	if value := res.Get("advancedSettings.updateInterval"); value.Exists() && !data.UpdateInterval.IsNull() {
+		data.UpdateInterval = types.Int64Value(value.Int())
+	} else {
+		data.UpdateInterval = types.Int64Null()
+	}
+	if value := res.Get("advancedSettings.identityConflict.generateEvent"); value.Exists() && !data.IdentityConflictGenerateEvent.IsNull() {
+		data.IdentityConflictGenerateEvent = types.BoolValue(value.Bool())
+	} else {
+		data.IdentityConflictGenerateEvent = types.BoolNull()
+	}
+	if value := res.Get("advancedSettings.identityConflict.automaticResolution"); value.Exists() && !data.IdentityConflictAutomaticResolution.IsNull() {
+		data.IdentityConflictAutomaticResolution = types.StringValue(value.String())
+	} else {
+		data.IdentityConflictAutomaticResolution = types.StringNull()
+	}
+	if value := res.Get("advancedSettings.dataStorage.hostLimitReachedAction"); value.Exists() && !data.DataStorageHostLimitAction.IsNull() {
+		data.DataStorageHostLimitAction = types.StringValue(value.String())
+	} else {
+		data.DataStorageHostLimitAction = types.StringNull()
+	}
+	if value := res.Get("advancedSettings.dataStorage.hostTimeout"); value.Exists() && !data.DataStorageHostTimeout.IsNull() {
+		data.DataStorageHostTimeout = types.Int64Value(value.Int())
+	} else {
+		data.DataStorageHostTimeout = types.Int64Null()
+	}
+	if value := res.Get("advancedSettings.dataStorage.serverTimeout"); value.Exists() && !data.DataStorageServerTimeout.IsNull() {
+		data.DataStorageServerTimeout = types.Int64Value(value.Int())
+	} else {
+		data.DataStorageServerTimeout = types.Int64Null()
+	}
+	if value := res.Get("advancedSettings.dataStorage.clientApplicationTimeout"); value.Exists() && !data.DataStorageClientApplicationTimeout.IsNull() {
+		data.DataStorageClientApplicationTimeout = types.Int64Value(value.Int())
+	} else {
+		data.DataStorageClientApplicationTimeout = types.Int64Null()
+	}
+	if value := res.Get("advancedSettings.eventLogging.events"); value.Exists() && !data.LoggedEvents.IsNull() {
+		data.LoggedEvents = helpers.GetStringSet(value.Array())
+	} else {
+		data.LoggedEvents = types.SetNull(types.StringType)
+	}
+}
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// fromBodyUnknowns updates the Unknown Computed tfstate values from a JSON.
+// Known values are not changed (usual for Computed attributes with UseStateForUnknown or with Default).
+func (data *NetworkDiscoveryPolicy) fromBodyUnknowns(ctx context.Context, res gjson.Result) {
+	if data.Type.IsUnknown() {
+		if value := res.Get("type"); value.Exists() {
+			data.Type = types.StringValue(value.String())
+		} else {
+			data.Type = types.StringNull()
+		}
+	}
+}
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
@@ -35,9 +35,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type Networks struct {
-	Id     types.String             `tfsdk:"id"`
-	Domain types.String             `tfsdk:"domain"`
-	Items  map[string]NetworksItems `tfsdk:"items"`
+	Id            types.String             `tfsdk:"id"`
+	Domain        types.String             `tfsdk:"domain"`
+	Items         map[string]NetworksItems `tfsdk:"items"`
+	AdoptExisting types.Bool               `tfsdk:"adopt_existing"`
 }
 
 type NetworksItems struct {
@@ -52,6 +53,7 @@ type NetworksItems struct {
 
 // Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
 var minFMCVersionBulkDeleteNetworks = version.Must(version.NewVersion("7.4"))
+var minFMCVersionBulkUpdateNetworks = version.Must(version.NewVersion("7.4"))
 
 // End of section. //template:end minimumVersions
 
@@ -37,9 +37,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type PolicyLists struct {
-	Id     types.String                `tfsdk:"id"`
-	Domain types.String                `tfsdk:"domain"`
-	Items  map[string]PolicyListsItems `tfsdk:"items"`
+	Id            types.String                `tfsdk:"id"`
+	Domain        types.String                `tfsdk:"domain"`
+	Items         map[string]PolicyListsItems `tfsdk:"items"`
+	AdoptExisting types.Bool                  `tfsdk:"adopt_existing"`
 }
 
 type PolicyListsItems struct {
@@ -0,0 +1,988 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type QoSRules struct {
+	Id          types.String    `tfsdk:"id"`
+	Domain      types.String    `tfsdk:"domain"`
+	QosPolicyId types.String    `tfsdk:"qos_policy_id"`
+	Items       []QoSRulesItems `tfsdk:"items"`
+}
+
+type QoSRulesItems struct {
+	Id                         types.String                              `tfsdk:"id"`
+	Name                       types.String                              `tfsdk:"name"`
+	Description                types.String                              `tfsdk:"description"`
+	Enabled                    types.Bool                                `tfsdk:"enabled"`
+	SourceInterfaces           []QoSRulesItemsSourceInterfaces           `tfsdk:"source_interfaces"`
+	DestinationInterfaces      []QoSRulesItemsDestinationInterfaces      `tfsdk:"destination_interfaces"`
+	SourceNetworkLiterals      []QoSRulesItemsSourceNetworkLiterals      `tfsdk:"source_network_literals"`
+	DestinationNetworkLiterals []QoSRulesItemsDestinationNetworkLiterals `tfsdk:"destination_network_literals"`
+	SourceNetworkObjects       []QoSRulesItemsSourceNetworkObjects       `tfsdk:"source_network_objects"`
+	DestinationNetworkObjects  []QoSRulesItemsDestinationNetworkObjects  `tfsdk:"destination_network_objects"`
+	SourcePortObjects          []QoSRulesItemsSourcePortObjects          `tfsdk:"source_port_objects"`
+	DestinationPortObjects     []QoSRulesItemsDestinationPortObjects     `tfsdk:"destination_port_objects"`
+	Applications               []QoSRulesItemsApplications               `tfsdk:"applications"`
+	UploadLimit                types.Float64                             `tfsdk:"upload_limit"`
+	DownloadLimit              types.Float64                             `tfsdk:"download_limit"`
+}
+
+type QoSRulesItemsSourceInterfaces struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type QoSRulesItemsDestinationInterfaces struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type QoSRulesItemsSourceNetworkLiterals struct {
+	Value types.String `tfsdk:"value"`
+}
+type QoSRulesItemsDestinationNetworkLiterals struct {
+	Value types.String `tfsdk:"value"`
+}
+type QoSRulesItemsSourceNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type QoSRulesItemsDestinationNetworkObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type QoSRulesItemsSourcePortObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type QoSRulesItemsDestinationPortObjects struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+type QoSRulesItemsApplications struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data QoSRules) getPath() string {
+	return fmt.Sprintf("/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/qospolicies/%v/qosrules", url.QueryEscape(data.QosPolicyId.ValueString()))
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+func (data QoSRules) toBody(ctx context.Context, state QoSRules) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if len(data.Items) > 0 {
+		body, _ = sjson.Set(body, "items", []any{})
+		for _, item := range data.Items {
+			itemBody := ""
+			if !item.Id.IsNull() && !item.Id.IsUnknown() {
+				itemBody, _ = sjson.Set(itemBody, "id", item.Id.ValueString())
+			}
+			if !item.Name.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "name", item.Name.ValueString())
+			}
+			if !item.Description.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "description", item.Description.ValueString())
+			}
+			if !item.Enabled.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "enabled", item.Enabled.ValueBool())
+			}
+			if len(item.SourceInterfaces) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceInterfaces.objects", []any{})
+				for _, childItem := range item.SourceInterfaces {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceInterfaces.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationInterfaces) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "destinationInterfaces.objects", []any{})
+				for _, childItem := range item.DestinationInterfaces {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "destinationInterfaces.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.SourceNetworkLiterals) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceNetworks.literals", []any{})
+				for _, childItem := range item.SourceNetworkLiterals {
+					itemChildBody := ""
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "AnyNonEmptyString")
+					if !childItem.Value.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "value", childItem.Value.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceNetworks.literals.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationNetworkLiterals) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "destinationNetworks.literals", []any{})
+				for _, childItem := range item.DestinationNetworkLiterals {
+					itemChildBody := ""
+					itemChildBody, _ = sjson.Set(itemChildBody, "type", "AnyNonEmptyString")
+					if !childItem.Value.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "value", childItem.Value.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "destinationNetworks.literals.-1", itemChildBody)
+				}
+			}
+			if len(item.SourceNetworkObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourceNetworks.objects", []any{})
+				for _, childItem := range item.SourceNetworkObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "sourceNetworks.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationNetworkObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "destinationNetworks.objects", []any{})
+				for _, childItem := range item.DestinationNetworkObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "destinationNetworks.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.SourcePortObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "sourcePorts.objects", []any{})
+				for _, childItem := range item.SourcePortObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "sourcePorts.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.DestinationPortObjects) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "destinationPorts.objects", []any{})
+				for _, childItem := range item.DestinationPortObjects {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					if !childItem.Type.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "type", childItem.Type.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "destinationPorts.objects.-1", itemChildBody)
+				}
+			}
+			if len(item.Applications) > 0 {
+				itemBody, _ = sjson.Set(itemBody, "applications.applications", []any{})
+				for _, childItem := range item.Applications {
+					itemChildBody := ""
+					if !childItem.Id.IsNull() {
+						itemChildBody, _ = sjson.Set(itemChildBody, "id", childItem.Id.ValueString())
+					}
+					itemBody, _ = sjson.SetRaw(itemBody, "applications.applications.-1", itemChildBody)
+				}
+			}
+			if !item.UploadLimit.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "rateLimit.upload", item.UploadLimit.ValueFloat64())
+			}
+			if !item.DownloadLimit.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "rateLimit.download", item.DownloadLimit.ValueFloat64())
+			}
+			body, _ = sjson.SetRaw(body, "items.-1", itemBody)
+		}
+	}
+	return body
+}
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *QoSRules) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("items"); value.Exists() {
+		data.Items = make([]QoSRulesItems, 0)
+		value.ForEach(func(k, res gjson.Result) bool {
+			parent := &data
+			data := QoSRulesItems{}
+			if value := res.Get("id"); value.Exists() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("name"); value.Exists() {
+				data.Name = types.StringValue(value.String())
+			} else {
+				data.Name = types.StringNull()
+			}
+			if value := res.Get("description"); value.Exists() {
+				data.Description = types.StringValue(value.String())
+			} else {
+				data.Description = types.StringNull()
+			}
+			if value := res.Get("enabled"); value.Exists() {
+				data.Enabled = types.BoolValue(value.Bool())
+			} else {
+				data.Enabled = types.BoolValue(true)
+			}
+			if value := res.Get("sourceInterfaces.objects"); value.Exists() {
+				data.SourceInterfaces = make([]QoSRulesItemsSourceInterfaces, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsSourceInterfaces{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).SourceInterfaces = append((*parent).SourceInterfaces, data)
+					return true
+				})
+			}
+			if value := res.Get("destinationInterfaces.objects"); value.Exists() {
+				data.DestinationInterfaces = make([]QoSRulesItemsDestinationInterfaces, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsDestinationInterfaces{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).DestinationInterfaces = append((*parent).DestinationInterfaces, data)
+					return true
+				})
+			}
+			if value := res.Get("sourceNetworks.literals"); value.Exists() {
+				data.SourceNetworkLiterals = make([]QoSRulesItemsSourceNetworkLiterals, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsSourceNetworkLiterals{}
+					if value := res.Get("value"); value.Exists() {
+						data.Value = types.StringValue(value.String())
+					} else {
+						data.Value = types.StringNull()
+					}
+					(*parent).SourceNetworkLiterals = append((*parent).SourceNetworkLiterals, data)
+					return true
+				})
+			}
+			if value := res.Get("destinationNetworks.literals"); value.Exists() {
+				data.DestinationNetworkLiterals = make([]QoSRulesItemsDestinationNetworkLiterals, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsDestinationNetworkLiterals{}
+					if value := res.Get("value"); value.Exists() {
+						data.Value = types.StringValue(value.String())
+					} else {
+						data.Value = types.StringNull()
+					}
+					(*parent).DestinationNetworkLiterals = append((*parent).DestinationNetworkLiterals, data)
+					return true
+				})
+			}
+			if value := res.Get("sourceNetworks.objects"); value.Exists() {
+				data.SourceNetworkObjects = make([]QoSRulesItemsSourceNetworkObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsSourceNetworkObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).SourceNetworkObjects = append((*parent).SourceNetworkObjects, data)
+					return true
+				})
+			}
+			if value := res.Get("destinationNetworks.objects"); value.Exists() {
+				data.DestinationNetworkObjects = make([]QoSRulesItemsDestinationNetworkObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsDestinationNetworkObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).DestinationNetworkObjects = append((*parent).DestinationNetworkObjects, data)
+					return true
+				})
+			}
+			if value := res.Get("sourcePorts.objects"); value.Exists() {
+				data.SourcePortObjects = make([]QoSRulesItemsSourcePortObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsSourcePortObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).SourcePortObjects = append((*parent).SourcePortObjects, data)
+					return true
+				})
+			}
+			if value := res.Get("destinationPorts.objects"); value.Exists() {
+				data.DestinationPortObjects = make([]QoSRulesItemsDestinationPortObjects, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsDestinationPortObjects{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					if value := res.Get("type"); value.Exists() {
+						data.Type = types.StringValue(value.String())
+					} else {
+						data.Type = types.StringNull()
+					}
+					(*parent).DestinationPortObjects = append((*parent).DestinationPortObjects, data)
+					return true
+				})
+			}
+			if value := res.Get("applications.applications"); value.Exists() {
+				data.Applications = make([]QoSRulesItemsApplications, 0)
+				value.ForEach(func(k, res gjson.Result) bool {
+					parent := &data
+					data := QoSRulesItemsApplications{}
+					if value := res.Get("id"); value.Exists() {
+						data.Id = types.StringValue(value.String())
+					} else {
+						data.Id = types.StringNull()
+					}
+					(*parent).Applications = append((*parent).Applications, data)
+					return true
+				})
+			}
+			if value := res.Get("rateLimit.upload"); value.Exists() {
+				data.UploadLimit = types.Float64Value(value.Float())
+			} else {
+				data.UploadLimit = types.Float64Null()
+			}
+			if value := res.Get("rateLimit.download"); value.Exists() {
+				data.DownloadLimit = types.Float64Value(value.Float())
+			} else {
+				data.DownloadLimit = types.Float64Null()
+			}
+			(*parent).Items = append((*parent).Items, data)
+			return true
+		})
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// fromBodyPartial reads values from a gjson.Result into a tfstate model. It ignores null attributes in order to
+// uncouple the provider from the exact values that the backend API might summon to replace nulls. (Such behavior might
+// easily change across versions of the backend API.) For List/Set/Map attributes, the func only updates the
+// "managed" elements, instead of all elements.
+func (data *QoSRules) fromBodyPartial(ctx context.Context, res gjson.Result) {
+	{
+		l := len(res.Get("items").Array())
+		tflog.Debug(ctx, fmt.Sprintf("items array resizing from %d to %d", len(data.Items), l))
+		for i := len(data.Items); i < l; i++ {
+			data.Items = append(data.Items, QoSRulesItems{})
+		}
+		if len(data.Items) > l {
+			data.Items = data.Items[:l]
+		}
+	}
+	for i := range data.Items {
+		parent := &data
+		data := (*parent).Items[i]
+		parentRes := &res
+		res := parentRes.Get(fmt.Sprintf("items.%d", i))
+		if value := res.Get("id"); value.Exists() {
+			data.Id = types.StringValue(value.String())
+		} else {
+			data.Id = types.StringNull()
+		}
+		if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+			data.Name = types.StringValue(value.String())
+		} else {
+			data.Name = types.StringNull()
+		}
+		if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+			data.Description = types.StringValue(value.String())
+		} else {
+			data.Description = types.StringNull()
+		}
+		if value := res.Get("enabled"); value.Exists() && !data.Enabled.IsNull() {
+			data.Enabled = types.BoolValue(value.Bool())
+		} else if data.Enabled.ValueBool() != true {
+			data.Enabled = types.BoolNull()
+		}
+		for i := 0; i < len(data.SourceInterfaces); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.SourceInterfaces[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceInterfaces[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceInterfaces.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceInterfaces[%d] = %+v",
+					i,
+					(*parent).SourceInterfaces[i],
+				))
+				(*parent).SourceInterfaces = slices.Delete((*parent).SourceInterfaces, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).SourceInterfaces[i] = data
+		}
+		for i := 0; i < len(data.DestinationInterfaces); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.DestinationInterfaces[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationInterfaces[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("destinationInterfaces.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationInterfaces[%d] = %+v",
+					i,
+					(*parent).DestinationInterfaces[i],
+				))
+				(*parent).DestinationInterfaces = slices.Delete((*parent).DestinationInterfaces, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).DestinationInterfaces[i] = data
+		}
+		for i := 0; i < len(data.SourceNetworkLiterals); i++ {
+			keys := [...]string{"value"}
+			keyValues := [...]string{data.SourceNetworkLiterals[i].Value.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceNetworkLiterals[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceNetworks.literals").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceNetworkLiterals[%d] = %+v",
+					i,
+					(*parent).SourceNetworkLiterals[i],
+				))
+				(*parent).SourceNetworkLiterals = slices.Delete((*parent).SourceNetworkLiterals, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("value"); value.Exists() && !data.Value.IsNull() {
+				data.Value = types.StringValue(value.String())
+			} else {
+				data.Value = types.StringNull()
+			}
+			(*parent).SourceNetworkLiterals[i] = data
+		}
+		for i := 0; i < len(data.DestinationNetworkLiterals); i++ {
+			keys := [...]string{"value"}
+			keyValues := [...]string{data.DestinationNetworkLiterals[i].Value.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationNetworkLiterals[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("destinationNetworks.literals").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationNetworkLiterals[%d] = %+v",
+					i,
+					(*parent).DestinationNetworkLiterals[i],
+				))
+				(*parent).DestinationNetworkLiterals = slices.Delete((*parent).DestinationNetworkLiterals, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("value"); value.Exists() && !data.Value.IsNull() {
+				data.Value = types.StringValue(value.String())
+			} else {
+				data.Value = types.StringNull()
+			}
+			(*parent).DestinationNetworkLiterals[i] = data
+		}
+		for i := 0; i < len(data.SourceNetworkObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.SourceNetworkObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).SourceNetworkObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourceNetworks.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourceNetworkObjects[%d] = %+v",
+					i,
+					(*parent).SourceNetworkObjects[i],
+				))
+				(*parent).SourceNetworkObjects = slices.Delete((*parent).SourceNetworkObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).SourceNetworkObjects[i] = data
+		}
+		for i := 0; i < len(data.DestinationNetworkObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.DestinationNetworkObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationNetworkObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("destinationNetworks.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationNetworkObjects[%d] = %+v",
+					i,
+					(*parent).DestinationNetworkObjects[i],
+				))
+				(*parent).DestinationNetworkObjects = slices.Delete((*parent).DestinationNetworkObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).DestinationNetworkObjects[i] = data
+		}
+		for i := 0; i < len(data.SourcePortObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.SourcePortObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).SourcePortObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("sourcePorts.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing SourcePortObjects[%d] = %+v",
+					i,
+					(*parent).SourcePortObjects[i],
+				))
+				(*parent).SourcePortObjects = slices.Delete((*parent).SourcePortObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).SourcePortObjects[i] = data
+		}
+		for i := 0; i < len(data.DestinationPortObjects); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.DestinationPortObjects[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).DestinationPortObjects[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("destinationPorts.objects").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing DestinationPortObjects[%d] = %+v",
+					i,
+					(*parent).DestinationPortObjects[i],
+				))
+				(*parent).DestinationPortObjects = slices.Delete((*parent).DestinationPortObjects, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+				data.Type = types.StringValue(value.String())
+			} else {
+				data.Type = types.StringNull()
+			}
+			(*parent).DestinationPortObjects[i] = data
+		}
+		for i := 0; i < len(data.Applications); i++ {
+			keys := [...]string{"id"}
+			keyValues := [...]string{data.Applications[i].Id.ValueString()}
+
+			parent := &data
+			data := (*parent).Applications[i]
+			parentRes := &res
+			var res gjson.Result
+
+			parentRes.Get("applications.applications").ForEach(
+				func(_, v gjson.Result) bool {
+					found := false
+					for ik := range keys {
+						if v.Get(keys[ik]).String() != keyValues[ik] {
+							found = false
+							break
+						}
+						found = true
+					}
+					if found {
+						res = v
+						return false
+					}
+					return true
+				},
+			)
+			if !res.Exists() {
+				tflog.Debug(ctx, fmt.Sprintf("removing Applications[%d] = %+v",
+					i,
+					(*parent).Applications[i],
+				))
+				(*parent).Applications = slices.Delete((*parent).Applications, i, i+1)
+				i--
+
+				continue
+			}
+			if value := res.Get("id"); value.Exists() && !data.Id.IsNull() {
+				data.Id = types.StringValue(value.String())
+			} else {
+				data.Id = types.StringNull()
+			}
+			(*parent).Applications[i] = data
+		}
+		if value := res.Get("rateLimit.upload"); value.Exists() && !data.UploadLimit.IsNull() {
+			data.UploadLimit = types.Float64Value(value.Float())
+		} else {
+			data.UploadLimit = types.Float64Null()
+		}
+		if value := res.Get("rateLimit.download"); value.Exists() && !data.DownloadLimit.IsNull() {
+			data.DownloadLimit = types.Float64Value(value.Float())
+		} else {
+			data.DownloadLimit = types.Float64Null()
+		}
+		(*parent).Items[i] = data
+	}
+}
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// fromBodyUnknowns updates the Unknown Computed tfstate values from a JSON.
+// Known values are not changed (usual for Computed attributes with UseStateForUnknown or with Default).
+func (data *QoSRules) fromBodyUnknowns(ctx context.Context, res gjson.Result) {
+	for i := range data.Items {
+		r := res.Get(fmt.Sprintf("items.%d", i))
+		if v := data.Items[i]; v.Id.IsUnknown() {
+			if value := r.Get("id"); value.Exists() {
+				v.Id = types.StringValue(value.String())
+			} else {
+				v.Id = types.StringNull()
+			}
+			data.Items[i] = v
+		}
+	}
+}
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
@@ -55,6 +55,8 @@ type RadiusServerGroupRadiusServers struct {
 	MessageAuthenticator        types.Bool   `tfsdk:"message_authenticator"`
 	AuthenticationPort          types.Int64  `tfsdk:"authentication_port"`
 	Key                         types.String `tfsdk:"key"`
+	KeyWo                       types.String `tfsdk:"key_wo"`
+	KeyWoVersion                types.Int64  `tfsdk:"key_wo_version"`
 	AccountingPort              types.Int64  `tfsdk:"accounting_port"`
 	Timeout                     types.Int64  `tfsdk:"timeout"`
 	UseRoutingToSelectInterface types.Bool   `tfsdk:"use_routing_to_select_interface"`
@@ -129,6 +131,9 @@ func (data RadiusServerGroup) toBody(ctx context.Context, state RadiusServerGrou
 			if !item.Key.IsNull() {
 				itemBody, _ = sjson.Set(itemBody, "secretKey", item.Key.ValueString())
 			}
+			if !item.KeyWo.IsNull() {
+				itemBody, _ = sjson.Set(itemBody, "secretKey", item.KeyWo.ValueString())
+			}
 			if !item.AccountingPort.IsNull() {
 				itemBody, _ = sjson.Set(itemBody, "accountingPort", item.AccountingPort.ValueInt64())
 			}
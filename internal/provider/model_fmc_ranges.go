@@ -35,9 +35,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type Ranges struct {
-	Id     types.String           `tfsdk:"id"`
-	Domain types.String           `tfsdk:"domain"`
-	Items  map[string]RangesItems `tfsdk:"items"`
+	Id            types.String           `tfsdk:"id"`
+	Domain        types.String           `tfsdk:"domain"`
+	Items         map[string]RangesItems `tfsdk:"items"`
+	AdoptExisting types.Bool             `tfsdk:"adopt_existing"`
 }
 
 type RangesItems struct {
@@ -52,6 +53,7 @@ type RangesItems struct {
 
 // Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
 var minFMCVersionBulkDeleteRanges = version.Must(version.NewVersion("7.4"))
+var minFMCVersionBulkUpdateRanges = version.Must(version.NewVersion("7.4"))
 
 // End of section. //template:end minimumVersions
 
@@ -46,8 +46,12 @@ type RealmADLDAP struct {
 	AdPrimaryDomain                    types.String                  `tfsdk:"ad_primary_domain"`
 	AdJoinUsername                     types.String                  `tfsdk:"ad_join_username"`
 	AdJoinPassword                     types.String                  `tfsdk:"ad_join_password"`
+	AdJoinPasswordWo                   types.String                  `tfsdk:"ad_join_password_wo"`
+	AdJoinPasswordWoVersion            types.Int64                   `tfsdk:"ad_join_password_wo_version"`
 	DirectoryUsername                  types.String                  `tfsdk:"directory_username"`
 	DirectoryPassword                  types.String                  `tfsdk:"directory_password"`
+	DirectoryPasswordWo                types.String                  `tfsdk:"directory_password_wo"`
+	DirectoryPasswordWoVersion         types.Int64                   `tfsdk:"directory_password_wo_version"`
 	BaseDn                             types.String                  `tfsdk:"base_dn"`
 	GroupDn                            types.String                  `tfsdk:"group_dn"`
 	IncludedUsers                      types.List                    `tfsdk:"included_users"`
@@ -119,12 +123,18 @@ func (data RealmADLDAP) toBody(ctx context.Context, state RealmADLDAP) string {
 	if !data.AdJoinPassword.IsNull() {
 		body, _ = sjson.Set(body, "adJoinPassword", data.AdJoinPassword.ValueString())
 	}
+	if !data.AdJoinPasswordWo.IsNull() {
+		body, _ = sjson.Set(body, "adJoinPassword", data.AdJoinPasswordWo.ValueString())
+	}
 	if !data.DirectoryUsername.IsNull() {
 		body, _ = sjson.Set(body, "dirUsername", data.DirectoryUsername.ValueString())
 	}
 	if !data.DirectoryPassword.IsNull() {
 		body, _ = sjson.Set(body, "dirPassword", data.DirectoryPassword.ValueString())
 	}
+	if !data.DirectoryPasswordWo.IsNull() {
+		body, _ = sjson.Set(body, "dirPassword", data.DirectoryPasswordWo.ValueString())
+	}
 	if !data.BaseDn.IsNull() {
 		body, _ = sjson.Set(body, "baseDn", data.BaseDn.ValueString())
 	}
@@ -37,9 +37,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type TimeRanges struct {
-	Id     types.String               `tfsdk:"id"`
-	Domain types.String               `tfsdk:"domain"`
-	Items  map[string]TimeRangesItems `tfsdk:"items"`
+	Id            types.String               `tfsdk:"id"`
+	Domain        types.String               `tfsdk:"domain"`
+	Items         map[string]TimeRangesItems `tfsdk:"items"`
+	AdoptExisting types.Bool                 `tfsdk:"adopt_existing"`
 }
 
 type TimeRangesItems struct {
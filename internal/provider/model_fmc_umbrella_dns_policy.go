@@ -0,0 +1,230 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin types
+
+type UmbrellaDNSPolicy struct {
+	Id                       types.String `tfsdk:"id"`
+	Domain                   types.String `tfsdk:"domain"`
+	Name                     types.String `tfsdk:"name"`
+	Description              types.String `tfsdk:"description"`
+	Type                     types.String `tfsdk:"type"`
+	UmbrellaProtectionPolicy types.String `tfsdk:"umbrella_protection_policy"`
+	Dnscrypt                 types.Bool   `tfsdk:"dnscrypt"`
+	IdleTimeout              types.Int64  `tfsdk:"idle_timeout"`
+	LocalDomainBypass        types.List   `tfsdk:"local_domain_bypass"`
+}
+
+// End of section. //template:end types
+
+// Section below is generated&owned by "gen/generator.go". //template:begin minimumVersions
+
+// End of section. //template:end minimumVersions
+
+// Section below is generated&owned by "gen/generator.go". //template:begin getPath
+
+func (data UmbrellaDNSPolicy) getPath() string {
+	return "/api/fmc_config/v1/domain/{DOMAIN_UUID}/policy/umbrelladnspolicies"
+}
+
+// End of section. //template:end getPath
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBody
+
+func (data UmbrellaDNSPolicy) toBody(ctx context.Context, state UmbrellaDNSPolicy) string {
+	body := ""
+	if data.Id.ValueString() != "" {
+		body, _ = sjson.Set(body, "id", data.Id.ValueString())
+	}
+	if !data.Name.IsNull() {
+		body, _ = sjson.Set(body, "name", data.Name.ValueString())
+	}
+	if !data.Description.IsNull() {
+		body, _ = sjson.Set(body, "description", data.Description.ValueString())
+	}
+	if !data.UmbrellaProtectionPolicy.IsNull() {
+		body, _ = sjson.Set(body, "umbrellaSettings.umbrellaProtectionPolicy", data.UmbrellaProtectionPolicy.ValueString())
+	}
+	if !data.Dnscrypt.IsNull() {
+		body, _ = sjson.Set(body, "umbrellaSettings.dnscrypt", data.Dnscrypt.ValueBool())
+	}
+	if !data.IdleTimeout.IsNull() {
+		body, _ = sjson.Set(body, "umbrellaSettings.idleTimeout", data.IdleTimeout.ValueInt64())
+	}
+	if !data.LocalDomainBypass.IsNull() {
+		var values []string
+		data.LocalDomainBypass.ElementsAs(ctx, &values, false)
+		body, _ = sjson.Set(body, "umbrellaSettings.localDomainBypass", values)
+	}
+	return body
+}
+
+// End of section. //template:end toBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBody
+
+func (data *UmbrellaDNSPolicy) fromBody(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("type"); value.Exists() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	if value := res.Get("umbrellaSettings.umbrellaProtectionPolicy"); value.Exists() {
+		data.UmbrellaProtectionPolicy = types.StringValue(value.String())
+	} else {
+		data.UmbrellaProtectionPolicy = types.StringNull()
+	}
+	if value := res.Get("umbrellaSettings.dnscrypt"); value.Exists() {
+		data.Dnscrypt = types.BoolValue(value.Bool())
+	} else {
+		data.Dnscrypt = types.BoolNull()
+	}
+	if value := res.Get("umbrellaSettings.idleTimeout"); value.Exists() {
+		data.IdleTimeout = types.Int64Value(value.Int())
+	} else {
+		data.IdleTimeout = types.Int64Null()
+	}
+	if value := res.Get("umbrellaSettings.localDomainBypass"); value.Exists() {
+		data.LocalDomainBypass = helpers.GetStringList(value.Array())
+	} else {
+		data.LocalDomainBypass = types.ListNull(types.StringType)
+	}
+}
+
+// End of section. //template:end fromBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyPartial
+
+// fromBodyPartial reads values from a gjson.Result into a tfstate model. It ignores null attributes in order to
+// uncouple the provider from the exact values that the backend API might summon to replace nulls. (Such behavior might
+// easily change across versions of the backend API.) For List/Set/Map attributes, the func only updates the
+// "managed" elements, instead of all elements.
+func (data *UmbrellaDNSPolicy) fromBodyPartial(ctx context.Context, res gjson.Result) {
+	if value := res.Get("name"); value.Exists() && !data.Name.IsNull() {
+		data.Name = types.StringValue(value.String())
+	} else {
+		data.Name = types.StringNull()
+	}
+	if value := res.Get("description"); value.Exists() && !data.Description.IsNull() {
+		data.Description = types.StringValue(value.String())
+	} else {
+		data.Description = types.StringNull()
+	}
+	if value := res.Get("type"); value.Exists() && !data.Type.IsNull() {
+		data.Type = types.StringValue(value.String())
+	} else {
+		data.Type = types.StringNull()
+	}
+	if value := res.Get("umbrellaSettings.umbrellaProtectionPolicy"); value.Exists() && !data.UmbrellaProtectionPolicy.IsNull() {
+		data.UmbrellaProtectionPolicy = types.StringValue(value.String())
+	} else {
+		data.UmbrellaProtectionPolicy = types.StringNull()
+	}
+	if value := res.Get("umbrellaSettings.dnscrypt"); value.Exists() && !data.Dnscrypt.IsNull() {
+		data.Dnscrypt = types.BoolValue(value.Bool())
+	} else {
+		data.Dnscrypt = types.BoolNull()
+	}
+	if value := res.Get("umbrellaSettings.idleTimeout"); value.Exists() && !data.IdleTimeout.IsNull() {
+		data.IdleTimeout = types.Int64Value(value.Int())
+	} else {
+		data.IdleTimeout = types.Int64Null()
+	}
+	if value := res.Get("umbrellaSettings.localDomainBypass"); value.Exists() && !data.LocalDomainBypass.IsNull() {
+		data.LocalDomainBypass = helpers.GetStringList(value.Array())
+	} else {
+		data.LocalDomainBypass = types.ListNull(types.StringType)
+	}
+}
+
+// End of section. //template:end fromBodyPartial
+
+// Section below is generated&owned by "gen/generator.go". //template:begin fromBodyUnknowns
+
+// fromBodyUnknowns updates the Unknown Computed tfstate values from a JSON.
+// Known values are not changed (usual for Computed attributes with UseStateForUnknown or with Default).
+func (data *UmbrellaDNSPolicy) fromBodyUnknowns(ctx context.Context, res gjson.Result) {
+	if data.Type.IsUnknown() {
+		if value := res.Get("type"); value.Exists() {
+			data.Type = types.StringValue(value.String())
+		} else {
+			data.Type = types.StringNull()
+		}
+	}
+}
+
+// End of section. //template:end fromBodyUnknowns
+
+// Section below is generated&owned by "gen/generator.go". //template:begin Clone
+
+// End of section. //template:end Clone
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyNonBulk
+
+// End of section. //template:end toBodyNonBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin findObjectsToBeReplaced
+
+// End of section. //template:end findObjectsToBeReplaced
+
+// Section below is generated&owned by "gen/generator.go". //template:begin clearItemIds
+
+// End of section. //template:end clearItemIds
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyPutDelete
+
+// End of section. //template:end toBodyPutDelete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBody
+
+// End of section. //template:end adjustBody
+
+// Section below is generated&owned by "gen/generator.go". //template:begin adjustBodyBulk
+
+// End of section. //template:end adjustBodyBulk
+
+// Section below is generated&owned by "gen/generator.go". //template:begin toBodyOverrides
+
+// End of section. //template:end toBodyOverrides
+
+// Section below is generated&owned by "gen/generator.go". //template:begin synthesizeOverrides
+
+// End of section. //template:end synthesizeOverrides
@@ -36,9 +36,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin types
 
 type VLANTagGroups struct {
-	Id     types.String                  `tfsdk:"id"`
-	Domain types.String                  `tfsdk:"domain"`
-	Items  map[string]VLANTagGroupsItems `tfsdk:"items"`
+	Id            types.String                  `tfsdk:"id"`
+	Domain        types.String                  `tfsdk:"domain"`
+	Items         map[string]VLANTagGroupsItems `tfsdk:"items"`
+	AdoptExisting types.Bool                    `tfsdk:"adopt_existing"`
 }
 
 type VLANTagGroupsItems struct {
@@ -42,11 +42,15 @@ type VPNS2SIKESettings struct {
 	Ikev1AuthenticationType          types.String                     `tfsdk:"ikev1_authentication_type"`
 	Ikev1AutomaticPreSharedKeyLength types.Int64                      `tfsdk:"ikev1_automatic_pre_shared_key_length"`
 	Ikev1ManualPreSharedKey          types.String                     `tfsdk:"ikev1_manual_pre_shared_key"`
+	Ikev1ManualPreSharedKeyWo        types.String                     `tfsdk:"ikev1_manual_pre_shared_key_wo"`
+	Ikev1ManualPreSharedKeyWoVersion types.Int64                      `tfsdk:"ikev1_manual_pre_shared_key_wo_version"`
 	Ikev1CertificateId               types.String                     `tfsdk:"ikev1_certificate_id"`
 	Ikev1Policies                    []VPNS2SIKESettingsIkev1Policies `tfsdk:"ikev1_policies"`
 	Ikev2AuthenticationType          types.String                     `tfsdk:"ikev2_authentication_type"`
 	Ikev2AutomaticPreSharedKeyLength types.Int64                      `tfsdk:"ikev2_automatic_pre_shared_key_length"`
 	Ikev2ManualPreSharedKey          types.String                     `tfsdk:"ikev2_manual_pre_shared_key"`
+	Ikev2ManualPreSharedKeyWo        types.String                     `tfsdk:"ikev2_manual_pre_shared_key_wo"`
+	Ikev2ManualPreSharedKeyWoVersion types.Int64                      `tfsdk:"ikev2_manual_pre_shared_key_wo_version"`
 	Ikev2EnforceHexBasedPreSharedKey types.Bool                       `tfsdk:"ikev2_enforce_hex_based_pre_shared_key"`
 	Ikev2CertificateId               types.String                     `tfsdk:"ikev2_certificate_id"`
 	Ikev2Policies                    []VPNS2SIKESettingsIkev2Policies `tfsdk:"ikev2_policies"`
@@ -92,6 +96,9 @@ func (data VPNS2SIKESettings) toBody(ctx context.Context, state VPNS2SIKESetting
 	if !data.Ikev1ManualPreSharedKey.IsNull() {
 		body, _ = sjson.Set(body, "ikeV1Settings.manualPreSharedKey", data.Ikev1ManualPreSharedKey.ValueString())
 	}
+	if !data.Ikev1ManualPreSharedKeyWo.IsNull() {
+		body, _ = sjson.Set(body, "ikeV1Settings.manualPreSharedKey", data.Ikev1ManualPreSharedKeyWo.ValueString())
+	}
 	if !data.Ikev1CertificateId.IsNull() {
 		body, _ = sjson.Set(body, "ikeV1Settings.certificateAuth.id", data.Ikev1CertificateId.ValueString())
 	}
@@ -117,6 +124,9 @@ func (data VPNS2SIKESettings) toBody(ctx context.Context, state VPNS2SIKESetting
 	if !data.Ikev2ManualPreSharedKey.IsNull() {
 		body, _ = sjson.Set(body, "ikeV2Settings.manualPreSharedKey", data.Ikev2ManualPreSharedKey.ValueString())
 	}
+	if !data.Ikev2ManualPreSharedKeyWo.IsNull() {
+		body, _ = sjson.Set(body, "ikeV2Settings.manualPreSharedKey", data.Ikev2ManualPreSharedKeyWo.ValueString())
+	}
 	if !data.Ikev2EnforceHexBasedPreSharedKey.IsNull() {
 		body, _ = sjson.Set(body, "ikeV2Settings.enforceHexBasedPreSharedKeyOnly", data.Ikev2EnforceHexBasedPreSharedKey.ValueBool())
 	}
@@ -25,21 +25,21 @@ import (
 )
 
 //
-// ConditionalUseStateForUnknownString sets value to `known after apply` if dependent attribute changes
+// ConditionalUseStateForUnknownString sets value to `known after apply` if any of dependent attributes changes
 //
 
 var _ planmodifier.String = conditionalUseStateForUnknownString{}
 
-func ConditionalUseStateForUnknownString(dependentAttribute string) planmodifier.String {
-	return conditionalUseStateForUnknownString{dependentAttribute: dependentAttribute}
+func ConditionalUseStateForUnknownString(dependentAttributes ...string) planmodifier.String {
+	return conditionalUseStateForUnknownString{dependentAttributes: dependentAttributes}
 }
 
 type conditionalUseStateForUnknownString struct {
-	dependentAttribute string
+	dependentAttributes []string
 }
 
 func (c conditionalUseStateForUnknownString) Description(ctx context.Context) string {
-	return "Conditionally set to `known after apply` based on change of `dependentAttribute`"
+	return "Conditionally set to `known after apply` based on change of `dependentAttributes`"
 }
 
 func (c conditionalUseStateForUnknownString) MarkdownDescription(ctx context.Context) string {
@@ -47,20 +47,27 @@ func (c conditionalUseStateForUnknownString) MarkdownDescription(ctx context.Con
 }
 
 func (c conditionalUseStateForUnknownString) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
-	// Get path to the dependent attribute
-	dependentAttributePath := req.Path.ParentPath().AtName(c.dependentAttribute)
+	// Nothing to keep on create, or if the value is known already
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
 
-	// Get state value of dependent attribute
-	var stateValue types.String
-	req.State.GetAttribute(ctx, dependentAttributePath, &stateValue)
+	for _, dependentAttribute := range c.dependentAttributes {
+		// Get path to the dependent attribute
+		dependentAttributePath := req.Path.ParentPath().AtName(dependentAttribute)
 
-	// Get plan value of dependent attribute
-	var planValue types.String
-	req.Plan.GetAttribute(ctx, dependentAttributePath, &planValue)
+		// Get state value of dependent attribute
+		var stateValue types.String
+		req.State.GetAttribute(ctx, dependentAttributePath, &stateValue)
 
-	// If state and plan values don't match, do nothing (sets value to `known after apply`)
-	if stateValue.ValueString() != planValue.ValueString() {
-		return
+		// Get plan value of dependent attribute
+		var planValue types.String
+		req.Plan.GetAttribute(ctx, dependentAttributePath, &planValue)
+
+		// If state and plan values don't match, do nothing (sets value to `known after apply`)
+		if planValue.IsUnknown() || stateValue.ValueString() != planValue.ValueString() {
+			return
+		}
 	}
 
 	// If state and plan values match, set plan to state value
@@ -18,20 +18,34 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"math/big"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
-// reattach.
+// reattach. If a cassette is active, the provider records or replays its
+// REST API interactions.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"fmc": providerserver.NewProtocol6WithError(New("test")()),
+	"fmc": func() (tfprotov6.ProviderServer, error) {
+		return providerserver.NewProtocol6WithError(&FmcProvider{
+			version:   "test",
+			transport: testAccCassette.transport(),
+		})()
+	},
 }
 
 func testAccPreCheck(t *testing.T) {
@@ -39,6 +53,9 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 
+	// Record or replay REST API interactions if FMC_CASSETTE is set
+	setupCassette(t)
+
 	username := os.Getenv("FMC_USERNAME")
 	password := os.Getenv("FMC_PASSWORD")
 	token := os.Getenv("FMC_TOKEN")
@@ -67,3 +84,152 @@ func testAccErrorCheck(t *testing.T, err error) error {
 	}
 	return err
 }
+
+// testUnitFunctionCase is a provider-defined function call with string arguments. A nil expected value means
+// the call must fail.
+type testUnitFunctionCase struct {
+	args     []string
+	expected attr.Value
+}
+
+// testUnitFunction runs function f with the arguments of every case and checks the result.
+func testUnitFunction(t *testing.T, f function.Function, cases []testUnitFunctionCase) {
+	t.Helper()
+	ctx := context.Background()
+
+	var metadata function.MetadataResponse
+	f.Metadata(ctx, function.MetadataRequest{}, &metadata)
+	var definition function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+
+	for _, c := range cases {
+		var args []attr.Value
+		for _, v := range c.args {
+			args = append(args, types.StringValue(v))
+		}
+		resp := function.RunResponse{Result: function.NewResultData(definition.Definition.Return.GetType().ValueType(ctx))}
+		f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+
+		if c.expected == nil {
+			if resp.Error == nil {
+				t.Errorf("%s%v: expected error", metadata.Name, c.args)
+			}
+			continue
+		}
+		if resp.Error != nil {
+			t.Errorf("%s%v: unexpected error %s", metadata.Name, c.args, resp.Error)
+		} else if !resp.Result.Value().Equal(c.expected) {
+			t.Errorf("%s%v: expected %s, got %s", metadata.Name, c.args, c.expected, resp.Result.Value())
+		}
+	}
+}
+
+// testUnitUpgradeState runs the state upgrader of every prior schema version of the resource with the given
+// prior state and checks that the upgraded state contains the expected attributes.
+func testUnitUpgradeState(t *testing.T, r resource.Resource, states [][2]string) {
+	t.Helper()
+	ctx := context.Background()
+
+	upgrader, ok := r.(resource.ResourceWithUpgradeState)
+	if !ok {
+		t.Fatalf("resource does not implement state upgrade")
+	}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if int(schemaResp.Schema.Version) != len(states) {
+		t.Fatalf("expected schema version %d, got %d", len(states), schemaResp.Schema.Version)
+	}
+	upgraders := upgrader.UpgradeState(ctx)
+	if len(upgraders) != len(states) {
+		t.Fatalf("expected %d state upgraders, got %d", len(states), len(upgraders))
+	}
+
+	typ := schemaResp.Schema.Type().TerraformType(ctx)
+	for version, state := range states {
+		var resp resource.UpgradeStateResponse
+		upgraders[int64(version)].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(state[0])}}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("version %d: unexpected diagnostics: %v", version, resp.Diagnostics)
+		}
+		value, err := resp.DynamicValue.Unmarshal(typ)
+		if err != nil {
+			t.Fatalf("version %d: %s", version, err)
+		}
+		var expected any
+		if err := json.Unmarshal([]byte(state[1]), &expected); err != nil {
+			t.Fatalf("version %d: invalid expected state: %s", version, err)
+		}
+		actual := tftypesValueToAny(value)
+		if !jsonSubset(expected, actual) {
+			t.Errorf("version %d: upgraded state %v does not contain %v", version, actual, expected)
+		}
+	}
+}
+
+// tftypesValueToAny converts a value to the representation used by encoding/json.
+func tftypesValueToAny(value tftypes.Value) any {
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+		return s
+	case value.Type().Is(tftypes.Number):
+		var f big.Float
+		_ = value.As(&f)
+		n, _ := f.Float64()
+		return n
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return b
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+		result := []any{}
+		for _, e := range elements {
+			result = append(result, tftypesValueToAny(e))
+		}
+		return result
+	default:
+		var attributes map[string]tftypes.Value
+		_ = value.As(&attributes)
+		result := map[string]any{}
+		for k, v := range attributes {
+			result[k] = tftypesValueToAny(v)
+		}
+		return result
+	}
+}
+
+// jsonSubset returns true if all object attributes in expected are equal in actual. Lists must have equal length.
+func jsonSubset(expected, actual any) bool {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range e {
+			if !jsonSubset(v, a[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !jsonSubset(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return expected == actual
+	}
+}
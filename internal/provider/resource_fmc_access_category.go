@@ -48,6 +48,7 @@ import (
 var (
 	_ resource.Resource                = &AccessCategoryResource{}
 	_ resource.ResourceWithImportState = &AccessCategoryResource{}
+	_ resource.ResourceWithModifyPlan  = &AccessCategoryResource{}
 )
 
 func NewAccessCategoryResource() resource.Resource {
@@ -55,7 +56,8 @@ func NewAccessCategoryResource() resource.Resource {
 }
 
 type AccessCategoryResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *AccessCategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,11 +78,9 @@ func (r *AccessCategoryResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"access_control_policy_id": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Id of the Access Control Policy.").String,
@@ -141,6 +141,11 @@ func (r *AccessCategoryResource) Configure(_ context.Context, req resource.Confi
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *AccessCategoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -167,7 +172,7 @@ func (r *AccessCategoryResource) Create(ctx context.Context, req resource.Create
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -221,7 +226,7 @@ func (r *AccessCategoryResource) Read(ctx context.Context, req resource.ReadRequ
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -279,7 +284,7 @@ func (r *AccessCategoryResource) Update(ctx context.Context, req resource.Update
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -314,7 +319,7 @@ func (r *AccessCategoryResource) Delete(ctx context.Context, req resource.Delete
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -333,11 +338,15 @@ func (r *AccessCategoryResource) Delete(ctx context.Context, req resource.Delete
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *AccessCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<access_control_policy_id>[^\s,]+),(?P<id>[^\s,]+?)$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<access_control_policy_id>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<access_control_policy_id>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
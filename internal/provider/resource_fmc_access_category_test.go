@@ -30,10 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcAccessCategory(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_category.test", "name", "my_category"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_access_category.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -42,7 +38,7 @@ func TestAccFmcAccessCategory(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcAccessCategoryPrerequisitesConfig + testAccFmcAccessCategoryConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcAccessCategoryChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -53,8 +49,25 @@ func TestAccFmcAccessCategory(t *testing.T) {
 	})
 }
 
+// testAccFmcAccessCategoryChecks returns the checks of the configuration with all attributes
+func testAccFmcAccessCategoryChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_category.test", "name", "my_category"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_access_category.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcAccessCategory(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcAccessCategoryPrerequisitesConfig = `
@@ -54,6 +54,7 @@ import (
 var (
 	_ resource.Resource                = &AccessControlPolicyResource{}
 	_ resource.ResourceWithImportState = &AccessControlPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &AccessControlPolicyResource{}
 )
 
 func NewAccessControlPolicyResource() resource.Resource {
@@ -61,7 +62,8 @@ func NewAccessControlPolicyResource() resource.Resource {
 }
 
 type AccessControlPolicyResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *AccessControlPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,11 +84,9 @@ func (r *AccessControlPolicyResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Name of the Access Control Policy.").String,
@@ -163,6 +163,18 @@ func (r *AccessControlPolicyResource) Schema(ctx context.Context, req resource.S
 				MarkdownDescription: helpers.NewAttributeDescription("Id of the Prefilter Policy.").String,
 				Optional:            true,
 			},
+			"decryption_policy_id": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Id of the Decryption Policy (`fmc_decryption_policy`).").String,
+				Optional:            true,
+			},
+			"dns_policy_id": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Id of the DNS Policy (`fmc_dns_policy`).").String,
+				Optional:            true,
+			},
+			"umbrella_dns_policy_id": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Id of the Umbrella DNS Policy (`fmc_umbrella_dns_policy`).").String,
+				Optional:            true,
+			},
 			"manage_categories": schema.BoolAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Should this resource manage Access Policy Categories. For Data Sources this defaults to `false` (Categories are not read).").AddDefaultValueDescription("true").String,
 				Optional:            true,
@@ -754,6 +766,11 @@ func (r *AccessControlPolicyResource) Configure(_ context.Context, req resource.
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *AccessControlPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -770,7 +787,7 @@ func (r *AccessControlPolicyResource) Create(ctx context.Context, req resource.C
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -861,7 +878,7 @@ func (r *AccessControlPolicyResource) Read(ctx context.Context, req resource.Rea
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.ValueString()))
@@ -972,7 +989,7 @@ func (r *AccessControlPolicyResource) Update(ctx context.Context, req resource.U
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -1019,7 +1036,7 @@ func (r *AccessControlPolicyResource) updateSubresources(ctx context.Context, tf
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	keptCats, keptRules := r.countKept(ctx, state, plan)
@@ -1256,7 +1273,7 @@ func (r *AccessControlPolicyResource) Delete(ctx context.Context, req resource.D
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -1274,11 +1291,15 @@ func (r *AccessControlPolicyResource) Delete(ctx context.Context, req resource.D
 // End of section. //template:end delete
 
 func (r *AccessControlPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<id>[^\s,]+?)$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
@@ -44,6 +44,7 @@ import (
 var (
 	_ resource.Resource                = &AccessControlPolicyInheritanceResource{}
 	_ resource.ResourceWithImportState = &AccessControlPolicyInheritanceResource{}
+	_ resource.ResourceWithModifyPlan  = &AccessControlPolicyInheritanceResource{}
 )
 
 func NewAccessControlPolicyInheritanceResource() resource.Resource {
@@ -51,7 +52,8 @@ func NewAccessControlPolicyInheritanceResource() resource.Resource {
 }
 
 type AccessControlPolicyInheritanceResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *AccessControlPolicyInheritanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,11 +74,9 @@ func (r *AccessControlPolicyInheritanceResource) Schema(ctx context.Context, req
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"access_control_policy_id": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Id of the Access Control Policy.").String,
@@ -106,6 +106,11 @@ func (r *AccessControlPolicyInheritanceResource) Configure(_ context.Context, re
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *AccessControlPolicyInheritanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -124,7 +129,7 @@ func (r *AccessControlPolicyInheritanceResource) Create(ctx context.Context, req
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 	//// ID needs to be retrieved from FMC, however we are expecting exactly one object
 	// Get objects from FMC
@@ -185,7 +190,7 @@ func (r *AccessControlPolicyInheritanceResource) Read(ctx context.Context, req r
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -243,7 +248,7 @@ func (r *AccessControlPolicyInheritanceResource) Update(ctx context.Context, req
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -277,7 +282,7 @@ func (r *AccessControlPolicyInheritanceResource) Delete(ctx context.Context, req
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -297,11 +302,15 @@ func (r *AccessControlPolicyInheritanceResource) Delete(ctx context.Context, req
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *AccessControlPolicyInheritanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<access_control_policy_id>[^\s,]+),(?P<id>[^\s,]+?)$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<access_control_policy_id>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<access_control_policy_id>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
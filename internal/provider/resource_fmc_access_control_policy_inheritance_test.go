@@ -29,13 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcAccessControlPolicyInheritance(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_access_control_policy_inheritance.test", "type"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcAccessControlPolicyInheritancePrerequisitesConfig + testAccFmcAccessControlPolicyInheritanceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcAccessControlPolicyInheritanceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -46,8 +43,24 @@ func TestAccFmcAccessControlPolicyInheritance(t *testing.T) {
 	})
 }
 
+// testAccFmcAccessControlPolicyInheritanceChecks returns the checks of the configuration with all attributes
+func testAccFmcAccessControlPolicyInheritanceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_access_control_policy_inheritance.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcAccessControlPolicyInheritance(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcAccessControlPolicyInheritancePrerequisitesConfig = `
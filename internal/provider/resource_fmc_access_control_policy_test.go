@@ -31,6 +31,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcAccessControlPolicy(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcAccessControlPolicyPrerequisitesConfig + testAccFmcAccessControlPolicyConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcAccessControlPolicyPrerequisitesConfig + testAccFmcAccessControlPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcAccessControlPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_access_control_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcAccessControlPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcAccessControlPolicyChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_control_policy.test", "name", "my_access_control_policy"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_access_control_policy.test", "type"))
@@ -63,31 +88,19 @@ func TestAccFmcAccessControlPolicy(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_control_policy.test", "rules.0.application_filters.0.categories.0.id", "118"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_control_policy.test", "rules.0.application_filters.0.tags.0.id", "24"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcAccessControlPolicyPrerequisitesConfig + testAccFmcAccessControlPolicyConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcAccessControlPolicyPrerequisitesConfig + testAccFmcAccessControlPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_access_control_policy.test",
-		ImportState:  true,
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcAccessControlPolicy(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcAccessControlPolicyPrerequisitesConfig = `
@@ -49,6 +49,7 @@ import (
 var (
 	_ resource.Resource                = &AccessRuleResource{}
 	_ resource.ResourceWithImportState = &AccessRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &AccessRuleResource{}
 )
 
 func NewAccessRuleResource() resource.Resource {
@@ -56,7 +57,8 @@ func NewAccessRuleResource() resource.Resource {
 }
 
 type AccessRuleResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *AccessRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -77,11 +79,9 @@ func (r *AccessRuleResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"access_control_policy_id": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Id of the Access Control Policy.").String,
@@ -652,6 +652,11 @@ func (r *AccessRuleResource) Configure(_ context.Context, req resource.Configure
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *AccessRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -681,7 +686,7 @@ func (r *AccessRuleResource) Create(ctx context.Context, req resource.CreateRequ
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	urlParams := ""
@@ -734,7 +739,7 @@ func (r *AccessRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.ValueString()))
@@ -794,7 +799,7 @@ func (r *AccessRuleResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -829,7 +834,7 @@ func (r *AccessRuleResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -848,11 +853,15 @@ func (r *AccessRuleResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *AccessRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<access_control_policy_id>[^\s,]+),(?P<id>[^\s,]+?)$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<access_control_policy_id>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<access_control_policy_id>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
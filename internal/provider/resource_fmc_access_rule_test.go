@@ -30,6 +30,27 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcAccessRule(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcAccessRulePrerequisitesConfig + testAccFmcAccessRuleConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcAccessRulePrerequisitesConfig + testAccFmcAccessRuleConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcAccessRuleChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcAccessRuleChecks returns the checks of the configuration with all attributes
+func testAccFmcAccessRuleChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_rule.test", "action", "ALLOW"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_rule.test", "name", "rule_1"))
@@ -51,27 +72,19 @@ func TestAccFmcAccessRule(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_rule.test", "application_filters.0.categories.0.id", "118"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_rule.test", "application_filters.0.tags.0.id", "24"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcAccessRulePrerequisitesConfig + testAccFmcAccessRuleConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcAccessRulePrerequisitesConfig + testAccFmcAccessRuleConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcAccessRule(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcAccessRulePrerequisitesConfig = `
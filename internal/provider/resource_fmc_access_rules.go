@@ -22,7 +22,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -51,7 +50,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var (
-	_ resource.Resource = &AccessRulesResource{}
+	_ resource.Resource               = &AccessRulesResource{}
+	_ resource.ResourceWithModifyPlan = &AccessRulesResource{}
 )
 
 func NewAccessRulesResource() resource.Resource {
@@ -59,7 +59,8 @@ func NewAccessRulesResource() resource.Resource {
 }
 
 type AccessRulesResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *AccessRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,7 +70,7 @@ func (r *AccessRulesResource) Metadata(ctx context.Context, req resource.Metadat
 func (r *AccessRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: helpers.NewAttributeDescription("This is an early access resource and it's behaviour may change in future releases.\n This resource manages Access Rules in Access Control Policies in bulk.\n Order of the rules is meant to be preserved within the resource, however *not* between multiple `fmc_access_rules` resources that create rules within a single category/section.\n Any change to the rule set will trigger recreation of all the rules. This is done to preserve the order of the rules. This usually means, that re-created rules will be placed at the end of the policy section/category.\n").String,
+		MarkdownDescription: helpers.NewAttributeDescription("This is an early access resource and it's behaviour may change in future releases.\n This resource manages Access Rules in Access Control Policies in bulk.\n Order of the rules is meant to be preserved within the resource, however *not* between multiple `fmc_access_rules` resources that create rules within a single category/section.\n Rules are matched by name. On change, existing rules are updated in place and keep their IDs, while new rules are inserted next to them. Only rules that change their relative order are deleted and re-created.\n").String,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -80,11 +81,9 @@ func (r *AccessRulesResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"access_control_policy_id": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Id of the Access Control Policy.").String,
@@ -653,12 +652,17 @@ func (r *AccessRulesResource) Configure(_ context.Context, req resource.Configur
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *AccessRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
 
 // Mutex to sync fmc_access_rules creation
-// Since creation of the access rules may be split into multible bulks,
+// Since creation of the access rules may be split into multible bulks and rules are inserted at a rule index,
 // we need to ensure that no other fmc_access_rules resource is trying to create access rules at the same time.
 var accessRulesCreateMu sync.Mutex
 
@@ -683,7 +687,7 @@ func (r *AccessRulesResource) Create(ctx context.Context, req resource.CreateReq
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	// Create new UUID for the bulk resource
@@ -691,7 +695,11 @@ func (r *AccessRulesResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Create rules
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
-	err := r.createRulesAt(ctx, plan, &plan, reqMods...)
+	// Mutex ensures that all rules, even if split into multiple bulks, are not mixed with
+	// other rules being created at the same time.
+	accessRulesCreateMu.Lock()
+	_, err := createBulkRules(r.bulkRules(ctx, plan, plan), plan.Items, "", 0, reqMods...)
+	accessRulesCreateMu.Unlock()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST/PUT), got error: %v", err))
 		return
@@ -716,7 +724,7 @@ func (r *AccessRulesResource) Read(ctx context.Context, req resource.ReadRequest
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	// Create URL path for the request
@@ -853,20 +861,21 @@ func (r *AccessRulesResource) Update(ctx context.Context, req resource.UpdateReq
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
 
-	err := r.truncateRulesAt(ctx, &state, 0, reqMods...)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object, got error: %v", err))
-		return
-	}
+	// Mutex ensures that rule indexes used for insertion are not shifted by other rules being created at the same time.
+	accessRulesCreateMu.Lock()
+	defer accessRulesCreateMu.Unlock()
 
-	err = r.createRulesAt(ctx, plan, &plan, reqMods...)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST/PUT), got error: %v", err))
+	items, diags := updateBulkRules(r.bulkRules(ctx, state, plan), state.Items, plan.Items, reqMods...)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		// Save the rules that are kept or created so far, as the deleted ones are already gone
+		state.Items = items
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
@@ -888,11 +897,15 @@ func (r *AccessRulesResource) Delete(ctx context.Context, req resource.DeleteReq
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
-	err := r.truncateRulesAt(ctx, &state, 0, reqMods...)
+	ids := make([]string, 0, len(state.Items))
+	for _, v := range state.Items {
+		ids = append(ids, v.Id.ValueString())
+	}
+	err := r.deleteRules(ctx, state, ids, reqMods...)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object, got error: %v", err))
 		return
@@ -906,76 +919,39 @@ func (r *AccessRulesResource) Delete(ctx context.Context, req resource.DeleteReq
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 // End of section. //template:end import
 
-func (r *AccessRulesResource) createRulesAt(ctx context.Context, plan AccessRules, state *AccessRules, reqMods ...func(*fmc.Req)) error {
-	var idx = 0
-	bulk := plan
-	bulk.Items = make([]AccessRulesItems, 0, bulkSizeCreate)
-	state.Items = state.Items[:0] // reset state items
-
-	// Mutex ensures that all rules, even if split into multiple bulks, are not mixed with
-	// other rules being created at the same time.
-	accessRulesCreateMu.Lock()
-	defer accessRulesCreateMu.Unlock()
-
-	// iterate over all items
-	for _, v := range plan.Items {
-		// count loops
-		idx++
-
-		// add object to current bulk
-		bulk.Items = append(bulk.Items, v)
-
-		// If bulk size was reached or all entries have been processed
-		if idx%bulkSizeCreate == 0 || idx == len(plan.Items) {
-
-			// Create object
-			body := bulk.toBody(ctx, AccessRules{})
-			body = gjson.Get(body, "items").String()
-
-			// Execute request
-			urlParams := "?bulk=true"
-			if c := plan.CategoryName.ValueString(); c != "" {
-				urlParams += "&category=" + url.QueryEscape(c)
-			} else if s := plan.Section.ValueString(); s != "" {
-				urlParams += "&section=" + url.QueryEscape(s)
-			}
-
-			res, err := r.client.Post(plan.getPath()+urlParams, body, reqMods...)
-			if err != nil {
-				return err
-			}
-
-			// Read result and save it to state
+// bulkRules returns the items of data for updateBulkRules and createBulkRules. New rules are appended to the
+// category/section of data. Rules are deleted from the policy of state.
+func (r *AccessRulesResource) bulkRules(ctx context.Context, state, data AccessRules) bulkRules[AccessRulesItems] {
+	urlParams := ""
+	if c := data.CategoryName.ValueString(); c != "" {
+		urlParams += "&category=" + url.QueryEscape(c)
+	} else if s := data.Section.ValueString(); s != "" {
+		urlParams += "&section=" + url.QueryEscape(s)
+	}
+	return bulkRules[AccessRulesItems]{
+		client:    r.client,
+		path:      data.getPath(),
+		urlParams: urlParams,
+		name:      func(v AccessRulesItems) string { return v.Name.ValueString() },
+		id:        func(v AccessRulesItems) types.String { return v.Id },
+		setId:     func(v *AccessRulesItems, id types.String) { v.Id = id },
+		toBody: func(items []AccessRulesItems) string {
+			return AccessRules{Items: items}.toBody(ctx, AccessRules{})
+		},
+		fromBodyUnknowns: func(items []AccessRulesItems, res gjson.Result) {
+			bulk := data
+			bulk.Items = items
 			bulk.fromBodyUnknowns(ctx, res)
-			state.Items = append(state.Items, bulk.Items...)
-			bulk.Items = bulk.Items[:0] // reset bulk items
-		}
+		},
+		deleteRules: func(ids []string, reqMods ...func(*fmc.Req)) error {
+			return r.deleteRules(ctx, state, ids, reqMods...)
+		},
 	}
-	return nil
 }
 
-func (r *AccessRulesResource) truncateRulesAt(ctx context.Context, state *AccessRules, kept int, reqMods ...func(*fmc.Req)) error {
-	var b strings.Builder
-	var bulks []string
-	var counts []int
-	count := 0
-
-	for i := kept; i < len(state.Items); i++ {
-		if b.Len() != 0 {
-			b.WriteString(",")
-		}
-		b.WriteString(state.Items[i].Id.ValueString())
-		count++
-		if b.Len() >= maxUrlParamLength {
-			bulks = append(bulks, b.String())
-			b.Reset()
-			counts = append(counts, count)
-			count = 0
-		}
-	}
-	if b.Len() > 0 {
-		bulks = append(bulks, b.String())
-		counts = append(counts, count)
+func (r *AccessRulesResource) deleteRules(ctx context.Context, state AccessRules, ids []string, reqMods ...func(*fmc.Req)) error {
+	if len(ids) == 0 {
+		return nil
 	}
 
 	defer func() {
@@ -983,14 +959,5 @@ func (r *AccessRulesResource) truncateRulesAt(ctx context.Context, state *Access
 		time.Sleep(2 * time.Second)
 	}()
 
-	for i, bulk := range bulks {
-		res, err := r.client.Delete(state.getPath()+"?bulk=true&filter=ids:"+url.QueryEscape(bulk), reqMods...)
-		if err != nil {
-			return fmt.Errorf("failed to bulk-delete rules, got error: %v, %s", err, res.String())
-		}
-
-		state.Items = slices.Delete(state.Items, kept, kept+counts[i])
-	}
-
-	return nil
+	return deleteRulesById(r.client, state.getPath(), ids, reqMods...)
 }
@@ -19,10 +19,15 @@ package provider
 
 // Section below is generated&owned by "gen/generator.go". //template:begin imports
 import (
+	"context"
 	"os"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tidwall/gjson"
 )
 
 // End of section. //template:end imports
@@ -30,6 +35,27 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcAccessRules(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcAccessRulesPrerequisitesConfig + testAccFmcAccessRulesConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcAccessRulesPrerequisitesConfig + testAccFmcAccessRulesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcAccessRulesChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcAccessRulesChecks returns the checks of the configuration with all attributes
+func testAccFmcAccessRulesChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_rules.test", "items.0.action", "ALLOW"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_rules.test", "items.0.name", "rule_1"))
@@ -51,27 +77,19 @@ func TestAccFmcAccessRules(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_rules.test", "items.0.application_filters.0.categories.0.id", "118"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_access_rules.test", "items.0.application_filters.0.tags.0.id", "24"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcAccessRulesPrerequisitesConfig + testAccFmcAccessRulesConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcAccessRulesPrerequisitesConfig + testAccFmcAccessRulesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcAccessRules(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcAccessRulesPrerequisitesConfig = `
@@ -185,3 +203,176 @@ func testAccFmcAccessRulesConfig_all() string {
 }
 
 // End of section. //template:end testAccConfigAll
+
+func TestUnitKeptAccessRules(t *testing.T) {
+	items := func(names ...string) []AccessRulesItems {
+		res := make([]AccessRulesItems, 0, len(names))
+		for _, v := range names {
+			res = append(res, AccessRulesItems{Name: types.StringValue(v)})
+		}
+		return res
+	}
+
+	name := func(v AccessRulesItems) string { return v.Name.ValueString() }
+
+	cases := []struct {
+		name  string
+		state []string
+		plan  []string
+		kept  []int
+	}{
+		{"unchanged", []string{"a", "b", "c"}, []string{"a", "b", "c"}, []int{0, 1, 2}},
+		{"create", nil, []string{"a", "b"}, []int{-1, -1}},
+		{"insert", []string{"a", "b"}, []string{"x", "a", "y", "b", "z"}, []int{-1, 0, -1, 1, -1}},
+		{"delete", []string{"a", "b", "c"}, []string{"a", "c"}, []int{0, 2}},
+		{"move to top", []string{"a", "b", "c", "d"}, []string{"d", "a", "b", "c"}, []int{-1, 0, 1, 2}},
+		{"move to bottom", []string{"a", "b", "c", "d"}, []string{"b", "c", "d", "a"}, []int{1, 2, 3, -1}},
+		{"swap", []string{"a", "b", "c", "d"}, []string{"a", "c", "b", "d"}, []int{0, -1, 1, 3}},
+		{"reverse", []string{"a", "b", "c"}, []string{"c", "b", "a"}, []int{-1, -1, 0}},
+		{"duplicate names", []string{"a"}, []string{"a", "a"}, []int{-1, 0}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if kept := keptRules(items(c.state...), items(c.plan...), name); !slices.Equal(kept, c.kept) {
+				t.Errorf("expected %v, got %v", c.kept, kept)
+			}
+		})
+	}
+}
+
+func TestUnitAccessRulesUpdateIncremental(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	r := &AccessRulesResource{client: client}
+	rulesPath := "/policy/accesspolicies/" + m.AddObject("/policy/accesspolicies", `{"name":"acp"}`) + "/accessrules"
+
+	rules := func(items ...string) AccessRules {
+		data := AccessRules{
+			Id:                    types.StringValue("bulk"),
+			Domain:                types.StringValue("Global"),
+			AccessControlPolicyId: types.StringValue(strings.Split(rulesPath, "/")[3]),
+			Section:               types.StringValue("mandatory"),
+		}
+		for _, v := range items {
+			name, action, _ := strings.Cut(v, ":")
+			data.Items = append(data.Items, AccessRulesItems{
+				Id:      types.StringUnknown(),
+				Name:    types.StringValue(name),
+				Action:  types.StringValue(action),
+				Enabled: types.BoolValue(true),
+			})
+		}
+		return data
+	}
+
+	state := rules("a:ALLOW", "b:ALLOW", "c:ALLOW", "d:ALLOW")
+	if _, err := createBulkRules(r.bulkRules(ctx, state, state), state.Items, "", 0); err != nil {
+		t.Fatalf("failed to create rules: %s", err)
+	}
+	ids := map[string]string{}
+	for _, v := range state.Items {
+		ids[v.Name.ValueString()] = v.Id.ValueString()
+	}
+	before := len(m.Requests())
+
+	resp := testUnitResourceUpdate(ctx, r, nil, rules("x:ALLOW", "a:ALLOW", "c:BLOCK", "y:ALLOW", "d:ALLOW", "z:ALLOW"), state)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to update rules: %v", resp.Diagnostics)
+	}
+
+	var names []string
+	res, _ := client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}" + rulesPath + "?expanded=true")
+	for _, v := range res.Get("items").Array() {
+		names = append(names, v.Get("name").String())
+		if id, ok := ids[v.Get("name").String()]; ok && id != v.Get("id").String() {
+			t.Errorf("expected rule %s to keep its ID", v.Get("name").String())
+		}
+	}
+	if !slices.Equal(names, []string{"x", "a", "c", "y", "d", "z"}) {
+		t.Errorf("unexpected rule order %v", names)
+	}
+	if action := gjson.Get(m.Object(rulesPath+"/"+ids["c"]), "action").String(); action != "BLOCK" {
+		t.Errorf("expected rule c to be updated in place, got action %s", action)
+	}
+
+	var requests []string
+	for _, v := range m.Requests()[before:] {
+		if !strings.HasPrefix(v, "GET") {
+			requests = append(requests, v[:strings.Index(v, " ")])
+		}
+	}
+	if !slices.Equal(requests, []string{"DELETE", "PUT", "POST", "POST", "POST"}) {
+		t.Errorf("unexpected requests %v", m.Requests()[before:])
+	}
+
+	var data AccessRules
+	resp.State.Get(ctx, &data)
+	for _, v := range data.Items {
+		if v.Id.IsUnknown() || v.Id.ValueString() == "" {
+			t.Errorf("expected rule %s to have an ID in state", v.Name.ValueString())
+		}
+	}
+}
+
+func TestUnitAccessRulesUpdatePartial(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	r := &AccessRulesResource{client: client}
+	rulesPath := "/policy/accesspolicies/" + m.AddObject("/policy/accesspolicies", `{"name":"acp"}`) + "/accessrules"
+
+	rules := func(items ...string) AccessRules {
+		data := AccessRules{
+			Id:                    types.StringValue("bulk"),
+			Domain:                types.StringValue("Global"),
+			AccessControlPolicyId: types.StringValue(strings.Split(rulesPath, "/")[3]),
+			Section:               types.StringValue("mandatory"),
+		}
+		for _, v := range items {
+			name, action, _ := strings.Cut(v, ":")
+			data.Items = append(data.Items, AccessRulesItems{
+				Id:      types.StringUnknown(),
+				Name:    types.StringValue(name),
+				Action:  types.StringValue(action),
+				Enabled: types.BoolValue(true),
+			})
+		}
+		return data
+	}
+
+	state := rules("a:ALLOW", "b:ALLOW", "c:ALLOW")
+	if _, err := createBulkRules(r.bulkRules(ctx, state, state), state.Items, "", 0); err != nil {
+		t.Fatalf("failed to create rules: %s", err)
+	}
+	ids := map[string]string{}
+	for _, v := range state.Items {
+		ids[v.Name.ValueString()] = v.Id.ValueString()
+	}
+
+	// Rule c is removed outside of Terraform, so its update in place fails after rule b is deleted
+	if _, err := client.Delete("/api/fmc_config/v1/domain/{DOMAIN_UUID}" + rulesPath + "/" + ids["c"]); err != nil {
+		t.Fatalf("failed to delete rule: %s", err)
+	}
+
+	resp := testUnitResourceUpdate(ctx, r, nil, rules("a:BLOCK", "c:BLOCK", "y:ALLOW"), state)
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected update of missing rule to fail")
+	}
+	if m.Count(rulesPath) != 1 || gjson.Get(m.Object(rulesPath+"/"+ids["a"]), "action").String() != "BLOCK" {
+		t.Errorf("expected rule b to be deleted and rule a to be updated on FMC")
+	}
+
+	var data AccessRules
+	resp.State.Get(ctx, &data)
+	var names []string
+	for _, v := range data.Items {
+		names = append(names, v.Name.ValueString()+":"+v.Action.ValueString())
+		if v.Id.ValueString() != ids[v.Name.ValueString()] {
+			t.Errorf("expected rule %s to keep its ID in state", v.Name.ValueString())
+		}
+	}
+	if !slices.Equal(names, []string{"a:BLOCK", "c:ALLOW"}) {
+		t.Errorf("expected deleted rule to be removed from state and failed update not to be saved, got %v", names)
+	}
+}
@@ -29,14 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcApplicationFilter(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_application_filter.test", "name", "my_application_filter"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_application_filter.test", "type"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcApplicationFilterPrerequisitesConfig + testAccFmcApplicationFilterConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcApplicationFilterChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_application_filter.test",
@@ -51,8 +47,25 @@ func TestAccFmcApplicationFilter(t *testing.T) {
 	})
 }
 
+// testAccFmcApplicationFilterChecks returns the checks of the configuration with all attributes
+func testAccFmcApplicationFilterChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_application_filter.test", "name", "my_application_filter"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_application_filter.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcApplicationFilter(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcApplicationFilterPrerequisitesConfig = `
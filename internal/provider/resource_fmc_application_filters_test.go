@@ -29,14 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcApplicationFilters(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_application_filters.test", "items.my_application_filter.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_application_filters.test", "items.my_application_filter.type"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcApplicationFiltersPrerequisitesConfig + testAccFmcApplicationFiltersConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcApplicationFiltersChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -47,8 +43,25 @@ func TestAccFmcApplicationFilters(t *testing.T) {
 	})
 }
 
+// testAccFmcApplicationFiltersChecks returns the checks of the configuration with all attributes
+func testAccFmcApplicationFiltersChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_application_filters.test", "items.my_application_filter.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_application_filters.test", "items.my_application_filter.type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcApplicationFilters(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcApplicationFiltersPrerequisitesConfig = `
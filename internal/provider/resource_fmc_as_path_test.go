@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcASPath(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_path.test", "name", "100"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_as_path.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_path.test", "overridable", "false"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_path.test", "entries.0.action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_path.test", "entries.0.regular_expression", "^(100|200)$"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcASPath(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcASPathConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcASPathChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_as_path.test",
@@ -60,8 +53,48 @@ func TestAccFmcASPath(t *testing.T) {
 	})
 }
 
+// testAccFmcASPathChecks returns the checks of the configuration with all attributes
+func testAccFmcASPathChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_path.test", "name", "100"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_as_path.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_path.test", "overridable", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_path.test", "entries.0.action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_path.test", "entries.0.regular_expression", "^(100|200)$"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcASPath(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcASPathConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcASPathConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcASPathChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_as_path.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -51,6 +52,8 @@ import (
 var (
 	_ resource.Resource                = &ASPathsResource{}
 	_ resource.ResourceWithImportState = &ASPathsResource{}
+	_ resource.ResourceWithModifyPlan  = &ASPathsResource{}
+	_ resource.ResourceWithMoveState   = &ASPathsResource{}
 )
 
 func NewASPathsResource() resource.Resource {
@@ -58,7 +61,8 @@ func NewASPathsResource() resource.Resource {
 }
 
 type ASPathsResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *ASPathsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,7 +72,7 @@ func (r *ASPathsResource) Metadata(ctx context.Context, req resource.MetadataReq
 func (r *ASPathsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: helpers.NewAttributeDescription("This resource manages AS Paths through bulk operations.").AddMinimumVersionHeaderDescription().AddMinimumVersionBulkCreateDescription("999").AddMinimumVersionBulkDeleteDescription("999").AddMinimumVersionBulkUpdateDescription().String,
+		MarkdownDescription: helpers.NewAttributeDescription("This resource manages AS Paths through bulk operations.").AddMinimumVersionHeaderDescription().AddMinimumVersionBulkCreateDescription("999").AddMinimumVersionBulkDeleteDescription("999").AddMinimumVersionBulkUpdateDescription("").String,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -79,11 +83,9 @@ func (r *ASPathsResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"items": schema.MapNestedAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Map of AS Paths. The key of the map is the name of the individual AS Path object.").String,
@@ -130,6 +132,12 @@ func (r *ASPathsResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Adopt objects that already exist on FMC with the same name as an item, instead of creating them. Adopted objects are updated if their configuration differs. Only applies when items are created.").AddDefaultValueDescription("false").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -140,6 +148,18 @@ func (r *ASPathsResource) Configure(_ context.Context, req resource.ConfigureReq
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *ASPathsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
+}
+
+// MoveState adopts objects managed by single object resources, see `moved` block
+func (r *ASPathsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.BulkStateMover("fmc_as_path", 0),
+	}
 }
 
 // End of section. //template:end model
@@ -158,7 +178,7 @@ func (r *ASPathsResource) Create(ctx context.Context, req resource.CreateRequest
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -205,7 +225,7 @@ func (r *ASPathsResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -260,7 +280,7 @@ func (r *ASPathsResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -301,6 +321,7 @@ func (r *ASPathsResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Create new objects (objects that have missing IDs in plan)
 	var toCreate ASPaths
 	toCreate.Items = make(map[string]ASPathsItems, len(plan.Items))
+	toCreate.AdoptExisting = plan.AdoptExisting
 	// Scan plan for items with no ID
 	for k, v := range plan.Items {
 		if v.Id.IsUnknown() || v.Id.IsNull() {
@@ -382,7 +403,7 @@ func (r *ASPathsResource) Delete(ctx context.Context, req resource.DeleteRequest
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -408,11 +429,15 @@ func (r *ASPathsResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *ASPathsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?\[(?P<names>.*?)\]$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,[<item1_name>,<item2_name>,...]\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,[<item1_name>,<item2_name>,...]\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
@@ -441,6 +466,58 @@ func (r *ASPathsResource) ImportState(ctx context.Context, req resource.ImportSt
 // createSubresources takes list of objects, splits them into bulks and creates them
 // We want to save the state after each create event, to be able track already created resources
 func (r *ASPathsResource) createSubresources(ctx context.Context, state, plan ASPaths, reqMods ...func(*fmc.Req)) (ASPaths, diag.Diagnostics) {
+	// Adopt objects that already exist on FMC with the same name, instead of creating them
+	if plan.AdoptExisting.ValueBool() {
+		res, err := r.client.Get(state.getPath()+"?expanded=true", reqMods...)
+		if err != nil {
+			return state, diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to retrieve existing objects (GET), got error: %s, %s", state.Id.ValueString(), err, res.String())),
+			}
+		}
+		existing := make(map[string]gjson.Result)
+		res.Get("items").ForEach(func(_, v gjson.Result) bool {
+			if name := v.Get("name").String(); name != "" {
+				existing[name] = v
+			}
+			return true
+		})
+
+		toCreate := plan.Clone()
+		var tmpObject ASPaths
+		tmpObject.Items = make(map[string]ASPathsItems, 1)
+		for k, v := range plan.Items {
+			item, found := existing[k]
+			if !found {
+				continue
+			}
+			delete(toCreate.Items, k)
+			v.Id = types.StringValue(item.Get("id").String())
+			tmpObject.Items[k] = v
+
+			// Update adopted object only if its configuration differs
+			body := tmpObject.toBodyNonBulk(ctx, state)
+			body = tmpObject.adjustBody(ctx, body)
+			if !helpers.IsJSONSubset(body, item.Raw) {
+				tflog.Debug(ctx, fmt.Sprintf("%s: Updating adopted object %s", state.Id.ValueString(), k))
+				res, err := r.client.Put(state.getPath()+"/"+url.QueryEscape(v.Id.ValueString()), body, reqMods...)
+				if err != nil {
+					return state, diag.Diagnostics{
+						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to update adopted object (PUT) id %s, got error: %s, %s", state.Id.ValueString(), v.Id.ValueString(), err, res.String())),
+					}
+				}
+			}
+
+			// fromBodyUnknowns expect result to be listed under "items" key
+			body, _ = sjson.SetRaw("{}", "items.-1", item.Raw)
+			tmpObject.fromBodyUnknowns(ctx, gjson.Parse(body))
+
+			// Save adopted object to state
+			state.Items[k] = tmpObject.Items[k]
+			delete(tmpObject.Items, k)
+		}
+		plan = toCreate
+	}
+
 	// Check if FMC version supports bulk creates
 	if r.client.FMCVersionParsed.LessThan(minFMCVersionBulkCreateASPaths) {
 		tflog.Debug(ctx, fmt.Sprintf("%s: One-by-one creation mode (AS Paths)", state.Id.ValueString()))
@@ -536,9 +613,12 @@ func (r *ASPathsResource) deleteSubresources(ctx context.Context, state, plan AS
 			urlPath := state.getPath() + "/" + url.QueryEscape(v.Id.ValueString())
 			res, err := r.client.Delete(urlPath, reqMods...)
 			if err != nil && !strings.Contains(err.Error(), "StatusCode 404") {
-				return state, diag.Diagnostics{
+				diags := diag.Diagnostics{
 					diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete object (DELETE) id %s, got error: %s, %s", state.Id.ValueString(), v.Id.ValueString(), err, res.String())),
 				}
+				// Explain which objects reference the object
+				diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, []fmcObjectUsageQuery{{Name: k, Id: v.Id.ValueString(), Type: v.Type.ValueString()}}, reqMods)...)
+				return state, diags
 			}
 
 			// Remove deleted item from state
@@ -553,6 +633,7 @@ func (r *ASPathsResource) deleteSubresources(ctx context.Context, state, plan AS
 		estimatedCapacity := min(len(objectsToRemove.Items)*estimatedIDLength, maxUrlParamLength)
 		var idsToRemove strings.Builder
 		idsToRemove.Grow(estimatedCapacity)
+		var objectsInBulk []fmcObjectUsageQuery
 
 		for k, v := range objectsToRemove.Items {
 			// Counter
@@ -567,15 +648,28 @@ func (r *ASPathsResource) deleteSubresources(ctx context.Context, state, plan AS
 			// Create list of IDs of items to delete
 			idsToRemove.WriteString(v.Id.ValueString())
 			idsToRemove.WriteString(",")
+			objectsInBulk = append(objectsInBulk, fmcObjectUsageQuery{Name: k, Id: v.Id.ValueString(), Type: v.Type.ValueString()})
 
 			// If bulk size was reached or all entries have been processed
 			if idsToRemove.Len() >= maxUrlParamLength || idx == len(objectsToRemove.Items) {
 				urlPath := state.getPath() + "?bulk=true&filter=ids:" + url.QueryEscape(idsToRemove.String())
 				res, err := r.client.Delete(urlPath, reqMods...)
 				if err != nil {
-					return state, diag.Diagnostics{
+					diags := diag.Diagnostics{
 						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete subobject(s) (DELETE), got error: %s, %s", state.Id.ValueString(), err, res.String())),
 					}
+					// Explain which objects reference the objects of this bulk, that failed to be deleted
+					failedObjects := fmcFailedObjects(res, objectsInBulk)
+					if len(failedObjects) == 0 {
+						// The error does not tell which objects failed, so delete the objects of this bulk one by one
+						var deletedItems []string
+						deletedItems, failedObjects = fmcDeleteObjectsOneByOne(ctx, r.client, state.getPath(), objectsInBulk, reqMods)
+						for _, name := range deletedItems {
+							delete(state.Items, name)
+						}
+					}
+					diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, failedObjects, reqMods)...)
+					return state, diags
 				}
 
 				// Read result and remove deleted items from state
@@ -586,6 +680,7 @@ func (r *ASPathsResource) deleteSubresources(ctx context.Context, state, plan AS
 
 				// Reset ID string
 				idsToRemove.Reset()
+				objectsInBulk = nil
 			}
 		}
 	}
@@ -597,13 +692,14 @@ func (r *ASPathsResource) deleteSubresources(ctx context.Context, state, plan AS
 
 // Section below is generated&owned by "gen/generator.go". //template:begin updateSubresources
 
-// updateSubresources take elements one-by-one and updates them, as bulks are not supported
+// updateSubresources updates objects either in bulk, or one-by-one, depending on FMC version
+// Objects updated successfully are saved to the state, even if updates of other objects fail
 func (r *ASPathsResource) updateSubresources(ctx context.Context, state, plan ASPaths, reqMods ...func(*fmc.Req)) (ASPaths, diag.Diagnostics) {
-	var tmpObject ASPaths
-	tmpObject.Items = make(map[string]ASPathsItems, 1)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: One-by-one update mode (AS Paths)", state.Id.ValueString()))
 
+	var tmpObject ASPaths
+	tmpObject.Items = make(map[string]ASPathsItems, 1)
 	for k, v := range plan.Items {
 		tmpObject.Items[k] = v
 
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcASPaths(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_as_paths.test", "items.240.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_as_paths.test", "items.240.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_paths.test", "items.240.overridable", "false"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_paths.test", "items.240.entries.0.action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_paths.test", "items.240.entries.0.regular_expression", "^(100|200)$"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcASPaths(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcASPathsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcASPathsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +49,43 @@ func TestAccFmcASPaths(t *testing.T) {
 	})
 }
 
+// testAccFmcASPathsChecks returns the checks of the configuration with all attributes
+func testAccFmcASPathsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_as_paths.test", "items.240.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_as_paths.test", "items.240.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_paths.test", "items.240.overridable", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_paths.test", "items.240.entries.0.action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_as_paths.test", "items.240.entries.0.regular_expression", "^(100|200)$"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcASPaths(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcASPathsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcASPathsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcASPathsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
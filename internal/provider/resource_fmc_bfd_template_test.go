@@ -30,6 +30,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcBFDTemplate(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcBFDTemplateConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcBFDTemplateConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcBFDTemplateChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_bfd_template.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcBFDTemplateChecks returns the checks of the configuration with all attributes
+func testAccFmcBFDTemplateChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_bfd_template.test", "name", "my_bfd_template"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_bfd_template.test", "type"))
@@ -43,30 +68,39 @@ func TestAccFmcBFDTemplate(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_bfd_template.test", "authentication_password_encryption", "UN_ENCRYPTED"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_bfd_template.test", "authentication_key_id", "1"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcBFDTemplate(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcBFDTemplateConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcBFDTemplateConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcBFDTemplateConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcBFDTemplateChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_bfd_template.test",
-		ImportState:  true,
+		ResourceName:            "fmc_bfd_template.test",
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"authentication_password"},
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
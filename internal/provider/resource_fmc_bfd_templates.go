@@ -35,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -53,6 +54,8 @@ import (
 var (
 	_ resource.Resource                = &BFDTemplatesResource{}
 	_ resource.ResourceWithImportState = &BFDTemplatesResource{}
+	_ resource.ResourceWithModifyPlan  = &BFDTemplatesResource{}
+	_ resource.ResourceWithMoveState   = &BFDTemplatesResource{}
 )
 
 func NewBFDTemplatesResource() resource.Resource {
@@ -60,7 +63,8 @@ func NewBFDTemplatesResource() resource.Resource {
 }
 
 type BFDTemplatesResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *BFDTemplatesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -70,7 +74,7 @@ func (r *BFDTemplatesResource) Metadata(ctx context.Context, req resource.Metada
 func (r *BFDTemplatesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: helpers.NewAttributeDescription("This resource manages BFD Templates through bulk operations.").AddMinimumVersionHeaderDescription().AddMinimumVersionDescription("7.4").AddMinimumVersionBulkCreateDescription("999").AddMinimumVersionBulkDeleteDescription("999").AddMinimumVersionBulkUpdateDescription().String,
+		MarkdownDescription: helpers.NewAttributeDescription("This resource manages BFD Templates through bulk operations.").AddMinimumVersionHeaderDescription().AddMinimumVersionDescription("7.4").AddMinimumVersionBulkCreateDescription("999").AddMinimumVersionBulkDeleteDescription("999").AddMinimumVersionBulkUpdateDescription("").String,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -81,11 +85,9 @@ func (r *BFDTemplatesResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"items": schema.MapNestedAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Map of BFD Templates. The key of the map is the name of the individual BFD Template.").String,
@@ -180,6 +182,12 @@ func (r *BFDTemplatesResource) Schema(ctx context.Context, req resource.SchemaRe
 					},
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Adopt objects that already exist on FMC with the same name as an item, instead of creating them. Adopted objects are updated if their configuration differs. Only applies when items are created.").AddDefaultValueDescription("false").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -190,6 +198,18 @@ func (r *BFDTemplatesResource) Configure(_ context.Context, req resource.Configu
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *BFDTemplatesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
+}
+
+// MoveState adopts objects managed by single object resources, see `moved` block
+func (r *BFDTemplatesResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.BulkStateMover("fmc_bfd_template", 0),
+	}
 }
 
 // End of section. //template:end model
@@ -214,7 +234,7 @@ func (r *BFDTemplatesResource) Create(ctx context.Context, req resource.CreateRe
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -266,7 +286,7 @@ func (r *BFDTemplatesResource) Read(ctx context.Context, req resource.ReadReques
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -321,7 +341,7 @@ func (r *BFDTemplatesResource) Update(ctx context.Context, req resource.UpdateRe
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -363,6 +383,7 @@ func (r *BFDTemplatesResource) Update(ctx context.Context, req resource.UpdateRe
 	// Create new objects (objects that have missing IDs in plan)
 	toCreate := toBeReplaced.Clone()
 	toCreate.clearItemsIds(ctx)
+	toCreate.AdoptExisting = plan.AdoptExisting
 	// Scan plan for items with no ID
 	for k, v := range plan.Items {
 		if v.Id.IsUnknown() || v.Id.IsNull() {
@@ -449,7 +470,7 @@ func (r *BFDTemplatesResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -475,11 +496,15 @@ func (r *BFDTemplatesResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *BFDTemplatesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?\[(?P<names>.*?)\]$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,[<item1_name>,<item2_name>,...]\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,[<item1_name>,<item2_name>,...]\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
@@ -508,6 +533,57 @@ func (r *BFDTemplatesResource) ImportState(ctx context.Context, req resource.Imp
 // createSubresources takes list of objects, splits them into bulks and creates them
 // We want to save the state after each create event, to be able track already created resources
 func (r *BFDTemplatesResource) createSubresources(ctx context.Context, state, plan BFDTemplates, reqMods ...func(*fmc.Req)) (BFDTemplates, diag.Diagnostics) {
+	// Adopt objects that already exist on FMC with the same name, instead of creating them
+	if plan.AdoptExisting.ValueBool() {
+		res, err := r.client.Get(state.getPath()+"?expanded=true", reqMods...)
+		if err != nil {
+			return state, diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to retrieve existing objects (GET), got error: %s, %s", state.Id.ValueString(), err, res.String())),
+			}
+		}
+		existing := make(map[string]gjson.Result)
+		res.Get("items").ForEach(func(_, v gjson.Result) bool {
+			if name := v.Get("name").String(); name != "" {
+				existing[name] = v
+			}
+			return true
+		})
+
+		toCreate := plan.Clone()
+		var tmpObject BFDTemplates
+		tmpObject.Items = make(map[string]BFDTemplatesItems, 1)
+		for k, v := range plan.Items {
+			item, found := existing[k]
+			if !found {
+				continue
+			}
+			delete(toCreate.Items, k)
+			v.Id = types.StringValue(item.Get("id").String())
+			tmpObject.Items[k] = v
+
+			// Update adopted object only if its configuration differs
+			body := tmpObject.toBodyNonBulk(ctx, state)
+			if !helpers.IsJSONSubset(body, item.Raw) {
+				tflog.Debug(ctx, fmt.Sprintf("%s: Updating adopted object %s", state.Id.ValueString(), k))
+				res, err := r.client.Put(state.getPath()+"/"+url.QueryEscape(v.Id.ValueString()), body, reqMods...)
+				if err != nil {
+					return state, diag.Diagnostics{
+						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to update adopted object (PUT) id %s, got error: %s, %s", state.Id.ValueString(), v.Id.ValueString(), err, res.String())),
+					}
+				}
+			}
+
+			// fromBodyUnknowns expect result to be listed under "items" key
+			body, _ = sjson.SetRaw("{}", "items.-1", item.Raw)
+			tmpObject.fromBodyUnknowns(ctx, gjson.Parse(body))
+
+			// Save adopted object to state
+			state.Items[k] = tmpObject.Items[k]
+			delete(tmpObject.Items, k)
+		}
+		plan = toCreate
+	}
+
 	// Check if FMC version supports bulk creates
 	if r.client.FMCVersionParsed.LessThan(minFMCVersionBulkCreateBFDTemplates) {
 		tflog.Debug(ctx, fmt.Sprintf("%s: One-by-one creation mode (BFD Templates)", state.Id.ValueString()))
@@ -601,9 +677,12 @@ func (r *BFDTemplatesResource) deleteSubresources(ctx context.Context, state, pl
 			urlPath := state.getPath() + "/" + url.QueryEscape(v.Id.ValueString())
 			res, err := r.client.Delete(urlPath, reqMods...)
 			if err != nil && !strings.Contains(err.Error(), "StatusCode 404") {
-				return state, diag.Diagnostics{
+				diags := diag.Diagnostics{
 					diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete object (DELETE) id %s, got error: %s, %s", state.Id.ValueString(), v.Id.ValueString(), err, res.String())),
 				}
+				// Explain which objects reference the object
+				diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, []fmcObjectUsageQuery{{Name: k, Id: v.Id.ValueString(), Type: "BFDTemplate"}}, reqMods)...)
+				return state, diags
 			}
 
 			// Remove deleted item from state
@@ -618,6 +697,7 @@ func (r *BFDTemplatesResource) deleteSubresources(ctx context.Context, state, pl
 		estimatedCapacity := min(len(objectsToRemove.Items)*estimatedIDLength, maxUrlParamLength)
 		var idsToRemove strings.Builder
 		idsToRemove.Grow(estimatedCapacity)
+		var objectsInBulk []fmcObjectUsageQuery
 
 		for k, v := range objectsToRemove.Items {
 			// Counter
@@ -632,15 +712,28 @@ func (r *BFDTemplatesResource) deleteSubresources(ctx context.Context, state, pl
 			// Create list of IDs of items to delete
 			idsToRemove.WriteString(v.Id.ValueString())
 			idsToRemove.WriteString(",")
+			objectsInBulk = append(objectsInBulk, fmcObjectUsageQuery{Name: k, Id: v.Id.ValueString(), Type: "BFDTemplate"})
 
 			// If bulk size was reached or all entries have been processed
 			if idsToRemove.Len() >= maxUrlParamLength || idx == len(objectsToRemove.Items) {
 				urlPath := state.getPath() + "?bulk=true&filter=ids:" + url.QueryEscape(idsToRemove.String())
 				res, err := r.client.Delete(urlPath, reqMods...)
 				if err != nil {
-					return state, diag.Diagnostics{
+					diags := diag.Diagnostics{
 						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete subobject(s) (DELETE), got error: %s, %s", state.Id.ValueString(), err, res.String())),
 					}
+					// Explain which objects reference the objects of this bulk, that failed to be deleted
+					failedObjects := fmcFailedObjects(res, objectsInBulk)
+					if len(failedObjects) == 0 {
+						// The error does not tell which objects failed, so delete the objects of this bulk one by one
+						var deletedItems []string
+						deletedItems, failedObjects = fmcDeleteObjectsOneByOne(ctx, r.client, state.getPath(), objectsInBulk, reqMods)
+						for _, name := range deletedItems {
+							delete(state.Items, name)
+						}
+					}
+					diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, failedObjects, reqMods)...)
+					return state, diags
 				}
 
 				// Read result and remove deleted items from state
@@ -651,6 +744,7 @@ func (r *BFDTemplatesResource) deleteSubresources(ctx context.Context, state, pl
 
 				// Reset ID string
 				idsToRemove.Reset()
+				objectsInBulk = nil
 			}
 		}
 	}
@@ -662,13 +756,14 @@ func (r *BFDTemplatesResource) deleteSubresources(ctx context.Context, state, pl
 
 // Section below is generated&owned by "gen/generator.go". //template:begin updateSubresources
 
-// updateSubresources take elements one-by-one and updates them, as bulks are not supported
+// updateSubresources updates objects either in bulk, or one-by-one, depending on FMC version
+// Objects updated successfully are saved to the state, even if updates of other objects fail
 func (r *BFDTemplatesResource) updateSubresources(ctx context.Context, state, plan BFDTemplates, reqMods ...func(*fmc.Req)) (BFDTemplates, diag.Diagnostics) {
-	var tmpObject BFDTemplates
-	tmpObject.Items = make(map[string]BFDTemplatesItems, 1)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: One-by-one update mode (BFD Templates)", state.Id.ValueString()))
 
+	var tmpObject BFDTemplates
+	tmpObject.Items = make(map[string]BFDTemplatesItems, 1)
 	for k, v := range plan.Items {
 		tmpObject.Items[k] = v
 
@@ -30,6 +30,27 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcBFDTemplates(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcBFDTemplatesConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcBFDTemplatesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcBFDTemplatesChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcBFDTemplatesChecks returns the checks of the configuration with all attributes
+func testAccFmcBFDTemplatesChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_bfd_templates.test", "items.my_bfd_templates.id"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_bfd_templates.test", "items.my_bfd_templates.type"))
@@ -43,26 +64,33 @@ func TestAccFmcBFDTemplates(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_bfd_templates.test", "items.my_bfd_templates.authentication_password_encryption", "UN_ENCRYPTED"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_bfd_templates.test", "items.my_bfd_templates.authentication_key_id", "1"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcBFDTemplates(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcBFDTemplatesConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcBFDTemplatesConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcBFDTemplatesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcBFDTemplatesChecks()...),
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcCertificateEnrollment(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_enrollment.test", "name", "my_certificate_enrollment"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_certificate_enrollment.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_enrollment.test", "description", "My certificate enrollment"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcCertificateEnrollment(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcCertificateEnrollmentPrerequisitesConfig + testAccFmcCertificateEnrollmentConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcCertificateEnrollmentChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_certificate_enrollment.test",
@@ -58,8 +53,26 @@ func TestAccFmcCertificateEnrollment(t *testing.T) {
 	})
 }
 
+// testAccFmcCertificateEnrollmentChecks returns the checks of the configuration with all attributes
+func testAccFmcCertificateEnrollmentChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_enrollment.test", "name", "my_certificate_enrollment"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_certificate_enrollment.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_enrollment.test", "description", "My certificate enrollment"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcCertificateEnrollment(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcCertificateEnrollmentPrerequisitesConfig = `
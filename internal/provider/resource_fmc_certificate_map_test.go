@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcCertificateMap(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "name", "my_certificate_map"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_certificate_map.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "rules.0.field", "SUBJECT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "rules.0.component", "COMMON_NAME"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "rules.0.operator", "EQUALS"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "rules.0.value", "cisco.com"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcCertificateMap(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcCertificateMapConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcCertificateMapChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_certificate_map.test",
@@ -61,8 +53,49 @@ func TestAccFmcCertificateMap(t *testing.T) {
 	})
 }
 
+// testAccFmcCertificateMapChecks returns the checks of the configuration with all attributes
+func testAccFmcCertificateMapChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "name", "my_certificate_map"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_certificate_map.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "rules.0.field", "SUBJECT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "rules.0.component", "COMMON_NAME"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "rules.0.operator", "EQUALS"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_certificate_map.test", "rules.0.value", "cisco.com"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcCertificateMap(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcCertificateMapConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcCertificateMapConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcCertificateMapChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_certificate_map.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -51,6 +52,8 @@ import (
 var (
 	_ resource.Resource                = &CertificateMapsResource{}
 	_ resource.ResourceWithImportState = &CertificateMapsResource{}
+	_ resource.ResourceWithModifyPlan  = &CertificateMapsResource{}
+	_ resource.ResourceWithMoveState   = &CertificateMapsResource{}
 )
 
 func NewCertificateMapsResource() resource.Resource {
@@ -58,7 +61,8 @@ func NewCertificateMapsResource() resource.Resource {
 }
 
 type CertificateMapsResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *CertificateMapsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,7 +72,7 @@ func (r *CertificateMapsResource) Metadata(ctx context.Context, req resource.Met
 func (r *CertificateMapsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: helpers.NewAttributeDescription("This resource manages Certificate Maps through bulk operations.").AddMinimumVersionHeaderDescription().AddMinimumVersionBulkCreateDescription("999").AddMinimumVersionBulkDeleteDescription("999").AddMinimumVersionBulkUpdateDescription().String,
+		MarkdownDescription: helpers.NewAttributeDescription("This resource manages Certificate Maps through bulk operations.").AddMinimumVersionHeaderDescription().AddMinimumVersionBulkCreateDescription("999").AddMinimumVersionBulkDeleteDescription("999").AddMinimumVersionBulkUpdateDescription("").String,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -79,11 +83,9 @@ func (r *CertificateMapsResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"items": schema.MapNestedAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Map of Certificate Maps. The key of the map is the name of the individual Certificate Map.").String,
@@ -140,6 +142,12 @@ func (r *CertificateMapsResource) Schema(ctx context.Context, req resource.Schem
 					},
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Adopt objects that already exist on FMC with the same name as an item, instead of creating them. Adopted objects are updated if their configuration differs. Only applies when items are created.").AddDefaultValueDescription("false").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -150,6 +158,18 @@ func (r *CertificateMapsResource) Configure(_ context.Context, req resource.Conf
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *CertificateMapsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
+}
+
+// MoveState adopts objects managed by single object resources, see `moved` block
+func (r *CertificateMapsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		helpers.BulkStateMover("fmc_certificate_map", 0),
+	}
 }
 
 // End of section. //template:end model
@@ -168,7 +188,7 @@ func (r *CertificateMapsResource) Create(ctx context.Context, req resource.Creat
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -215,7 +235,7 @@ func (r *CertificateMapsResource) Read(ctx context.Context, req resource.ReadReq
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -270,7 +290,7 @@ func (r *CertificateMapsResource) Update(ctx context.Context, req resource.Updat
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -311,6 +331,7 @@ func (r *CertificateMapsResource) Update(ctx context.Context, req resource.Updat
 	// Create new objects (objects that have missing IDs in plan)
 	var toCreate CertificateMaps
 	toCreate.Items = make(map[string]CertificateMapsItems, len(plan.Items))
+	toCreate.AdoptExisting = plan.AdoptExisting
 	// Scan plan for items with no ID
 	for k, v := range plan.Items {
 		if v.Id.IsUnknown() || v.Id.IsNull() {
@@ -392,7 +413,7 @@ func (r *CertificateMapsResource) Delete(ctx context.Context, req resource.Delet
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -418,11 +439,15 @@ func (r *CertificateMapsResource) Delete(ctx context.Context, req resource.Delet
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *CertificateMapsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?\[(?P<names>.*?)\]$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,[<item1_name>,<item2_name>,...]\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,[<item1_name>,<item2_name>,...]\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
@@ -451,6 +476,57 @@ func (r *CertificateMapsResource) ImportState(ctx context.Context, req resource.
 // createSubresources takes list of objects, splits them into bulks and creates them
 // We want to save the state after each create event, to be able track already created resources
 func (r *CertificateMapsResource) createSubresources(ctx context.Context, state, plan CertificateMaps, reqMods ...func(*fmc.Req)) (CertificateMaps, diag.Diagnostics) {
+	// Adopt objects that already exist on FMC with the same name, instead of creating them
+	if plan.AdoptExisting.ValueBool() {
+		res, err := r.client.Get(state.getPath()+"?expanded=true", reqMods...)
+		if err != nil {
+			return state, diag.Diagnostics{
+				diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to retrieve existing objects (GET), got error: %s, %s", state.Id.ValueString(), err, res.String())),
+			}
+		}
+		existing := make(map[string]gjson.Result)
+		res.Get("items").ForEach(func(_, v gjson.Result) bool {
+			if name := v.Get("name").String(); name != "" {
+				existing[name] = v
+			}
+			return true
+		})
+
+		toCreate := plan.Clone()
+		var tmpObject CertificateMaps
+		tmpObject.Items = make(map[string]CertificateMapsItems, 1)
+		for k, v := range plan.Items {
+			item, found := existing[k]
+			if !found {
+				continue
+			}
+			delete(toCreate.Items, k)
+			v.Id = types.StringValue(item.Get("id").String())
+			tmpObject.Items[k] = v
+
+			// Update adopted object only if its configuration differs
+			body := tmpObject.toBodyNonBulk(ctx, state)
+			if !helpers.IsJSONSubset(body, item.Raw) {
+				tflog.Debug(ctx, fmt.Sprintf("%s: Updating adopted object %s", state.Id.ValueString(), k))
+				res, err := r.client.Put(state.getPath()+"/"+url.QueryEscape(v.Id.ValueString()), body, reqMods...)
+				if err != nil {
+					return state, diag.Diagnostics{
+						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to update adopted object (PUT) id %s, got error: %s, %s", state.Id.ValueString(), v.Id.ValueString(), err, res.String())),
+					}
+				}
+			}
+
+			// fromBodyUnknowns expect result to be listed under "items" key
+			body, _ = sjson.SetRaw("{}", "items.-1", item.Raw)
+			tmpObject.fromBodyUnknowns(ctx, gjson.Parse(body))
+
+			// Save adopted object to state
+			state.Items[k] = tmpObject.Items[k]
+			delete(tmpObject.Items, k)
+		}
+		plan = toCreate
+	}
+
 	// Check if FMC version supports bulk creates
 	if r.client.FMCVersionParsed.LessThan(minFMCVersionBulkCreateCertificateMaps) {
 		tflog.Debug(ctx, fmt.Sprintf("%s: One-by-one creation mode (Certificate Maps)", state.Id.ValueString()))
@@ -544,9 +620,12 @@ func (r *CertificateMapsResource) deleteSubresources(ctx context.Context, state,
 			urlPath := state.getPath() + "/" + url.QueryEscape(v.Id.ValueString())
 			res, err := r.client.Delete(urlPath, reqMods...)
 			if err != nil && !strings.Contains(err.Error(), "StatusCode 404") {
-				return state, diag.Diagnostics{
+				diags := diag.Diagnostics{
 					diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete object (DELETE) id %s, got error: %s, %s", state.Id.ValueString(), v.Id.ValueString(), err, res.String())),
 				}
+				// Explain which objects reference the object
+				diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, []fmcObjectUsageQuery{{Name: k, Id: v.Id.ValueString(), Type: v.Type.ValueString()}}, reqMods)...)
+				return state, diags
 			}
 
 			// Remove deleted item from state
@@ -561,6 +640,7 @@ func (r *CertificateMapsResource) deleteSubresources(ctx context.Context, state,
 		estimatedCapacity := min(len(objectsToRemove.Items)*estimatedIDLength, maxUrlParamLength)
 		var idsToRemove strings.Builder
 		idsToRemove.Grow(estimatedCapacity)
+		var objectsInBulk []fmcObjectUsageQuery
 
 		for k, v := range objectsToRemove.Items {
 			// Counter
@@ -575,15 +655,28 @@ func (r *CertificateMapsResource) deleteSubresources(ctx context.Context, state,
 			// Create list of IDs of items to delete
 			idsToRemove.WriteString(v.Id.ValueString())
 			idsToRemove.WriteString(",")
+			objectsInBulk = append(objectsInBulk, fmcObjectUsageQuery{Name: k, Id: v.Id.ValueString(), Type: v.Type.ValueString()})
 
 			// If bulk size was reached or all entries have been processed
 			if idsToRemove.Len() >= maxUrlParamLength || idx == len(objectsToRemove.Items) {
 				urlPath := state.getPath() + "?bulk=true&filter=ids:" + url.QueryEscape(idsToRemove.String())
 				res, err := r.client.Delete(urlPath, reqMods...)
 				if err != nil {
-					return state, diag.Diagnostics{
+					diags := diag.Diagnostics{
 						diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete subobject(s) (DELETE), got error: %s, %s", state.Id.ValueString(), err, res.String())),
 					}
+					// Explain which objects reference the objects of this bulk, that failed to be deleted
+					failedObjects := fmcFailedObjects(res, objectsInBulk)
+					if len(failedObjects) == 0 {
+						// The error does not tell which objects failed, so delete the objects of this bulk one by one
+						var deletedItems []string
+						deletedItems, failedObjects = fmcDeleteObjectsOneByOne(ctx, r.client, state.getPath(), objectsInBulk, reqMods)
+						for _, name := range deletedItems {
+							delete(state.Items, name)
+						}
+					}
+					diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, failedObjects, reqMods)...)
+					return state, diags
 				}
 
 				// Read result and remove deleted items from state
@@ -594,6 +687,7 @@ func (r *CertificateMapsResource) deleteSubresources(ctx context.Context, state,
 
 				// Reset ID string
 				idsToRemove.Reset()
+				objectsInBulk = nil
 			}
 		}
 	}
@@ -605,13 +699,14 @@ func (r *CertificateMapsResource) deleteSubresources(ctx context.Context, state,
 
 // Section below is generated&owned by "gen/generator.go". //template:begin updateSubresources
 
-// updateSubresources take elements one-by-one and updates them, as bulks are not supported
+// updateSubresources updates objects either in bulk, or one-by-one, depending on FMC version
+// Objects updated successfully are saved to the state, even if updates of other objects fail
 func (r *CertificateMapsResource) updateSubresources(ctx context.Context, state, plan CertificateMaps, reqMods ...func(*fmc.Req)) (CertificateMaps, diag.Diagnostics) {
-	var tmpObject CertificateMaps
-	tmpObject.Items = make(map[string]CertificateMapsItems, 1)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: One-by-one update mode (Certificate Maps)", state.Id.ValueString()))
 
+	var tmpObject CertificateMaps
+	tmpObject.Items = make(map[string]CertificateMapsItems, 1)
 	for k, v := range plan.Items {
 		tmpObject.Items[k] = v
 
@@ -33,15 +33,6 @@ func TestAccFmcChassisEtherChannelInterface(t *testing.T) {
 	if os.Getenv("TF_VAR_chassis_id") == "" || os.Getenv("TF_VAR_chassis_interface_id") == "" || os.Getenv("FMC_CHASSIS_ETHERCHANNEL_INTERFACE") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_chassis_id and TF_VAR_chassis_interface_id and FMC_CHASSIS_ETHERCHANNEL_INTERFACE")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_etherchannel_interface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_etherchannel_interface.test", "name"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "ether_channel_id", "10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "port_type", "DATA"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "admin_state", "ENABLED"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "lacp_mode", "ACTIVE"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "lacp_rate", "DEFAULT"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -50,7 +41,7 @@ func TestAccFmcChassisEtherChannelInterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcChassisEtherChannelInterfacePrerequisitesConfig + testAccFmcChassisEtherChannelInterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcChassisEtherChannelInterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -61,8 +52,30 @@ func TestAccFmcChassisEtherChannelInterface(t *testing.T) {
 	})
 }
 
+// testAccFmcChassisEtherChannelInterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcChassisEtherChannelInterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_etherchannel_interface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_etherchannel_interface.test", "name"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "ether_channel_id", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "port_type", "DATA"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "admin_state", "ENABLED"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "lacp_mode", "ACTIVE"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_etherchannel_interface.test", "lacp_rate", "DEFAULT"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcChassisEtherChannelInterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcChassisEtherChannelInterfacePrerequisitesConfig = `
@@ -27,6 +27,8 @@ import (
 	"time"
 
 	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -51,6 +53,7 @@ import (
 var (
 	_ resource.Resource                = &ChassisLogicalDeviceResource{}
 	_ resource.ResourceWithImportState = &ChassisLogicalDeviceResource{}
+	_ resource.ResourceWithModifyPlan  = &ChassisLogicalDeviceResource{}
 )
 
 func NewChassisLogicalDeviceResource() resource.Resource {
@@ -58,7 +61,8 @@ func NewChassisLogicalDeviceResource() resource.Resource {
 }
 
 type ChassisLogicalDeviceResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *ChassisLogicalDeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -79,11 +83,9 @@ func (r *ChassisLogicalDeviceResource) Schema(ctx context.Context, req resource.
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"chassis_id": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Id of the parent chassis.").String,
@@ -202,12 +204,31 @@ func (r *ChassisLogicalDeviceResource) Schema(ctx context.Context, req resource.
 			},
 			"device_password": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Admin password for the device.").String,
-				Required:            true,
+				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("device_password_wo")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"device_password_wo": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Admin password for the device. Write-only variant of `device_password`, the value is never stored in the state (requires Terraform 1.11 or later). Change `device_password_wo_version` to send a new value to FMC.").String,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"device_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Version of `device_password_wo`. Any change of this value triggers an update of `device_password_wo` on FMC.").String,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("device_password_wo")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
 			"admin_state": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Admin state of the device.").AddStringEnumDescription("ENABLED", "DISABLED").AddDefaultValueDescription("ENABLED").String,
 				Optional:            true,
@@ -287,6 +308,9 @@ func (r *ChassisLogicalDeviceResource) Schema(ctx context.Context, req resource.
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -296,6 +320,11 @@ func (r *ChassisLogicalDeviceResource) Configure(_ context.Context, req resource
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *ChassisLogicalDeviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -303,7 +332,6 @@ func (r *ChassisLogicalDeviceResource) Configure(_ context.Context, req resource
 func (r *ChassisLogicalDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ChassisLogicalDevice
 	var res2 fmc.Res
-	const atom time.Duration = 60 * time.Second
 
 	// Read plan
 	diags := req.Plan.Get(ctx, &plan)
@@ -311,10 +339,26 @@ func (r *ChassisLogicalDeviceResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config ChassisLogicalDevice
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.DevicePasswordWo = config.DevicePasswordWo
+
+	// Apply create timeout
+	createTimeout, diags := plan.Timeouts.Create(ctx, 45*time.Minute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -345,7 +389,8 @@ func (r *ChassisLogicalDeviceResource) Create(ctx context.Context, req resource.
 	}
 
 	// Deployment will trigger the creation of the logical device, but it will take some time. Wait for this to finish to get the device ID.
-	for i := time.Duration(0); i < 45*time.Minute; i += atom {
+	var poller jobPoller
+	for {
 		// FMCBUG: plan.getPath() endpoint also returns the device ID once deployed, but for whatever reason it does not show up if Get request is looped
 		//         for that reason the devicerecords endpoint is used
 		// filter needs to be exact device name, so search for `ftd` won't find `ftd-1`
@@ -359,7 +404,9 @@ func (r *ChassisLogicalDeviceResource) Create(ctx context.Context, req resource.
 		if res2.Get("items.0.id").Exists() {
 			break
 		}
-		time.Sleep(atom)
+		if poller.wait(ctx) != nil {
+			break
+		}
 	}
 
 	// Check if the device was created
@@ -401,7 +448,7 @@ func (r *ChassisLogicalDeviceResource) Read(ctx context.Context, req resource.Re
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -448,16 +495,32 @@ func (r *ChassisLogicalDeviceResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config ChassisLogicalDevice
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.DevicePasswordWo = config.DevicePasswordWo
+
 	// Read state
 	diags = req.State.Get(ctx, &state)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Apply update timeout
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 45*time.Minute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -525,10 +588,18 @@ func (r *ChassisLogicalDeviceResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	// Apply delete timeout
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 45*time.Minute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -583,11 +654,15 @@ func (r *ChassisLogicalDeviceResource) Delete(ctx context.Context, req resource.
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *ChassisLogicalDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<chassis_id>[^\s,]+),(?P<id>[^\s,]+?)$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<chassis_id>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<chassis_id>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
@@ -33,6 +33,27 @@ func TestAccFmcChassisLogicalDevice(t *testing.T) {
 	if os.Getenv("TF_VAR_chassis_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_chassis_id")
 	}
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcChassisLogicalDeviceConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcChassisLogicalDeviceConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcChassisLogicalDeviceChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcChassisLogicalDeviceChecks returns the checks of the configuration with all attributes
+func testAccFmcChassisLogicalDeviceChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_logical_device.test", "type"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_logical_device.test", "device_id"))
@@ -62,27 +83,19 @@ func TestAccFmcChassisLogicalDevice(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_logical_device.test", "container_role"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_logical_device.test", "container_status"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcChassisLogicalDeviceConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcChassisLogicalDeviceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcChassisLogicalDevice(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration references parent objects, which must exist on FMC")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
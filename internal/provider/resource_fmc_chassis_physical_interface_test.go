@@ -33,12 +33,6 @@ func TestAccFmcChassisPhysicalInterface(t *testing.T) {
 	if os.Getenv("TF_VAR_chassis_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_chassis_id")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_physical_interface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_physical_interface.test", "name", "Ethernet1/1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_physical_interface.test", "port_type", "DATA"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_physical_interface.test", "admin_state", "ENABLED"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -47,7 +41,7 @@ func TestAccFmcChassisPhysicalInterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcChassisPhysicalInterfacePrerequisitesConfig + testAccFmcChassisPhysicalInterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcChassisPhysicalInterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -58,8 +52,27 @@ func TestAccFmcChassisPhysicalInterface(t *testing.T) {
 	})
 }
 
+// testAccFmcChassisPhysicalInterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcChassisPhysicalInterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_physical_interface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_physical_interface.test", "name", "Ethernet1/1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_physical_interface.test", "port_type", "DATA"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_physical_interface.test", "admin_state", "ENABLED"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcChassisPhysicalInterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcChassisPhysicalInterfacePrerequisitesConfig = `
@@ -33,13 +33,6 @@ func TestAccFmcChassisSubinterface(t *testing.T) {
 	if os.Getenv("TF_VAR_chassis_id") == "" || os.Getenv("TF_VAR_chassis_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_chassis_id and TF_VAR_chassis_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_subinterface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_subinterface.test", "name"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_subinterface.test", "sub_interface_id", "7"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_subinterface.test", "vlan_id", "4094"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_subinterface.test", "port_type", "DATA"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -48,7 +41,7 @@ func TestAccFmcChassisSubinterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcChassisSubinterfacePrerequisitesConfig + testAccFmcChassisSubinterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcChassisSubinterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -59,8 +52,28 @@ func TestAccFmcChassisSubinterface(t *testing.T) {
 	})
 }
 
+// testAccFmcChassisSubinterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcChassisSubinterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_subinterface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis_subinterface.test", "name"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_subinterface.test", "sub_interface_id", "7"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_subinterface.test", "vlan_id", "4094"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis_subinterface.test", "port_type", "DATA"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcChassisSubinterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcChassisSubinterfacePrerequisitesConfig = `
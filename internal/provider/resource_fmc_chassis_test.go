@@ -33,10 +33,6 @@ func TestAccFmcChassis(t *testing.T) {
 	if os.Getenv("TF_VAR_chassis_registration_key") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_chassis_registration_key")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis.test", "name", "my_chassis"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +41,7 @@ func TestAccFmcChassis(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcChassisConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcChassisChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_chassis.test",
@@ -60,8 +56,25 @@ func TestAccFmcChassis(t *testing.T) {
 	})
 }
 
+// testAccFmcChassisChecks returns the checks of the configuration with all attributes
+func testAccFmcChassisChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_chassis.test", "name", "my_chassis"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_chassis.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcChassis(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs environment specific test data (TF_VAR_chassis_registration_key)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
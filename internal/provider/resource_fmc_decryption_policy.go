@@ -0,0 +1,396 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                = &DecryptionPolicyResource{}
+	_ resource.ResourceWithImportState = &DecryptionPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &DecryptionPolicyResource{}
+)
+
+func NewDecryptionPolicyResource() resource.Resource {
+	return &DecryptionPolicyResource{}
+}
+
+type DecryptionPolicyResource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (r *DecryptionPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_decryption_policy"
+}
+
+func (r *DecryptionPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource manages Decryption (SSL) Policy. Rules of the policy are managed with `fmc_decryption_rules`. The policy is applied by referencing it from `fmc_access_control_policy` (`decryption_policy_id`).").AddMinimumVersionHeaderDescription().AddMinimumVersionAnyDescription().AddMinimumVersionCreateDescription("7.4").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Name of the Decryption Policy.").String,
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Description of the policy.").String,
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Type of the object; this value is always 'SSLPolicy'.").String,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Action to take when none of the rules match.").AddStringEnumDescription("DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET").String,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET"),
+				},
+			},
+			"default_action_id": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Id of the default action.").String,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_action_log_connection_end": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Log events at the end of the connection for default action.").String,
+				Optional:            true,
+			},
+			"default_action_send_events_to_fmc": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Send events to the Firepower Management Center event viewer for default action.").String,
+				Optional:            true,
+			},
+			"default_action_syslog_alert_id": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Id of syslog alert. Can be set only when `default_action_log_connection_end` is true.").String,
+				Optional:            true,
+			},
+			"undecryptable_compressed_session_action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Action for compressed sessions.").AddStringEnumDescription("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET"),
+				},
+			},
+			"undecryptable_sslv2_session_action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Action for SSLv2 sessions.").AddStringEnumDescription("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET"),
+				},
+			},
+			"undecryptable_unknown_cipher_suite_action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Action for sessions with unknown cipher suite.").AddStringEnumDescription("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET"),
+				},
+			},
+			"undecryptable_unsupported_cipher_suite_action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Action for sessions with unsupported cipher suite.").AddStringEnumDescription("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET"),
+				},
+			},
+			"undecryptable_session_not_cached_action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Action for sessions, that are not cached.").AddStringEnumDescription("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET"),
+				},
+			},
+			"undecryptable_handshake_errors_action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Action for sessions with handshake errors.").AddStringEnumDescription("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("INHERIT_DEFAULT_ACTION", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET"),
+				},
+			},
+			"undecryptable_decryption_errors_action": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Action for sessions with decryption errors.").AddStringEnumDescription("BLOCK", "BLOCK_WITH_RESET").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("BLOCK", "BLOCK_WITH_RESET"),
+				},
+			},
+		},
+	}
+}
+
+func (r *DecryptionPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *DecryptionPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
+}
+
+// End of section. //template:end model
+
+// Section below is generated&owned by "gen/generator.go". //template:begin create
+
+func (r *DecryptionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	// Check if FMC client is connected to supports this object
+	if r.client.FMCVersionParsed.LessThan(minFMCVersionCreateDecryptionPolicy) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("UnsupportedVersion: FMC version %s does not support Decryption Policy creation, minumum required version is 7.4", r.client.FMCVersion))
+		return
+	}
+	var plan DecryptionPolicy
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	// Create object
+	body := plan.toBody(ctx, DecryptionPolicy{})
+	res, err := r.client.Post(plan.getPath(), body, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST/PUT), got error: %s, %s", err, res.String()))
+		return
+	}
+	plan.Id = types.StringValue(res.Get("id").String())
+	plan.fromBodyUnknowns(ctx, res)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	helpers.SetFlagImporting(ctx, false, resp.Private, &resp.Diagnostics)
+}
+
+// End of section. //template:end create
+
+// Section below is generated&owned by "gen/generator.go". //template:begin read
+
+func (r *DecryptionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DecryptionPolicy
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	urlPath := state.getPath() + "/" + url.QueryEscape(state.Id.ValueString())
+	res, err := r.client.Get(urlPath, reqMods...)
+
+	if err != nil && strings.Contains(err.Error(), "StatusCode 404") {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		return
+	}
+
+	imp, diags := helpers.IsFlagImporting(ctx, req)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After `terraform import` we switch to a full read.
+	if imp {
+		state.fromBody(ctx, res)
+	} else {
+		state.fromBodyPartial(ctx, res)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	helpers.SetFlagImporting(ctx, false, resp.Private, &resp.Diagnostics)
+}
+
+// End of section. //template:end read
+
+// Section below is generated&owned by "gen/generator.go". //template:begin update
+
+func (r *DecryptionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DecryptionPolicy
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read state
+	diags = req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	body := plan.toBody(ctx, state)
+	res, err := r.client.Put(plan.getPath()+"/"+url.QueryEscape(plan.Id.ValueString()), body, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// End of section. //template:end update
+
+// Section below is generated&owned by "gen/generator.go". //template:begin delete
+
+func (r *DecryptionPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DecryptionPolicy
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+	res, err := r.client.Delete(state.getPath()+"/"+url.QueryEscape(state.Id.ValueString()), reqMods...)
+	if err != nil && !strings.Contains(err.Error(), "StatusCode 404") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+// End of section. //template:end delete
+
+// Section below is generated&owned by "gen/generator.go". //template:begin import
+func (r *DecryptionPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
+	// Parse import ID
+	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<id>[^\s,]+?)$`)
+	match := inputPattern.FindStringSubmatch(req.ID)
+	if match == nil {
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		resp.Diagnostics.AddError("Import error", errMsg)
+		return
+	}
+
+	// Set domain, if provided
+	if tmpDomain := match[inputPattern.SubexpIndex("domain")]; tmpDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), tmpDomain)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), match[inputPattern.SubexpIndex("id")])...)
+
+	helpers.SetFlagImporting(ctx, true, resp.Private, &resp.Diagnostics)
+}
+
+// End of section. //template:end import
+
+// Section below is generated&owned by "gen/generator.go". //template:begin createSubresources
+
+// End of section. //template:end createSubresources
+
+// Section below is generated&owned by "gen/generator.go". //template:begin deleteSubresources
+
+// End of section. //template:end deleteSubresources
+
+// Section below is generated&owned by "gen/generator.go". //template:begin updateSubresources
+
+// End of section. //template:end updateSubresources
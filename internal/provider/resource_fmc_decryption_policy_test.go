@@ -0,0 +1,142 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcDecryptionPolicy(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDecryptionPolicyConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDecryptionPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDecryptionPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_decryption_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcDecryptionPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcDecryptionPolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "name", "my_decryption_policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "description", "My decryption policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_decryption_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "default_action", "DO_NOT_DECRYPT"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_decryption_policy.test", "default_action_id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "default_action_log_connection_end", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "default_action_send_events_to_fmc", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "undecryptable_compressed_session_action", "INHERIT_DEFAULT_ACTION"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "undecryptable_sslv2_session_action", "INHERIT_DEFAULT_ACTION"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "undecryptable_unknown_cipher_suite_action", "INHERIT_DEFAULT_ACTION"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "undecryptable_unsupported_cipher_suite_action", "INHERIT_DEFAULT_ACTION"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "undecryptable_session_not_cached_action", "INHERIT_DEFAULT_ACTION"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "undecryptable_handshake_errors_action", "INHERIT_DEFAULT_ACTION"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_policy.test", "undecryptable_decryption_errors_action", "BLOCK"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDecryptionPolicy(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDecryptionPolicyConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDecryptionPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDecryptionPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_decryption_policy.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcDecryptionPolicyConfig_minimum() string {
+	config := `resource "fmc_decryption_policy" "test" {` + "\n"
+	config += `	name = "my_decryption_policy"` + "\n"
+	config += `	default_action = "DO_NOT_DECRYPT"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcDecryptionPolicyConfig_all() string {
+	config := `resource "fmc_decryption_policy" "test" {` + "\n"
+	config += `	name = "my_decryption_policy"` + "\n"
+	config += `	description = "My decryption policy"` + "\n"
+	config += `	default_action = "DO_NOT_DECRYPT"` + "\n"
+	config += `	default_action_log_connection_end = true` + "\n"
+	config += `	default_action_send_events_to_fmc = true` + "\n"
+	config += `	undecryptable_compressed_session_action = "INHERIT_DEFAULT_ACTION"` + "\n"
+	config += `	undecryptable_sslv2_session_action = "INHERIT_DEFAULT_ACTION"` + "\n"
+	config += `	undecryptable_unknown_cipher_suite_action = "INHERIT_DEFAULT_ACTION"` + "\n"
+	config += `	undecryptable_unsupported_cipher_suite_action = "INHERIT_DEFAULT_ACTION"` + "\n"
+	config += `	undecryptable_session_not_cached_action = "INHERIT_DEFAULT_ACTION"` + "\n"
+	config += `	undecryptable_handshake_errors_action = "INHERIT_DEFAULT_ACTION"` + "\n"
+	config += `	undecryptable_decryption_errors_action = "BLOCK"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
@@ -0,0 +1,515 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource               = &DecryptionRulesResource{}
+	_ resource.ResourceWithModifyPlan = &DecryptionRulesResource{}
+)
+
+func NewDecryptionRulesResource() resource.Resource {
+	return &DecryptionRulesResource{}
+}
+
+type DecryptionRulesResource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (r *DecryptionRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_decryption_rules"
+}
+
+func (r *DecryptionRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource manages Decryption Rules in Decryption Policies in bulk.\n Order of the rules is preserved within the resource. Rules are matched by name. On change, existing rules are updated in place and keep their IDs, while new rules are inserted next to them. Only rules that change their relative order are deleted and re-created.\n").AddMinimumVersionHeaderDescription().AddMinimumVersionAnyDescription().AddMinimumVersionCreateDescription("7.4").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"decryption_policy_id": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Id of the Decryption Policy.").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Ordered list of Decryption Rules.").String,
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Id of the Decryption Rule.").String,
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Name of the Decryption Rule. This name needs to be unique within the policy.").String,
+							Required:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Rule action. `DECRYPT_RESIGN` requires `certificate_authority_id`, `DECRYPT_KNOWN_KEY` requires `known_key_certificates`.").AddStringEnumDescription("DECRYPT_RESIGN", "DECRYPT_KNOWN_KEY", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET", "MONITOR").String,
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("DECRYPT_RESIGN", "DECRYPT_KNOWN_KEY", "DO_NOT_DECRYPT", "BLOCK", "BLOCK_WITH_RESET", "MONITOR"),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Enable rule.").AddDefaultValueDescription("true").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"certificate_authority_id": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Id of the Internal Certificate Authority (`fmc_internal_certificate_authority`) used to re-sign server certificates. Can be set only for `DECRYPT_RESIGN` action.").String,
+							Optional:            true,
+						},
+						"replace_key_only": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Replace only the key of self-signed server certificates. Can be set only for `DECRYPT_RESIGN` action.").String,
+							Optional:            true,
+						},
+						"known_key_certificates": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of Internal Certificates (`fmc_internal_certificate`) with known private keys of the servers. Can be set only for `DECRYPT_KNOWN_KEY` action.").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the Internal Certificate.").String,
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"source_zones": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects representing source Security Zones associated with the rule.").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the object.").String,
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"destination_zones": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects representing destination Security Zones associated with the rule.").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the object.").String,
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"source_network_literals": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects that represent sources of traffic (literally specified).").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("IP address or network in CIDR format.").String,
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"destination_network_literals": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects that represent destinations of traffic (literally specified).").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("IP address or network in CIDR format.").String,
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"source_network_objects": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects that represent sources of traffic (Host, Network, Range, Network Group, Country, Continent or Geolocation).").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the object.").String,
+										Required:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Type of the object.").String,
+										Required:            true,
+									},
+								},
+							},
+						},
+						"destination_network_objects": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects that represent destinations of traffic (Host, Network, Range, Network Group, Country, Continent or Geolocation).").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the object.").String,
+										Required:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Type of the object.").String,
+										Required:            true,
+									},
+								},
+							},
+						},
+						"destination_port_objects": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects representing destination ports associated with the rule.").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the object.").String,
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"log_connection_end": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Log events at the end of the connection.").AddDefaultValueDescription("false").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"send_events_to_fmc": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Send events to the Firepower Management Center event viewer.").AddDefaultValueDescription("false").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"syslog_alert_id": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Id of Syslog Alert. Can be set only when `log_connection_end` is true.").String,
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DecryptionRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *DecryptionRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
+}
+
+// End of section. //template:end model
+
+var _ resource.ResourceWithValidateConfig = &DecryptionRulesResource{}
+
+// ValidateConfig checks the attributes, which can be set only for a specific rule action.
+func (r *DecryptionRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var items types.List
+	diags := req.Config.GetAttribute(ctx, path.Root("items"), &items)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() || items.IsNull() || items.IsUnknown() {
+		return
+	}
+
+	for i := range items.Elements() {
+		itemPath := path.Root("items").AtListIndex(i)
+
+		var action, certificateAuthorityId types.String
+		var replaceKeyOnly types.Bool
+		var knownKeyCertificates types.Set
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, itemPath.AtName("action"), &action)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, itemPath.AtName("certificate_authority_id"), &certificateAuthorityId)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, itemPath.AtName("replace_key_only"), &replaceKeyOnly)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, itemPath.AtName("known_key_certificates"), &knownKeyCertificates)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if action.IsNull() || action.IsUnknown() {
+			continue
+		}
+
+		resign := action.ValueString() == "DECRYPT_RESIGN"
+		knownKey := action.ValueString() == "DECRYPT_KNOWN_KEY"
+
+		if resign && certificateAuthorityId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				itemPath.AtName("certificate_authority_id"),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s: The `certificate_authority_id` attribute is required when `action` is DECRYPT_RESIGN.", itemPath),
+			)
+		}
+		if knownKey && (knownKeyCertificates.IsNull() || !knownKeyCertificates.IsUnknown() && len(knownKeyCertificates.Elements()) == 0) {
+			resp.Diagnostics.AddAttributeError(
+				itemPath.AtName("known_key_certificates"),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s: The `known_key_certificates` attribute is required when `action` is DECRYPT_KNOWN_KEY.", itemPath),
+			)
+		}
+
+		for _, attribute := range []struct {
+			name   string
+			set    bool
+			action string
+		}{
+			{"certificate_authority_id", !certificateAuthorityId.IsNull(), "DECRYPT_RESIGN"},
+			{"replace_key_only", !replaceKeyOnly.IsNull(), "DECRYPT_RESIGN"},
+			{"known_key_certificates", !knownKeyCertificates.IsNull(), "DECRYPT_KNOWN_KEY"},
+		} {
+			if attribute.set && action.ValueString() != attribute.action {
+				resp.Diagnostics.AddAttributeError(
+					itemPath.AtName(attribute.name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("%s: The `%s` attribute can be set only when `action` is %s, got %s.", itemPath, attribute.name, attribute.action, action.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+// Mutex to sync fmc_decryption_rules changes, as rules are inserted at a rule index, which must not be shifted
+// by other fmc_decryption_rules resources creating rules at the same time.
+var decryptionRulesCreateMu sync.Mutex
+
+func (r *DecryptionRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	// Check if FMC client is connected to supports this object
+	if r.client.FMCVersionParsed.LessThan(minFMCVersionCreateDecryptionRules) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("UnsupportedVersion: FMC version %s does not support Decryption Rules creation, minumum required version is 7.4", r.client.FMCVersion))
+		return
+	}
+	var plan DecryptionRules
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
+	}
+
+	// Create new UUID for the bulk resource
+	plan.Id = types.StringValue(uuid.NewString())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+	decryptionRulesCreateMu.Lock()
+	_, err := createBulkRules(r.bulkRules(ctx, plan), plan.Items, "", 0, reqMods...)
+	decryptionRulesCreateMu.Unlock()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %v", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	helpers.SetFlagImporting(ctx, false, resp.Private, &resp.Diagnostics)
+}
+
+func (r *DecryptionRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DecryptionRules
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.ValueString()))
+
+	res, err := readRulesByName(r.client, state.getPath(), state.Items, func(v DecryptionRulesItems) string { return v.Name.ValueString() }, reqMods...)
+	if err != nil && strings.Contains(err.Error(), "StatusCode 404") {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
+
+	imp, diags := helpers.IsFlagImporting(ctx, req)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After `terraform import` we switch to a full read.
+	if imp {
+		state.fromBody(ctx, res)
+	} else {
+		state.fromBodyPartial(ctx, res)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	helpers.SetFlagImporting(ctx, false, resp.Private, &resp.Diagnostics)
+}
+
+func (r *DecryptionRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DecryptionRules
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read state
+	diags = req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	// Mutex ensures that rule indexes used for insertion are not shifted by other rules being created at the same time.
+	decryptionRulesCreateMu.Lock()
+	defer decryptionRulesCreateMu.Unlock()
+
+	items, diags := updateBulkRules(r.bulkRules(ctx, plan), state.Items, plan.Items, reqMods...)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		// Save the rules that are kept or created so far, as the deleted ones are already gone
+		state.Items = items
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DecryptionRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DecryptionRules
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+	ids := make([]string, 0, len(state.Items))
+	for _, v := range state.Items {
+		ids = append(ids, v.Id.ValueString())
+	}
+	err := deleteRulesById(r.client, state.getPath(), ids, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object, got error: %v", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Section below is generated&owned by "gen/generator.go". //template:begin import
+// End of section. //template:end import
+
+// bulkRules returns the items of data for updateBulkRules and createBulkRules.
+func (r *DecryptionRulesResource) bulkRules(ctx context.Context, data DecryptionRules) bulkRules[DecryptionRulesItems] {
+	return bulkRules[DecryptionRulesItems]{
+		client: r.client,
+		path:   data.getPath(),
+		name:   func(v DecryptionRulesItems) string { return v.Name.ValueString() },
+		id:     func(v DecryptionRulesItems) types.String { return v.Id },
+		setId:  func(v *DecryptionRulesItems, id types.String) { v.Id = id },
+		toBody: func(items []DecryptionRulesItems) string {
+			return DecryptionRules{Items: items}.toBody(ctx, DecryptionRules{})
+		},
+		fromBodyUnknowns: func(items []DecryptionRulesItems, res gjson.Result) {
+			bulk := data
+			bulk.Items = items
+			bulk.fromBodyUnknowns(ctx, res)
+		},
+	}
+}
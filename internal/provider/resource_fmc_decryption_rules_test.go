@@ -0,0 +1,235 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tidwall/gjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcDecryptionRules(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDecryptionRulesPrerequisitesConfig + testAccFmcDecryptionRulesConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDecryptionRulesPrerequisitesConfig + testAccFmcDecryptionRulesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDecryptionRulesChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcDecryptionRulesChecks returns the checks of the configuration with all attributes
+func testAccFmcDecryptionRulesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_rules.test", "items.0.name", "rule_1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_rules.test", "items.0.source_network_literals.0.value", "10.1.1.0/24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_rules.test", "items.0.destination_network_literals.0.value", "10.2.2.0/24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_rules.test", "items.0.log_connection_end", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_decryption_rules.test", "items.0.send_events_to_fmc", "true"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDecryptionRules(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+
+const testAccFmcDecryptionRulesPrerequisitesConfig = `
+resource "fmc_decryption_policy" "test" {
+  name           = "decryption_rules"
+  default_action = "DO_NOT_DECRYPT"
+}
+
+resource "fmc_network" "test" {
+  name   = "fmc_decryption_rules_network"
+  prefix = "10.0.0.0/24"
+}
+
+resource "fmc_host" "test" {
+  name = "fmc_decryption_rules_host"
+  ip   = "10.1.1.1"
+}
+`
+
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcDecryptionRulesConfig_minimum() string {
+	config := `resource "fmc_decryption_rules" "test" {` + "\n"
+	config += `	decryption_policy_id = fmc_decryption_policy.test.id` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcDecryptionRulesConfig_all() string {
+	config := `resource "fmc_decryption_rules" "test" {` + "\n"
+	config += `	decryption_policy_id = fmc_decryption_policy.test.id` + "\n"
+	config += `	items = [{` + "\n"
+	config += `		name = "rule_1"` + "\n"
+	config += `		action = "DO_NOT_DECRYPT"` + "\n"
+	config += `		enabled = true` + "\n"
+	config += `		source_network_literals = [{` + "\n"
+	config += `			value = "10.1.1.0/24"` + "\n"
+	config += `		}]` + "\n"
+	config += `		destination_network_literals = [{` + "\n"
+	config += `			value = "10.2.2.0/24"` + "\n"
+	config += `		}]` + "\n"
+	config += `		source_network_objects = [{` + "\n"
+	config += `			id = fmc_network.test.id` + "\n"
+	config += `			type = fmc_network.test.type` + "\n"
+	config += `		}]` + "\n"
+	config += `		destination_network_objects = [{` + "\n"
+	config += `			id = fmc_host.test.id` + "\n"
+	config += `			type = fmc_host.test.type` + "\n"
+	config += `		}]` + "\n"
+	config += `		log_connection_end = true` + "\n"
+	config += `		send_events_to_fmc = true` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
+
+func TestUnitDecryptionRulesUpdateIncremental(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	r := &DecryptionRulesResource{client: client}
+	policy := m.AddObject("/policy/sslpolicies", `{"name":"decryption","type":"SSLPolicy"}`)
+	rulesPath := "/policy/sslpolicies/" + policy + "/sslrules"
+	m.AddObject(rulesPath, `{"name":"unmanaged","action":"DO_NOT_DECRYPT","type":"SSLRule"}`)
+
+	rules := func(items ...string) DecryptionRules {
+		data := DecryptionRules{Id: types.StringValue("bulk"), Domain: types.StringNull(), DecryptionPolicyId: types.StringValue(policy)}
+		for _, v := range items {
+			name, action, _ := strings.Cut(v, ":")
+			data.Items = append(data.Items, DecryptionRulesItems{Id: types.StringUnknown(), Name: types.StringValue(name), Action: types.StringValue(action), Enabled: types.BoolValue(true)})
+		}
+		return data
+	}
+
+	state := rules("a:DO_NOT_DECRYPT", "b:DO_NOT_DECRYPT", "c:DO_NOT_DECRYPT", "d:DO_NOT_DECRYPT")
+	if _, err := createBulkRules(r.bulkRules(ctx, state), state.Items, "", 0); err != nil {
+		t.Fatalf("failed to create rules: %s", err)
+	}
+	ids := map[string]string{}
+	for _, v := range state.Items {
+		ids[v.Name.ValueString()] = v.Id.ValueString()
+	}
+
+	plan := rules("x:BLOCK", "a:DO_NOT_DECRYPT", "c:DECRYPT_RESIGN", "y:BLOCK", "d:DO_NOT_DECRYPT", "z:BLOCK")
+	if _, diags := updateBulkRules(r.bulkRules(ctx, plan), state.Items, plan.Items); diags.HasError() {
+		t.Fatalf("failed to update rules: %v", diags)
+	}
+
+	res, err := readRulesByName(client, plan.getPath(), plan.Items, func(v DecryptionRulesItems) string { return v.Name.ValueString() })
+	if err != nil {
+		t.Fatalf("failed to read rules: %s", err)
+	}
+	var names []string
+	for i, v := range res.Get("items").Array() {
+		names = append(names, v.Get("name").String())
+		if id, ok := ids[v.Get("name").String()]; ok && id != v.Get("id").String() {
+			t.Errorf("expected rule %s to keep its ID", v.Get("name").String())
+		}
+		if i < len(plan.Items) && plan.Items[i].Id.ValueString() != v.Get("id").String() {
+			t.Errorf("expected rule %s to have ID %s in plan", v.Get("name").String(), v.Get("id").String())
+		}
+	}
+	if !slices.Equal(names, []string{"x", "a", "c", "y", "d", "z"}) {
+		t.Errorf("unexpected rule order %v", names)
+	}
+	if action := gjson.Get(m.Object(rulesPath+"/"+ids["c"]), "action").String(); action != "DECRYPT_RESIGN" {
+		t.Errorf("expected rule c to be updated in place, got action %s", action)
+	}
+	if m.Count(rulesPath) != 7 {
+		t.Errorf("expected unmanaged rule to be kept, got %d rules", m.Count(rulesPath))
+	}
+}
+
+func TestUnitDecryptionRulesValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &DecryptionRulesResource{}
+	certificates := []DecryptionRulesItemsKnownKeyCertificates{{Id: types.StringValue("cert-id")}}
+
+	tests := []struct {
+		name   string
+		item   DecryptionRulesItems
+		errors []string
+	}{
+		{"resign", DecryptionRulesItems{Action: types.StringValue("DECRYPT_RESIGN"), CertificateAuthorityId: types.StringValue("ca-id"), ReplaceKeyOnly: types.BoolValue(true)}, nil},
+		{"resign without ca", DecryptionRulesItems{Action: types.StringValue("DECRYPT_RESIGN")}, []string{"certificate_authority_id"}},
+		{"known key", DecryptionRulesItems{Action: types.StringValue("DECRYPT_KNOWN_KEY"), KnownKeyCertificates: certificates}, nil},
+		{"known key without certificates", DecryptionRulesItems{Action: types.StringValue("DECRYPT_KNOWN_KEY")}, []string{"known_key_certificates"}},
+		{"known key with ca", DecryptionRulesItems{Action: types.StringValue("DECRYPT_KNOWN_KEY"), KnownKeyCertificates: certificates, CertificateAuthorityId: types.StringValue("ca-id")}, []string{"certificate_authority_id"}},
+		{"block with resign attributes", DecryptionRulesItems{Action: types.StringValue("BLOCK"), CertificateAuthorityId: types.StringValue("ca-id"), ReplaceKeyOnly: types.BoolValue(false)}, []string{"certificate_authority_id", "replace_key_only"}},
+		{"resign with certificates", DecryptionRulesItems{Action: types.StringValue("DECRYPT_RESIGN"), CertificateAuthorityId: types.StringValue("ca-id"), KnownKeyCertificates: certificates}, []string{"known_key_certificates"}},
+		{"unknown action", DecryptionRulesItems{Action: types.StringUnknown(), CertificateAuthorityId: types.StringValue("ca-id")}, nil},
+	}
+	for _, tt := range tests {
+		tt.item.Name = types.StringValue("rule")
+		resp := testUnitResourceValidateConfig(ctx, r, DecryptionRules{Items: []DecryptionRulesItems{tt.item}})
+		var errors []string
+		for _, d := range resp.Diagnostics {
+			if d, ok := d.(diag.DiagnosticWithPath); ok {
+				errors = append(errors, strings.TrimPrefix(d.Path().String(), "items[0]."))
+			} else {
+				t.Errorf("%s: unexpected diagnostic %s: %s", tt.name, d.Summary(), d.Detail())
+			}
+		}
+		if !slices.Equal(errors, tt.errors) {
+			t.Errorf("%s: expected errors for %v, got %v", tt.name, tt.errors, errors)
+		}
+	}
+}
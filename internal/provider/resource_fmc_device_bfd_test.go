@@ -33,15 +33,10 @@ func TestAccFmcDeviceBFD(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_bfd.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bfd.test", "hop_type", "SINGLE_HOP"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bfd.test", "slow_timer", "1000"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceBFDPrerequisitesConfig + testAccFmcDeviceBFDConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceBFDChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -52,8 +47,26 @@ func TestAccFmcDeviceBFD(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceBFDChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceBFDChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_bfd.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bfd.test", "hop_type", "SINGLE_HOP"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bfd.test", "slow_timer", "1000"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceBFD(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceBFDPrerequisitesConfig = `
@@ -33,6 +33,27 @@ func TestAccFmcDeviceBGPGeneralSettings(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id")
 	}
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDeviceBGPGeneralSettingsPrerequisitesConfig + testAccFmcDeviceBGPGeneralSettingsConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDeviceBGPGeneralSettingsPrerequisitesConfig + testAccFmcDeviceBGPGeneralSettingsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceBGPGeneralSettingsChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcDeviceBGPGeneralSettingsChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceBGPGeneralSettingsChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_bgp_general_settings.test", "name"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bgp_general_settings.test", "as_number", "65535"))
@@ -55,27 +76,19 @@ func TestAccFmcDeviceBGPGeneralSettings(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bgp_general_settings.test", "min_hold_time", "0"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bgp_general_settings.test", "next_hop_delay_interval", "5"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcDeviceBGPGeneralSettingsPrerequisitesConfig + testAccFmcDeviceBGPGeneralSettingsConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcDeviceBGPGeneralSettingsPrerequisitesConfig + testAccFmcDeviceBGPGeneralSettingsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceBGPGeneralSettings(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceBGPGeneralSettingsPrerequisitesConfig = `
@@ -37,20 +37,6 @@ func TestAccFmcDeviceBridgeGroupInterface(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_bridge_group_interface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_bridge_group_interface.test", "name"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "logical_name", "my_bridge_group_interface"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "description", "My Bridge Group Interface"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "bridge_group_id", "100"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "ipv4_static_address", "10.1.1.1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "ipv4_static_netmask", "24"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "ipv6_addresses.0.address", "2004::1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "ipv6_addresses.0.prefix", "64"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "arp_table_entries.0.mac_address", "0123.4567.89ab"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "arp_table_entries.0.ip_address", "10.1.1.10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "arp_table_entries.0.enable_alias", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -59,7 +45,7 @@ func TestAccFmcDeviceBridgeGroupInterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceBridgeGroupInterfacePrerequisitesConfig + testAccFmcDeviceBridgeGroupInterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceBridgeGroupInterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -70,8 +56,35 @@ func TestAccFmcDeviceBridgeGroupInterface(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceBridgeGroupInterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceBridgeGroupInterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_bridge_group_interface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_bridge_group_interface.test", "name"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "logical_name", "my_bridge_group_interface"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "description", "My Bridge Group Interface"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "bridge_group_id", "100"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "ipv4_static_address", "10.1.1.1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "ipv4_static_netmask", "24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "ipv6_addresses.0.address", "2004::1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "ipv6_addresses.0.prefix", "64"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "arp_table_entries.0.mac_address", "0123.4567.89ab"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "arp_table_entries.0.ip_address", "10.1.1.10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_bridge_group_interface.test", "arp_table_entries.0.enable_alias", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceBridgeGroupInterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceBridgeGroupInterfacePrerequisitesConfig = `
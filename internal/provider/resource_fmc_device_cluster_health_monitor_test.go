@@ -33,22 +33,6 @@ func TestAccFmcDeviceClusterHealthMonitor(t *testing.T) {
 	if os.Getenv("TF_VAR_cluster_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_cluster_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_cluster_health_monitor.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "health_check", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "hold_time", "3.0"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "debounce_time", "9000"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "data_interface_auto_rejoin_attempts", "3"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "data_interface_auto_rejoin_interval", "5"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "data_interface_auto_rejoin_interval_variation", "2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "cluster_interface_auto_rejoin_attempts", "-1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "cluster_interface_auto_rejoin_interval", "5"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "cluster_interface_auto_rejoin_interval_variation", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "system_auto_rejoin_attempts", "3"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "system_auto_rejoin_interval", "5"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "system_auto_rejoin_interval_variation", "2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "service_application_monitoring", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -57,7 +41,7 @@ func TestAccFmcDeviceClusterHealthMonitor(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceClusterHealthMonitorPrerequisitesConfig + testAccFmcDeviceClusterHealthMonitorConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceClusterHealthMonitorChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -68,8 +52,37 @@ func TestAccFmcDeviceClusterHealthMonitor(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceClusterHealthMonitorChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceClusterHealthMonitorChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_cluster_health_monitor.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "health_check", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "hold_time", "3.0"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "debounce_time", "9000"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "data_interface_auto_rejoin_attempts", "3"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "data_interface_auto_rejoin_interval", "5"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "data_interface_auto_rejoin_interval_variation", "2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "cluster_interface_auto_rejoin_attempts", "-1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "cluster_interface_auto_rejoin_interval", "5"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "cluster_interface_auto_rejoin_interval_variation", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "system_auto_rejoin_attempts", "3"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "system_auto_rejoin_interval", "5"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "system_auto_rejoin_interval_variation", "2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster_health_monitor.test", "service_application_monitoring", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceClusterHealthMonitor(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceClusterHealthMonitorPrerequisitesConfig = `
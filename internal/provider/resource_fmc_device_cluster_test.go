@@ -33,17 +33,6 @@ func TestAccFmcDeviceCluster(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_device_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_device_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "name", "my_device_cluster"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_cluster.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "control_node_vni_prefix", "10.10.3.0/27"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "control_node_ccl_prefix", "10.10.4.0/27"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "control_node_ccl_ipv4_address", "10.10.4.1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "control_node_priority", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "data_nodes.0.device_id", "76d24097-41c4-4558-a4d0-a8c07ac08470"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "data_nodes.0.ccl_ipv4_address", "10.10.4.2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "data_nodes.0.priority", "2"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -52,7 +41,7 @@ func TestAccFmcDeviceCluster(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceClusterPrerequisitesConfig + testAccFmcDeviceClusterConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceClusterChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_device_cluster.test",
@@ -67,8 +56,32 @@ func TestAccFmcDeviceCluster(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceClusterChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceClusterChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "name", "my_device_cluster"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_cluster.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "control_node_vni_prefix", "10.10.3.0/27"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "control_node_ccl_prefix", "10.10.4.0/27"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "control_node_ccl_ipv4_address", "10.10.4.1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "control_node_priority", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "data_nodes.0.device_id", "76d24097-41c4-4558-a4d0-a8c07ac08470"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "data_nodes.0.ccl_ipv4_address", "10.10.4.2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_cluster.test", "data_nodes.0.priority", "2"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceCluster(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceClusterPrerequisitesConfig = `
@@ -21,9 +21,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -40,7 +42,8 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var (
-	_ resource.Resource = &DeviceDeployResource{}
+	_ resource.Resource               = &DeviceDeployResource{}
+	_ resource.ResourceWithModifyPlan = &DeviceDeployResource{}
 )
 
 func NewDeviceDeployResource() resource.Resource {
@@ -48,7 +51,8 @@ func NewDeviceDeployResource() resource.Resource {
 }
 
 type DeviceDeployResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *DeviceDeployResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,11 +73,9 @@ func (r *DeviceDeployResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"version": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Version to which the deployment should be done in milliseconds unix timestamp. If not provided, the latest version will be used.").String,
@@ -93,6 +95,9 @@ func (r *DeviceDeployResource) Schema(ctx context.Context, req resource.SchemaRe
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true}),
+		},
 	}
 }
 
@@ -102,6 +107,11 @@ func (r *DeviceDeployResource) Configure(_ context.Context, req resource.Configu
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *DeviceDeployResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -115,10 +125,18 @@ func (r *DeviceDeployResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	// Apply create timeout
+	createTimeout, diags := plan.Timeouts.Create(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	// Create ID
@@ -159,10 +177,18 @@ func (r *DeviceDeployResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	// Apply update timeout
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	// Trigger deployment
@@ -188,7 +214,7 @@ func (r *DeviceDeployResource) Delete(ctx context.Context, req resource.DeleteRe
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -33,14 +33,10 @@ func TestAccFmcDeviceECMPZone(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ecmp_zone.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ecmp_zone.test", "name", "my_ecmp_zone"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceECMPZonePrerequisitesConfig + testAccFmcDeviceECMPZoneConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceECMPZoneChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -51,8 +47,25 @@ func TestAccFmcDeviceECMPZone(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceECMPZoneChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceECMPZoneChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ecmp_zone.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ecmp_zone.test", "name", "my_ecmp_zone"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceECMPZone(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceECMPZonePrerequisitesConfig = `
@@ -33,15 +33,10 @@ func TestAccFmcDeviceHAPairFailoverInterfaceMACAddress(t *testing.T) {
 	if os.Getenv("TF_VAR_device_ha_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_ha_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair_failover_interface_mac_address.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_failover_interface_mac_address.test", "active_mac_address", "c460.15e4.0edd"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_failover_interface_mac_address.test", "standby_mac_address", "c460.15e4.0ed0"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceHAPairFailoverInterfaceMACAddressPrerequisitesConfig + testAccFmcDeviceHAPairFailoverInterfaceMACAddressConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceHAPairFailoverInterfaceMACAddressChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -52,8 +47,26 @@ func TestAccFmcDeviceHAPairFailoverInterfaceMACAddress(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceHAPairFailoverInterfaceMACAddressChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceHAPairFailoverInterfaceMACAddressChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair_failover_interface_mac_address.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_failover_interface_mac_address.test", "active_mac_address", "c460.15e4.0edd"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_failover_interface_mac_address.test", "standby_mac_address", "c460.15e4.0ed0"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceHAPairFailoverInterfaceMACAddress(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceHAPairFailoverInterfaceMACAddressPrerequisitesConfig = `
@@ -33,16 +33,6 @@ func TestAccFmcDeviceHAPairMonitoring(t *testing.T) {
 	if os.Getenv("TF_VAR_device_ha_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_ha_id")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair_monitoring.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "logical_name", "outside"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "monitor_interface", "true"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair_monitoring.test", "ipv4_active_address"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "ipv4_standby_address", "10.1.1.2"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair_monitoring.test", "ipv4_netmask"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "ipv6_addresses.0.active_address", "2006::1/30"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "ipv6_addresses.0.standby_address", "2006::2"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -51,7 +41,7 @@ func TestAccFmcDeviceHAPairMonitoring(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceHAPairMonitoringPrerequisitesConfig + testAccFmcDeviceHAPairMonitoringConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceHAPairMonitoringChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -62,8 +52,31 @@ func TestAccFmcDeviceHAPairMonitoring(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceHAPairMonitoringChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceHAPairMonitoringChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair_monitoring.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "logical_name", "outside"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "monitor_interface", "true"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair_monitoring.test", "ipv4_active_address"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "ipv4_standby_address", "10.1.1.2"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair_monitoring.test", "ipv4_netmask"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "ipv6_addresses.0.active_address", "2006::1/30"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair_monitoring.test", "ipv6_addresses.0.standby_address", "2006::2"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceHAPairMonitoring(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceHAPairMonitoringPrerequisitesConfig = `
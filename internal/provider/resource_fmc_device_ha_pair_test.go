@@ -33,6 +33,31 @@ func TestAccFmcDeviceHAPair(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_device_2_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_device_2_id")
 	}
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDeviceHAPairPrerequisitesConfig + testAccFmcDeviceHAPairConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDeviceHAPairPrerequisitesConfig + testAccFmcDeviceHAPairConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceHAPairChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_device_ha_pair.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcDeviceHAPairChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceHAPairChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair.test", "name", "Device_HA_Pair"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ha_pair.test", "type"))
@@ -57,31 +82,19 @@ func TestAccFmcDeviceHAPair(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair.test", "interface_poll_time_unit", "SEC"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ha_pair.test", "interface_hold_time", "25"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcDeviceHAPairPrerequisitesConfig + testAccFmcDeviceHAPairConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcDeviceHAPairPrerequisitesConfig + testAccFmcDeviceHAPairConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_device_ha_pair.test",
-		ImportState:  true,
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceHAPair(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceHAPairPrerequisitesConfig = `
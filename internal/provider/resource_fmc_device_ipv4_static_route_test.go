@@ -33,10 +33,6 @@ func TestAccFmcDeviceIPv4StaticRoute(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ipv4_static_route.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ipv4_static_route.test", "gateway_host_literal", "10.0.0.1"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +41,7 @@ func TestAccFmcDeviceIPv4StaticRoute(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceIPv4StaticRoutePrerequisitesConfig + testAccFmcDeviceIPv4StaticRouteConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceIPv4StaticRouteChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +52,25 @@ func TestAccFmcDeviceIPv4StaticRoute(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceIPv4StaticRouteChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceIPv4StaticRouteChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ipv4_static_route.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ipv4_static_route.test", "gateway_host_literal", "10.0.0.1"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceIPv4StaticRoute(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceIPv4StaticRoutePrerequisitesConfig = `
@@ -33,18 +33,6 @@ func TestAccFmcDeviceLoopbackInterface(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_loopback_interface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_loopback_interface.test", "name"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "logical_name", "my_loopback_1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "enabled", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "loopback_id", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "description", "my VTI interface"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "ipv4_static_address", "10.1.1.1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "ipv4_static_netmask", "24"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "ipv6_addresses.0.address", "2004::10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "ipv6_addresses.0.prefix", "64"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -53,7 +41,7 @@ func TestAccFmcDeviceLoopbackInterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceLoopbackInterfacePrerequisitesConfig + testAccFmcDeviceLoopbackInterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceLoopbackInterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -64,8 +52,33 @@ func TestAccFmcDeviceLoopbackInterface(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceLoopbackInterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceLoopbackInterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_loopback_interface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_loopback_interface.test", "name"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "logical_name", "my_loopback_1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "enabled", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "loopback_id", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "description", "my VTI interface"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "ipv4_static_address", "10.1.1.1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "ipv4_static_netmask", "24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "ipv6_addresses.0.address", "2004::10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_loopback_interface.test", "ipv6_addresses.0.prefix", "64"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceLoopbackInterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceLoopbackInterfacePrerequisitesConfig = `
@@ -33,19 +33,6 @@ func TestAccFmcDeviceOSPFInterface(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ospf_interface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "default_cost", "10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "priority", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "mtu_missmatch_ignore", "false"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "hello_interval", "10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "transmit_delay", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "retransmit_interval", "5"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "dead_interval", "40"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "hello_multiplier", "4"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "point_to_point", "false"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "bfd", "false"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -54,7 +41,7 @@ func TestAccFmcDeviceOSPFInterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceOSPFInterfacePrerequisitesConfig + testAccFmcDeviceOSPFInterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceOSPFInterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -65,8 +52,34 @@ func TestAccFmcDeviceOSPFInterface(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceOSPFInterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceOSPFInterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ospf_interface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "default_cost", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "priority", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "mtu_missmatch_ignore", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "hello_interval", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "transmit_delay", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "retransmit_interval", "5"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "dead_interval", "40"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "hello_multiplier", "4"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "point_to_point", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf_interface.test", "bfd", "false"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceOSPFInterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceOSPFInterfacePrerequisitesConfig = `
@@ -33,6 +33,27 @@ func TestAccFmcDeviceOSPF(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id")
 	}
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDeviceOSPFPrerequisitesConfig + testAccFmcDeviceOSPFConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDeviceOSPFPrerequisitesConfig + testAccFmcDeviceOSPFConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceOSPFChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcDeviceOSPFChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceOSPFChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_ospf.test", "type"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf.test", "process_id", "1"))
@@ -53,27 +74,19 @@ func TestAccFmcDeviceOSPF(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf.test", "areas.0.ranges.0.advertise", "true"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_ospf.test", "areas.0.inter_area_filters.0.filter_direction", "IN"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcDeviceOSPFPrerequisitesConfig + testAccFmcDeviceOSPFConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcDeviceOSPFPrerequisitesConfig + testAccFmcDeviceOSPFConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceOSPF(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceOSPFPrerequisitesConfig = `
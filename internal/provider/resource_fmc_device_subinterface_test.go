@@ -33,18 +33,6 @@ func TestAccFmcDeviceSubinterface(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_subinterface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_subinterface.test", "name"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_subinterface.test", "is_multi_instance"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "logical_name", "myinterface-0-1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "description", "my description"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "mtu", "9000"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "sub_interface_id", "7"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "vlan_id", "4094"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "ipv4_static_address", "10.1.1.1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "ipv4_static_netmask", "24"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -53,7 +41,7 @@ func TestAccFmcDeviceSubinterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceSubinterfacePrerequisitesConfig + testAccFmcDeviceSubinterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceSubinterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -64,8 +52,33 @@ func TestAccFmcDeviceSubinterface(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceSubinterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceSubinterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_subinterface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_subinterface.test", "name"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_subinterface.test", "is_multi_instance"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "logical_name", "myinterface-0-1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "description", "my description"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "mtu", "9000"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "sub_interface_id", "7"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "vlan_id", "4094"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "ipv4_static_address", "10.1.1.1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_subinterface.test", "ipv4_static_netmask", "24"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceSubinterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceSubinterfacePrerequisitesConfig = `
@@ -37,22 +37,6 @@ func TestAccFmcDeviceVirtualTunnelInterface(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_virtual_tunnel_interface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_virtual_tunnel_interface.test", "name"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "tunnel_type", "STATIC"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "logical_name", "my_vti_interface"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "enabled", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "description", "My VTI"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "priority", "100"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "tunnel_id", "100"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "tunnel_mode", "ipv4"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ipv4_static_address", "10.10.10.10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ipv4_static_netmask", "24"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ip_based_monitoring", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ip_based_monitoring_type", "PEER_IPV4"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ip_based_monitoring_peer_ip", "10.10.10.100"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -61,7 +45,7 @@ func TestAccFmcDeviceVirtualTunnelInterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceVirtualTunnelInterfacePrerequisitesConfig + testAccFmcDeviceVirtualTunnelInterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceVirtualTunnelInterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -72,8 +56,37 @@ func TestAccFmcDeviceVirtualTunnelInterface(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceVirtualTunnelInterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceVirtualTunnelInterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_virtual_tunnel_interface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_virtual_tunnel_interface.test", "name"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "tunnel_type", "STATIC"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "logical_name", "my_vti_interface"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "enabled", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "description", "My VTI"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "priority", "100"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "tunnel_id", "100"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "tunnel_mode", "ipv4"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ipv4_static_address", "10.10.10.10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ipv4_static_netmask", "24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ip_based_monitoring", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ip_based_monitoring_type", "PEER_IPV4"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_virtual_tunnel_interface.test", "ip_based_monitoring_peer_ip", "10.10.10.100"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceVirtualTunnelInterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceVirtualTunnelInterfacePrerequisitesConfig = `
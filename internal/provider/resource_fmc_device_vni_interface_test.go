@@ -33,18 +33,6 @@ func TestAccFmcDeviceVNIInterface(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_vni_interface.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "vni_id", "42"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "multicast_group_address", "224.0.0.24"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "segment_id", "501"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "logical_name", "vni42"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "description", "my description"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "ipv4_static_address", "10.2.2.2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "ipv4_static_netmask", "24"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "ipv6", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "ipv6_auto_config", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -53,7 +41,7 @@ func TestAccFmcDeviceVNIInterface(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceVNIInterfacePrerequisitesConfig + testAccFmcDeviceVNIInterfaceConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceVNIInterfaceChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -64,8 +52,33 @@ func TestAccFmcDeviceVNIInterface(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceVNIInterfaceChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceVNIInterfaceChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_vni_interface.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "vni_id", "42"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "multicast_group_address", "224.0.0.24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "segment_id", "501"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "logical_name", "vni42"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "description", "my description"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "ipv4_static_address", "10.2.2.2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "ipv4_static_netmask", "24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "ipv6", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vni_interface.test", "ipv6_auto_config", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceVNIInterface(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceVNIInterfacePrerequisitesConfig = `
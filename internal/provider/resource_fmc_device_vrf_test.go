@@ -33,11 +33,6 @@ func TestAccFmcDeviceVRF(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vrf.test", "name", "VRF_A"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_vrf.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vrf.test", "description", "My VRF instance"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +41,7 @@ func TestAccFmcDeviceVRF(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceVRFPrerequisitesConfig + testAccFmcDeviceVRFConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceVRFChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +52,26 @@ func TestAccFmcDeviceVRF(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceVRFChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceVRFChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vrf.test", "name", "VRF_A"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_vrf.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vrf.test", "description", "My VRF instance"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceVRF(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceVRFPrerequisitesConfig = `
@@ -33,12 +33,6 @@ func TestAccFmcDeviceVTEPPolicy(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_vtep_policy.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vtep_policy.test", "vteps.0.nve_number", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vtep_policy.test", "vteps.0.neighbor_discovery", "STATIC_PEER_IP"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vtep_policy.test", "vteps.0.neighbor_address_literal", "192.168.0.1"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -47,7 +41,7 @@ func TestAccFmcDeviceVTEPPolicy(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDeviceVTEPPolicyPrerequisitesConfig + testAccFmcDeviceVTEPPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDeviceVTEPPolicyChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -58,8 +52,27 @@ func TestAccFmcDeviceVTEPPolicy(t *testing.T) {
 	})
 }
 
+// testAccFmcDeviceVTEPPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcDeviceVTEPPolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_device_vtep_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vtep_policy.test", "vteps.0.nve_number", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vtep_policy.test", "vteps.0.neighbor_discovery", "STATIC_PEER_IP"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_device_vtep_policy.test", "vteps.0.neighbor_address_literal", "192.168.0.1"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDeviceVTEPPolicy(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcDeviceVTEPPolicyPrerequisitesConfig = `
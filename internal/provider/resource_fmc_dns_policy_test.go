@@ -0,0 +1,120 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcDNSPolicy(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDNSPolicyConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDNSPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDNSPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_dns_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcDNSPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcDNSPolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_policy.test", "name", "my_dns_policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_policy.test", "description", "My DNS policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dns_policy.test", "type"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDNSPolicy(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDNSPolicyConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDNSPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDNSPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_dns_policy.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcDNSPolicyConfig_minimum() string {
+	config := `resource "fmc_dns_policy" "test" {` + "\n"
+	config += `	name = "my_dns_policy"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcDNSPolicyConfig_all() string {
+	config := `resource "fmc_dns_policy" "test" {` + "\n"
+	config += `	name = "my_dns_policy"` + "\n"
+	config += `	description = "My DNS policy"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
@@ -0,0 +1,378 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin model
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource               = &DNSRulesResource{}
+	_ resource.ResourceWithModifyPlan = &DNSRulesResource{}
+)
+
+func NewDNSRulesResource() resource.Resource {
+	return &DNSRulesResource{}
+}
+
+type DNSRulesResource struct {
+	client        *fmc.Client
+	defaultDomain string
+}
+
+func (r *DNSRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_rules"
+}
+
+func (r *DNSRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource manages DNS Rules in DNS Policies in bulk.\n Order of the rules is preserved within the resource. Rules are matched by name. On change, existing rules are updated in place and keep their IDs, while new rules are inserted next to them. Only rules that change their relative order are deleted and re-created.\n").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"dns_policy_id": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Id of the DNS Policy.").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Ordered list of DNS Rules.").String,
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Id of the DNS Rule.").String,
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Name of the DNS Rule. This name needs to be unique within the policy.").String,
+							Required:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Rule action. `WHITELIST` allows the query, `MONITOR` only logs it, `DOMAIN_NOT_FOUND` answers it with NXDOMAIN, `DROP` drops it and `SINKHOLE` answers it with the address of `sinkhole_id`.").AddStringEnumDescription("WHITELIST", "MONITOR", "DOMAIN_NOT_FOUND", "DROP", "SINKHOLE").String,
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("WHITELIST", "MONITOR", "DOMAIN_NOT_FOUND", "DROP", "SINKHOLE"),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Enable rule.").AddDefaultValueDescription("true").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"sinkhole_id": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Id of the Sinkhole object (`fmc_sinkhole`). Can be set only for `SINKHOLE` action.").String,
+							Optional:            true,
+						},
+						"dns_lists": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of Security Intelligence DNS Lists (`fmc_security_intelligence_dns_list`) and DNS Feeds (`fmc_security_intelligence_dns_feed`) matched by the rule.").String,
+							Required:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the object.").String,
+										Required:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Type of the object.").AddStringEnumDescription("SIDNSList", "SIDNSFeed").String,
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("SIDNSList", "SIDNSFeed"),
+										},
+									},
+								},
+							},
+						},
+						"source_zones": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects representing source Security Zones associated with the rule.").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the object.").String,
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"source_network_literals": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects that represent sources of DNS queries (literally specified).").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("IP address or network in CIDR format.").String,
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"source_network_objects": schema.SetNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set of objects that represent sources of DNS queries (Host, Network, Range or Network Group).").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Id of the object.").String,
+										Required:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Type of the object.").String,
+										Required:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSRulesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *DNSRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
+}
+
+// End of section. //template:end model
+
+// Mutex to sync fmc_dns_rules changes, as rules are inserted at a rule index, which must not be shifted
+// by other fmc_dns_rules resources creating rules at the same time.
+var dnsRulesCreateMu sync.Mutex
+
+func (r *DNSRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSRules
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
+	}
+
+	// Create new UUID for the bulk resource
+	plan.Id = types.StringValue(uuid.NewString())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+	dnsRulesCreateMu.Lock()
+	_, err := createBulkRules(r.bulkRules(ctx, plan), plan.Items, "", 0, reqMods...)
+	dnsRulesCreateMu.Unlock()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %v", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	helpers.SetFlagImporting(ctx, false, resp.Private, &resp.Diagnostics)
+}
+
+func (r *DNSRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSRules
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.ValueString()))
+
+	res, err := readRulesByName(r.client, state.getPath(), state.Items, func(v DNSRulesItems) string { return v.Name.ValueString() }, reqMods...)
+	if err != nil && strings.Contains(err.Error(), "StatusCode 404") {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s", err))
+		return
+	}
+
+	imp, diags := helpers.IsFlagImporting(ctx, req)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After `terraform import` we switch to a full read.
+	if imp {
+		state.fromBody(ctx, res)
+	} else {
+		state.fromBodyPartial(ctx, res)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	helpers.SetFlagImporting(ctx, false, resp.Private, &resp.Diagnostics)
+}
+
+func (r *DNSRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DNSRules
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read state
+	diags = req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	// Mutex ensures that rule indexes used for insertion are not shifted by other rules being created at the same time.
+	dnsRulesCreateMu.Lock()
+	defer dnsRulesCreateMu.Unlock()
+
+	items, diags := updateBulkRules(r.bulkRules(ctx, plan), state.Items, plan.Items, reqMods...)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		// Save the rules that are kept or created so far, as the deleted ones are already gone
+		state.Items = items
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DNSRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSRules
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set request domain if provided
+	reqMods := [](func(*fmc.Req)){}
+	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+	ids := make([]string, 0, len(state.Items))
+	for _, v := range state.Items {
+		ids = append(ids, v.Id.ValueString())
+	}
+	err := deleteRulesById(r.client, state.getPath(), ids, reqMods...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object, got error: %v", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Section below is generated&owned by "gen/generator.go". //template:begin import
+// End of section. //template:end import
+
+// bulkRules returns the items of data for updateBulkRules and createBulkRules.
+func (r *DNSRulesResource) bulkRules(ctx context.Context, data DNSRules) bulkRules[DNSRulesItems] {
+	return bulkRules[DNSRulesItems]{
+		client: r.client,
+		path:   data.getPath(),
+		name:   func(v DNSRulesItems) string { return v.Name.ValueString() },
+		id:     func(v DNSRulesItems) types.String { return v.Id },
+		setId:  func(v *DNSRulesItems, id types.String) { v.Id = id },
+		toBody: func(items []DNSRulesItems) string {
+			return DNSRules{Items: items}.toBody(ctx, DNSRules{})
+		},
+		fromBodyUnknowns: func(items []DNSRulesItems, res gjson.Result) {
+			bulk := data
+			bulk.Items = items
+			bulk.fromBodyUnknowns(ctx, res)
+		},
+	}
+}
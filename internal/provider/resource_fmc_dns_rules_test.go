@@ -0,0 +1,182 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcDNSRules(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDNSRulesPrerequisitesConfig + testAccFmcDNSRulesConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDNSRulesPrerequisitesConfig + testAccFmcDNSRulesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDNSRulesChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcDNSRulesChecks returns the checks of the configuration with all attributes
+func testAccFmcDNSRulesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_rules.test", "items.0.name", "rule_1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_rules.test", "items.0.source_network_literals.0.value", "10.1.1.0/24"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDNSRules(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+
+const testAccFmcDNSRulesPrerequisitesConfig = `
+resource "fmc_dns_policy" "test" {
+  name = "dns_rules"
+}
+
+resource "fmc_network" "test" {
+  name   = "fmc_dns_rules_network"
+  prefix = "10.0.0.0/24"
+}
+
+data "fmc_security_intelligence_dns_list" "test" {
+  name = "Global-Block-List-for-DNS"
+}
+`
+
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcDNSRulesConfig_minimum() string {
+	config := `resource "fmc_dns_rules" "test" {` + "\n"
+	config += `	dns_policy_id = fmc_dns_policy.test.id` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcDNSRulesConfig_all() string {
+	config := `resource "fmc_dns_rules" "test" {` + "\n"
+	config += `	dns_policy_id = fmc_dns_policy.test.id` + "\n"
+	config += `	items = [{` + "\n"
+	config += `		name = "rule_1"` + "\n"
+	config += `		action = "DOMAIN_NOT_FOUND"` + "\n"
+	config += `		enabled = true` + "\n"
+	config += `		dns_lists = [{` + "\n"
+	config += `			id = data.fmc_security_intelligence_dns_list.test.id` + "\n"
+	config += `			type = data.fmc_security_intelligence_dns_list.test.type` + "\n"
+	config += `		}]` + "\n"
+	config += `		source_network_literals = [{` + "\n"
+	config += `			value = "10.1.1.0/24"` + "\n"
+	config += `		}]` + "\n"
+	config += `		source_network_objects = [{` + "\n"
+	config += `			id = fmc_network.test.id` + "\n"
+	config += `			type = fmc_network.test.type` + "\n"
+	config += `		}]` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
+
+func TestUnitDNSRulesUpdateReorder(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	r := &DNSRulesResource{client: client}
+	policy := m.AddObject("/policy/dnspolicies", `{"name":"dns","type":"DNSPolicy"}`)
+	sinkhole := m.AddObject("/object/sinkholes", `{"name":"sinkhole","ipv4Address":"10.10.10.10","type":"Sinkhole"}`)
+	rulesPath := "/policy/dnspolicies/" + policy + "/dnsrules"
+
+	rules := func(names ...string) DNSRules {
+		data := DNSRules{Id: types.StringValue("bulk"), Domain: types.StringNull(), DnsPolicyId: types.StringValue(policy)}
+		for _, v := range names {
+			data.Items = append(data.Items, DNSRulesItems{Id: types.StringUnknown(), Name: types.StringValue(v), Action: types.StringValue("SINKHOLE"), Enabled: types.BoolValue(true), SinkholeId: types.StringValue(sinkhole)})
+		}
+		return data
+	}
+
+	state := rules("a", "b", "c")
+	if _, err := createBulkRules(r.bulkRules(ctx, state), state.Items, "", 0); err != nil {
+		t.Fatalf("failed to create rules: %s", err)
+	}
+	ids := map[string]string{}
+	for _, v := range state.Items {
+		ids[v.Name.ValueString()] = v.Id.ValueString()
+	}
+
+	plan := rules("c", "a", "b")
+	if _, diags := updateBulkRules(r.bulkRules(ctx, plan), state.Items, plan.Items); diags.HasError() {
+		t.Fatalf("failed to update rules: %v", diags)
+	}
+
+	res, err := readRulesByName(client, plan.getPath(), plan.Items, func(v DNSRulesItems) string { return v.Name.ValueString() })
+	if err != nil {
+		t.Fatalf("failed to read rules: %s", err)
+	}
+	var names []string
+	for _, v := range res.Get("items").Array() {
+		names = append(names, v.Get("name").String())
+		if v.Get("sinkhole.id").String() != sinkhole {
+			t.Errorf("expected rule %s to reference the sinkhole", v.Get("name").String())
+		}
+	}
+	if !slices.Equal(names, []string{"c", "a", "b"}) {
+		t.Errorf("unexpected rule order %v", names)
+	}
+	if plan.Items[1].Id.ValueString() != ids["a"] || plan.Items[2].Id.ValueString() != ids["b"] {
+		t.Errorf("expected rules a and b to keep their IDs")
+	}
+	if plan.Items[0].Id.ValueString() == ids["c"] || m.Count(rulesPath) != 3 {
+		t.Errorf("expected rule c to be re-created, got %d rules", m.Count(rulesPath))
+	}
+}
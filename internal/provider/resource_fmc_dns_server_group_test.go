@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcDNSServerGroup(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "name", "my_dns_server_group"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dns_server_group.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "default_domain", "example.com"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "timeout", "2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "retries", "2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "dns_servers.0.ip", "10.10.10.1"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcDNSServerGroup(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDNSServerGroupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDNSServerGroupChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_dns_server_group.test",
@@ -61,8 +53,49 @@ func TestAccFmcDNSServerGroup(t *testing.T) {
 	})
 }
 
+// testAccFmcDNSServerGroupChecks returns the checks of the configuration with all attributes
+func testAccFmcDNSServerGroupChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "name", "my_dns_server_group"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dns_server_group.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "default_domain", "example.com"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "timeout", "2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "retries", "2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_group.test", "dns_servers.0.ip", "10.10.10.1"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDNSServerGroup(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDNSServerGroupConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDNSServerGroupConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDNSServerGroupChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_dns_server_group.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
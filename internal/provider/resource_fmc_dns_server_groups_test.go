@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcDNSServerGroups(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dns_server_groups.test", "items.my_dns_server_groups.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dns_server_groups.test", "items.my_dns_server_groups.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_groups.test", "items.my_dns_server_groups.default_domain", "example.com"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_groups.test", "items.my_dns_server_groups.timeout", "2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_groups.test", "items.my_dns_server_groups.retries", "2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_groups.test", "items.my_dns_server_groups.dns_servers.0.ip", "10.10.10.1"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcDNSServerGroups(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDNSServerGroupsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDNSServerGroupsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +49,44 @@ func TestAccFmcDNSServerGroups(t *testing.T) {
 	})
 }
 
+// testAccFmcDNSServerGroupsChecks returns the checks of the configuration with all attributes
+func testAccFmcDNSServerGroupsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dns_server_groups.test", "items.my_dns_server_groups.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dns_server_groups.test", "items.my_dns_server_groups.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_groups.test", "items.my_dns_server_groups.default_domain", "example.com"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_groups.test", "items.my_dns_server_groups.timeout", "2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_groups.test", "items.my_dns_server_groups.retries", "2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dns_server_groups.test", "items.my_dns_server_groups.dns_servers.0.ip", "10.10.10.1"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDNSServerGroups(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDNSServerGroupsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDNSServerGroupsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDNSServerGroupsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -0,0 +1,168 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcDomain(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcDomainConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDomainConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDomainChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_domain.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcDomainChecks returns the checks of the configuration with all attributes
+func testAccFmcDomainChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_domain.test", "name", "my_domain"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_domain.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_domain.test", "description", "My Domain"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_domain.test", "full_name"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDomain(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDomainConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDomainConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDomainChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_domain.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcDomainConfig_minimum() string {
+	config := `resource "fmc_domain" "test" {` + "\n"
+	config += `	name = "my_domain"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcDomainConfig_all() string {
+	config := `resource "fmc_domain" "test" {` + "\n"
+	config += `	name = "my_domain"` + "\n"
+	config += `	description = "My Domain"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
+
+func TestUnitDomainLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	r := &DomainResource{client: client}
+
+	res, err := client.Post("/api/fmc_config/v1/domain/{DOMAIN_UUID}/domains", `{"name":"tenant1"}`)
+	if err != nil {
+		t.Fatalf("failed to create domain: %s", err)
+	}
+	data := Domain{Id: types.StringValue(res.Get("id").String()), Name: types.StringValue("tenant1"), Type: types.StringValue("Domain")}
+	if diags := r.readFullName(ctx, &data); diags.HasError() {
+		t.Fatalf("failed to read full name: %v", diags)
+	}
+	if data.FullName.ValueString() != "Global/tenant1" {
+		t.Errorf("unexpected full name %s", data.FullName)
+	}
+
+	// The new domain can be used right away, while the domains of the client itself are left untouched
+	if _, ok := client.Domains["Global/tenant1"]; ok {
+		t.Error("expected client domains not to be modified")
+	}
+	res, err = client.Post("/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords", `{"name":"ftd1"}`, FMCDomainName(client, "Global/tenant1"))
+	if err != nil {
+		t.Fatalf("failed to create device in new domain: %s", err)
+	}
+
+	deleteResp := testUnitResourceDelete(ctx, r, data)
+	if !deleteResp.Diagnostics.HasError() || !strings.Contains(deleteResp.Diagnostics[0].Detail(), "ftd1") {
+		t.Fatalf("expected delete to fail listing the assigned device, got: %v", deleteResp.Diagnostics)
+	}
+
+	if _, err := client.Delete("/api/fmc_config/v1/domain/{DOMAIN_UUID}/devices/devicerecords/"+res.Get("id").String(), FMCDomainName(client, "Global/tenant1")); err != nil {
+		t.Fatalf("failed to delete device: %s", err)
+	}
+	deleteResp = testUnitResourceDelete(ctx, r, data)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("failed to delete domain: %v", deleteResp.Diagnostics)
+	}
+	if _, ok := fmcDomains(client)["Global/tenant1"]; ok {
+		t.Error("expected deleted domain to be removed from known domains")
+	}
+}
@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcDynamicAccessPolicy(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dynamic_access_policy.test", "name", "my_dynamic_access_policy"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dynamic_access_policy.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dynamic_access_policy.test", "description", "My Dynamic Access Policy"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcDynamicAccessPolicy(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDynamicAccessPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDynamicAccessPolicyChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_dynamic_access_policy.test",
@@ -58,8 +53,46 @@ func TestAccFmcDynamicAccessPolicy(t *testing.T) {
 	})
 }
 
+// testAccFmcDynamicAccessPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcDynamicAccessPolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dynamic_access_policy.test", "name", "my_dynamic_access_policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dynamic_access_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dynamic_access_policy.test", "description", "My Dynamic Access Policy"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDynamicAccessPolicy(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDynamicAccessPolicyConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDynamicAccessPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDynamicAccessPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_dynamic_access_policy.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -48,6 +48,7 @@ import (
 var (
 	_ resource.Resource                = &DynamicObjectsResource{}
 	_ resource.ResourceWithImportState = &DynamicObjectsResource{}
+	_ resource.ResourceWithModifyPlan  = &DynamicObjectsResource{}
 )
 
 func NewDynamicObjectsResource() resource.Resource {
@@ -55,7 +56,8 @@ func NewDynamicObjectsResource() resource.Resource {
 }
 
 type DynamicObjectsResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *DynamicObjectsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,7 +67,7 @@ func (r *DynamicObjectsResource) Metadata(ctx context.Context, req resource.Meta
 func (r *DynamicObjectsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: helpers.NewAttributeDescription("This resource manages Dynamic Objects through bulk operations.").AddMinimumVersionHeaderDescription().AddMinimumVersionBulkUpdateDescription().String,
+		MarkdownDescription: helpers.NewAttributeDescription("This resource manages Dynamic Objects through bulk operations.").AddMinimumVersionHeaderDescription().AddMinimumVersionBulkUpdateDescription("").String,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -76,11 +78,9 @@ func (r *DynamicObjectsResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"items": schema.MapNestedAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Map of Dynamic Objects. The key of the map is the name of the individual Dynamic Object.").String,
@@ -130,6 +130,11 @@ func (r *DynamicObjectsResource) Configure(_ context.Context, req resource.Confi
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *DynamicObjectsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -148,7 +153,7 @@ func (r *DynamicObjectsResource) Create(ctx context.Context, req resource.Create
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -204,7 +209,7 @@ func (r *DynamicObjectsResource) Read(ctx context.Context, req resource.ReadRequ
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -277,7 +282,7 @@ func (r *DynamicObjectsResource) Update(ctx context.Context, req resource.Update
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -435,7 +440,7 @@ func (r *DynamicObjectsResource) Delete(ctx context.Context, req resource.Delete
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -461,11 +466,15 @@ func (r *DynamicObjectsResource) Delete(ctx context.Context, req resource.Delete
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *DynamicObjectsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?\[(?P<names>.*?)\]$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,[<item1_name>,<item2_name>,...]\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,[<item1_name>,<item2_name>,...]\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
@@ -572,6 +581,7 @@ func (r *DynamicObjectsResource) deleteSubresources(ctx context.Context, state,
 	estimatedCapacity := min(len(objectsToRemove.Items)*estimatedIDLength, maxUrlParamLength)
 	var idsToRemove strings.Builder
 	idsToRemove.Grow(estimatedCapacity)
+	var objectsInBulk []fmcObjectUsageQuery
 
 	for k, v := range objectsToRemove.Items {
 		// Counter
@@ -586,15 +596,28 @@ func (r *DynamicObjectsResource) deleteSubresources(ctx context.Context, state,
 		// Create list of IDs of items to delete
 		idsToRemove.WriteString(v.Id.ValueString())
 		idsToRemove.WriteString(",")
+		objectsInBulk = append(objectsInBulk, fmcObjectUsageQuery{Name: k, Id: v.Id.ValueString(), Type: v.Type.ValueString()})
 
 		// If bulk size was reached or all entries have been processed
 		if idsToRemove.Len() >= maxUrlParamLength || idx == len(objectsToRemove.Items) {
 			urlPath := state.getPath() + "?bulk=true&filter=ids:" + url.QueryEscape(idsToRemove.String())
 			res, err := r.client.Delete(urlPath, reqMods...)
 			if err != nil {
-				return state, diag.Diagnostics{
+				diags := diag.Diagnostics{
 					diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s: Failed to delete subobject(s) (DELETE), got error: %s, %s", state.Id.ValueString(), err, res.String())),
 				}
+				// Explain which objects reference the objects of this bulk, that failed to be deleted
+				failedObjects := fmcFailedObjects(res, objectsInBulk)
+				if len(failedObjects) == 0 {
+					// The error does not tell which objects failed, so delete the objects of this bulk one by one
+					var deletedItems []string
+					deletedItems, failedObjects = fmcDeleteObjectsOneByOne(ctx, r.client, state.getPath(), objectsInBulk, reqMods)
+					for _, name := range deletedItems {
+						delete(state.Items, name)
+					}
+				}
+				diags.Append(FMCObjectsInUseDiagnostics(ctx, r.client, failedObjects, reqMods)...)
+				return state, diags
 			}
 
 			// Read result and remove deleted items from state
@@ -605,6 +628,7 @@ func (r *DynamicObjectsResource) deleteSubresources(ctx context.Context, state,
 
 			// Reset ID string
 			idsToRemove.Reset()
+			objectsInBulk = nil
 		}
 	}
 
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcDynamicObjects(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dynamic_objects.test", "items.dynamic_object_1.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dynamic_objects.test", "items.dynamic_object_1.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dynamic_objects.test", "items.dynamic_object_1.description", "My Dynamic Object 1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_dynamic_objects.test", "items.dynamic_object_1.object_type", "IP"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcDynamicObjects(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcDynamicObjectsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDynamicObjectsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,42 @@ func TestAccFmcDynamicObjects(t *testing.T) {
 	})
 }
 
+// testAccFmcDynamicObjectsChecks returns the checks of the configuration with all attributes
+func testAccFmcDynamicObjectsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dynamic_objects.test", "items.dynamic_object_1.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_dynamic_objects.test", "items.dynamic_object_1.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dynamic_objects.test", "items.dynamic_object_1.description", "My Dynamic Object 1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_dynamic_objects.test", "items.dynamic_object_1.object_type", "IP"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcDynamicObjects(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDynamicObjectsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcDynamicObjectsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcDynamicObjectsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcExpandedCommunityList(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_list.test", "name", "my_expanded_community_list"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_expanded_community_list.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_list.test", "entries.0.action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_list.test", "entries.0.regular_expression", "^123$"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcExpandedCommunityList(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcExpandedCommunityListConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExpandedCommunityListChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_expanded_community_list.test",
@@ -59,8 +53,47 @@ func TestAccFmcExpandedCommunityList(t *testing.T) {
 	})
 }
 
+// testAccFmcExpandedCommunityListChecks returns the checks of the configuration with all attributes
+func testAccFmcExpandedCommunityListChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_list.test", "name", "my_expanded_community_list"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_expanded_community_list.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_list.test", "entries.0.action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_list.test", "entries.0.regular_expression", "^123$"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcExpandedCommunityList(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcExpandedCommunityListConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcExpandedCommunityListConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExpandedCommunityListChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_expanded_community_list.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
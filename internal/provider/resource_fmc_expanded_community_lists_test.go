@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcExpandedCommunityLists(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_expanded_community_lists.test", "items.my_expanded_community_lists.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_expanded_community_lists.test", "items.my_expanded_community_lists.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_lists.test", "items.my_expanded_community_lists.entries.0.action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_lists.test", "items.my_expanded_community_lists.entries.0.regular_expression", "^123$"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcExpandedCommunityLists(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcExpandedCommunityListsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExpandedCommunityListsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,42 @@ func TestAccFmcExpandedCommunityLists(t *testing.T) {
 	})
 }
 
+// testAccFmcExpandedCommunityListsChecks returns the checks of the configuration with all attributes
+func testAccFmcExpandedCommunityListsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_expanded_community_lists.test", "items.my_expanded_community_lists.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_expanded_community_lists.test", "items.my_expanded_community_lists.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_lists.test", "items.my_expanded_community_lists.entries.0.action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_expanded_community_lists.test", "items.my_expanded_community_lists.entries.0.regular_expression", "^123$"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcExpandedCommunityLists(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcExpandedCommunityListsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcExpandedCommunityListsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExpandedCommunityListsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
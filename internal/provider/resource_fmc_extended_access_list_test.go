@@ -30,23 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcExtendedAccessList(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "name", "my_extended_acl"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_extended_access_list.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.action", "DENY"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.log_level", "WARNING"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.logging", "PER_ACCESS_LIST_ENTRY"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.log_interval", "120"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.source_network_literals.0.value", "10.1.1.0/24"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.source_network_literals.0.type", "Network"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_network_literals.0.value", "10.2.2.2"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_network_literals.0.type", "Host"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_port_literals.0.type", "PortLiteral"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_port_literals.0.port", "80"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_port_literals.0.protocol", "6"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.source_port_literals.0.protocol", "6"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.source_port_literals.0.port", "80"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -55,7 +38,7 @@ func TestAccFmcExtendedAccessList(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcExtendedAccessListPrerequisitesConfig + testAccFmcExtendedAccessListConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExtendedAccessListChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_extended_access_list.test",
@@ -70,8 +53,38 @@ func TestAccFmcExtendedAccessList(t *testing.T) {
 	})
 }
 
+// testAccFmcExtendedAccessListChecks returns the checks of the configuration with all attributes
+func testAccFmcExtendedAccessListChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "name", "my_extended_acl"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_extended_access_list.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.action", "DENY"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.log_level", "WARNING"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.logging", "PER_ACCESS_LIST_ENTRY"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.log_interval", "120"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.source_network_literals.0.value", "10.1.1.0/24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.source_network_literals.0.type", "Network"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_network_literals.0.value", "10.2.2.2"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_network_literals.0.type", "Host"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_port_literals.0.type", "PortLiteral"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_port_literals.0.port", "80"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.destination_port_literals.0.protocol", "6"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.source_port_literals.0.protocol", "6"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_access_list.test", "entries.0.source_port_literals.0.port", "80"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcExtendedAccessList(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcExtendedAccessListPrerequisitesConfig = `
@@ -29,6 +29,26 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcExtendedCommunityList(t *testing.T) {
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcExtendedCommunityListConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExtendedCommunityListChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_extended_community_list.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcExtendedCommunityListChecks returns the checks of the configuration with all attributes
+func testAccFmcExtendedCommunityListChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_community_list.test", "name", "my_extended_community_list"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_extended_community_list.test", "type"))
@@ -36,25 +56,35 @@ func TestAccFmcExtendedCommunityList(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_community_list.test", "entries.0.action", "PERMIT"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_community_list.test", "entries.0.route_target", "64512:1010"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcExtendedCommunityList(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcExtendedCommunityListConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExtendedCommunityListChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_extended_community_list.test",
-		ImportState:  true,
+		ResourceName:      "fmc_extended_community_list.test",
+		ImportState:       true,
+		ImportStateVerify: true,
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -29,6 +29,22 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcExtendedCommunityLists(t *testing.T) {
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcExtendedCommunityListsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExtendedCommunityListsChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcExtendedCommunityListsChecks returns the checks of the configuration with all attributes
+func testAccFmcExtendedCommunityListsChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_extended_community_lists.test", "items.my_extended_community_lists.id"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_extended_community_lists.test", "items.my_extended_community_lists.type"))
@@ -36,21 +52,30 @@ func TestAccFmcExtendedCommunityLists(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_community_lists.test", "items.my_extended_community_lists.entries.0.action", "PERMIT"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_extended_community_lists.test", "items.my_extended_community_lists.entries.0.route_target", "64512:1010"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcExtendedCommunityLists(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcExtendedCommunityListsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcExtendedCommunityListsChecks()...),
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -34,6 +34,31 @@ func TestAccFmcFilePolicy(t *testing.T) {
 	if v := os.Getenv("FMC_VERSION"); v != "" && slices.Contains([]string{"7.7"}, v) {
 		t.Skip("skipping test for FMC version " + v)
 	}
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcFilePolicyConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFilePolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFilePolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_file_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcFilePolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcFilePolicyChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_file_policy.test", "name", "my_file_policy"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_file_policy.test", "type"))
@@ -54,30 +79,38 @@ func TestAccFmcFilePolicy(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_file_policy.test", "file_rules.0.file_types.0.id", "19"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_file_policy.test", "file_rules.0.file_types.0.name", "7Z"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFilePolicy(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcFilePolicyConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFilePolicyConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFilePolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFilePolicyChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_file_policy.test",
-		ImportState:  true,
+		ResourceName:      "fmc_file_policy.test",
+		ImportState:       true,
+		ImportStateVerify: true,
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
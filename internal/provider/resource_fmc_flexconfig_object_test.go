@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcFlexConfigObject(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcFlexConfigObjectPrerequisitesConfig + testAccFmcFlexConfigObjectConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFlexConfigObjectPrerequisitesConfig + testAccFmcFlexConfigObjectConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFlexConfigObjectChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_flexconfig_object.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcFlexConfigObjectChecks returns the checks of the configuration with all attributes
+func testAccFmcFlexConfigObjectChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_object.test", "name", "my_flexconfig_object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_object.test", "description", "My FlexConfig object"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_flexconfig_object.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_object.test", "deployment", "EVERYTIME"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_object.test", "flexconfig_type", "APPEND"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_object.test", "body", "snmp-server location $location"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_object.test", "variables.0.name", "location"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFlexConfigObject(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+
+const testAccFmcFlexConfigObjectPrerequisitesConfig = `
+resource "fmc_flexconfig_text_object" "test" {
+  name   = "fmc_flexconfig_object_location"
+  values = ["Building 1"]
+}
+`
+
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcFlexConfigObjectConfig_minimum() string {
+	config := `resource "fmc_flexconfig_object" "test" {` + "\n"
+	config += `	name = "my_flexconfig_object"` + "\n"
+	config += `	deployment = "EVERYTIME"` + "\n"
+	config += `	flexconfig_type = "APPEND"` + "\n"
+	config += `	body = "snmp-server location $location"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcFlexConfigObjectConfig_all() string {
+	config := `resource "fmc_flexconfig_object" "test" {` + "\n"
+	config += `	name = "my_flexconfig_object"` + "\n"
+	config += `	description = "My FlexConfig object"` + "\n"
+	config += `	deployment = "EVERYTIME"` + "\n"
+	config += `	flexconfig_type = "APPEND"` + "\n"
+	config += `	body = "snmp-server location $location"` + "\n"
+	config += `	variables = [{` + "\n"
+	config += `		name = "location"` + "\n"
+	config += `		object_id = fmc_flexconfig_text_object.test.id` + "\n"
+	config += `		object_type = fmc_flexconfig_text_object.test.type` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
@@ -0,0 +1,127 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcFlexConfigTextObject(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcFlexConfigTextObjectConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFlexConfigTextObjectConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFlexConfigTextObjectChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_flexconfig_text_object.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcFlexConfigTextObjectChecks returns the checks of the configuration with all attributes
+func testAccFmcFlexConfigTextObjectChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_text_object.test", "name", "my_text_object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_text_object.test", "description", "My text object"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_flexconfig_text_object.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_text_object.test", "variable_type", "SINGLE"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_text_object.test", "values.0", "Building 1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_flexconfig_text_object.test", "overridable", "true"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFlexConfigTextObject(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFlexConfigTextObjectConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFlexConfigTextObjectConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFlexConfigTextObjectChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_flexconfig_text_object.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcFlexConfigTextObjectConfig_minimum() string {
+	config := `resource "fmc_flexconfig_text_object" "test" {` + "\n"
+	config += `	name = "my_text_object"` + "\n"
+	config += `	values = ["Building 1"]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcFlexConfigTextObjectConfig_all() string {
+	config := `resource "fmc_flexconfig_text_object" "test" {` + "\n"
+	config += `	name = "my_text_object"` + "\n"
+	config += `	description = "My text object"` + "\n"
+	config += `	variable_type = "SINGLE"` + "\n"
+	config += `	values = ["Building 1"]` + "\n"
+	config += `	overridable = true` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFQDN(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdn.test", "name", "my_fqdn"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdn.test", "fqdn", "www.example.com"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdn.test", "dns_resolution", "IPV4_AND_IPV6"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdn.test", "description", "My FQDN Object"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_fqdn.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcFQDN(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFQDNConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFQDNChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_fqdn.test",
@@ -60,8 +53,48 @@ func TestAccFmcFQDN(t *testing.T) {
 	})
 }
 
+// testAccFmcFQDNChecks returns the checks of the configuration with all attributes
+func testAccFmcFQDNChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdn.test", "name", "my_fqdn"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdn.test", "fqdn", "www.example.com"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdn.test", "dns_resolution", "IPV4_AND_IPV6"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdn.test", "description", "My FQDN Object"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_fqdn.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFQDN(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFQDNConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFQDNConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFQDNChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_fqdn.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFQDNs(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_fqdns.test", "items.my_fqdns.id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdns.test", "items.my_fqdns.description", "My FQDN 1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdns.test", "items.my_fqdns.overridable", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdns.test", "items.my_fqdns.fqdn", "www.example.com"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdns.test", "items.my_fqdns.dns_resolution", "IPV4_AND_IPV6"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_fqdns.test", "items.my_fqdns.type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcFQDNs(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFQDNsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFQDNsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +49,44 @@ func TestAccFmcFQDNs(t *testing.T) {
 	})
 }
 
+// testAccFmcFQDNsChecks returns the checks of the configuration with all attributes
+func testAccFmcFQDNsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_fqdns.test", "items.my_fqdns.id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdns.test", "items.my_fqdns.description", "My FQDN 1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdns.test", "items.my_fqdns.overridable", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdns.test", "items.my_fqdns.fqdn", "www.example.com"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_fqdns.test", "items.my_fqdns.dns_resolution", "IPV4_AND_IPV6"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_fqdns.test", "items.my_fqdns.type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFQDNs(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFQDNsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFQDNsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFQDNsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,10 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDAutoNATRule(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_auto_nat_rule.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_auto_nat_rule.test", "nat_type", "STATIC"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -42,7 +38,7 @@ func TestAccFmcFTDAutoNATRule(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDAutoNATRulePrerequisitesConfig + testAccFmcFTDAutoNATRuleConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDAutoNATRuleChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -53,8 +49,25 @@ func TestAccFmcFTDAutoNATRule(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDAutoNATRuleChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDAutoNATRuleChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_auto_nat_rule.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_auto_nat_rule.test", "nat_type", "STATIC"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDAutoNATRule(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDAutoNATRulePrerequisitesConfig = `
@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDManualNATRule(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_manual_nat_rule.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "description", "My Manual NAT rule 1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "enabled", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "section", "BEFORE_AUTO"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "nat_type", "STATIC"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "fall_through", "false"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcFTDManualNATRule(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDManualNATRulePrerequisitesConfig + testAccFmcFTDManualNATRuleConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDManualNATRuleChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +49,29 @@ func TestAccFmcFTDManualNATRule(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDManualNATRuleChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDManualNATRuleChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_manual_nat_rule.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "description", "My Manual NAT rule 1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "enabled", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "section", "BEFORE_AUTO"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "nat_type", "STATIC"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_manual_nat_rule.test", "fall_through", "false"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDManualNATRule(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDManualNATRulePrerequisitesConfig = `
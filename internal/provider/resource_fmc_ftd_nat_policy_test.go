@@ -30,17 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDNATPolicy(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "name", "my_ftd_nat_policy"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "description", "My nat policy"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_nat_policy.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.description", "My Manual NAT rule 1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.enabled", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.section", "BEFORE_AUTO"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.nat_type", "STATIC"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.fall_through", "false"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "auto_nat_rules.0.nat_type", "STATIC"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -49,7 +38,7 @@ func TestAccFmcFTDNATPolicy(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDNATPolicyPrerequisitesConfig + testAccFmcFTDNATPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDNATPolicyChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_ftd_nat_policy.test",
@@ -64,8 +53,32 @@ func TestAccFmcFTDNATPolicy(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDNATPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDNATPolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "name", "my_ftd_nat_policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "description", "My nat policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_nat_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.description", "My Manual NAT rule 1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.enabled", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.section", "BEFORE_AUTO"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.nat_type", "STATIC"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "manual_nat_rules.0.fall_through", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_nat_policy.test", "auto_nat_rules.0.nat_type", "STATIC"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDNATPolicy(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDNATPolicyPrerequisitesConfig = `
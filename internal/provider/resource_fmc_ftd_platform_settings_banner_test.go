@@ -29,14 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsBanner(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_banner.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_banner.test", "text_lines.0", "Welcome to FTD"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsBannerPrerequisitesConfig + testAccFmcFTDPlatformSettingsBannerConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsBannerChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -47,8 +43,25 @@ func TestAccFmcFTDPlatformSettingsBanner(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsBannerChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsBannerChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_banner.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_banner.test", "text_lines.0", "Welcome to FTD"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsBanner(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsBannerPrerequisitesConfig = `
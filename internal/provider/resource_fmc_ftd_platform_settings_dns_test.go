@@ -29,17 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsDNS(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_dns.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_dns.test", "dns_server_groups.0.is_default", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_dns.test", "expire_entry_timer", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_dns.test", "poll_timer", "240"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_dns.test", "use_management_interface", "true"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsDNSPrerequisitesConfig + testAccFmcFTDPlatformSettingsDNSConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsDNSChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -50,8 +43,28 @@ func TestAccFmcFTDPlatformSettingsDNS(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsDNSChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsDNSChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_dns.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_dns.test", "dns_server_groups.0.is_default", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_dns.test", "expire_entry_timer", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_dns.test", "poll_timer", "240"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_dns.test", "use_management_interface", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsDNS(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsDNSPrerequisitesConfig = `
@@ -29,15 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsHTTPAccess(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_http_access.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_http_access.test", "server_enabled", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_http_access.test", "server_port", "443"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsHTTPAccessPrerequisitesConfig + testAccFmcFTDPlatformSettingsHTTPAccessConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsHTTPAccessChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -48,8 +43,26 @@ func TestAccFmcFTDPlatformSettingsHTTPAccess(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsHTTPAccessChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsHTTPAccessChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_http_access.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_http_access.test", "server_enabled", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_http_access.test", "server_port", "443"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsHTTPAccess(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsHTTPAccessPrerequisitesConfig = `
@@ -29,16 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsICMPAccess(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_icmp_access.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_icmp_access.test", "rate_limit", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_icmp_access.test", "burst_size", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_icmp_access.test", "configurations.0.action", "Permit"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsICMPAccessPrerequisitesConfig + testAccFmcFTDPlatformSettingsICMPAccessConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsICMPAccessChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -49,8 +43,27 @@ func TestAccFmcFTDPlatformSettingsICMPAccess(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsICMPAccessChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsICMPAccessChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_icmp_access.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_icmp_access.test", "rate_limit", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_icmp_access.test", "burst_size", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_icmp_access.test", "configurations.0.action", "Permit"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsICMPAccess(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsICMPAccessPrerequisitesConfig = `
@@ -29,6 +29,22 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsSNMP(t *testing.T) {
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFTDPlatformSettingsSNMPPrerequisitesConfig + testAccFmcFTDPlatformSettingsSNMPConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsSNMPChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcFTDPlatformSettingsSNMPChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsSNMPChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_snmp.test", "type"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_snmp.test", "server_enabled", "true"))
@@ -66,22 +82,19 @@ func TestAccFmcFTDPlatformSettingsSNMP(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_snmp.test", "trap_cluster_state", "true"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_snmp.test", "trap_peer_flap", "true"))
 
-	var steps []resource.TestStep
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcFTDPlatformSettingsSNMPPrerequisitesConfig + testAccFmcFTDPlatformSettingsSNMPConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsSNMP(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsSNMPPrerequisitesConfig = `
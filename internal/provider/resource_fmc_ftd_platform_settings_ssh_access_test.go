@@ -29,13 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsSSHAccess(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_ssh_access.test", "type"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsSSHAccessPrerequisitesConfig + testAccFmcFTDPlatformSettingsSSHAccessConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsSSHAccessChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -46,8 +43,24 @@ func TestAccFmcFTDPlatformSettingsSSHAccess(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsSSHAccessChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsSSHAccessChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_ssh_access.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsSSHAccess(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsSSHAccessPrerequisitesConfig = `
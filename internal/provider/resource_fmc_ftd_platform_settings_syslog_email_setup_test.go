@@ -29,16 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsSyslogEmailSetup(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_email_setup.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_email_setup.test", "source_email_address", "test@example.com"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_email_setup.test", "destinations.0.email_addresses.0", "recipient@example.com"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_email_setup.test", "destinations.0.logging_level", "ERR"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsSyslogEmailSetupPrerequisitesConfig + testAccFmcFTDPlatformSettingsSyslogEmailSetupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsSyslogEmailSetupChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -49,8 +43,27 @@ func TestAccFmcFTDPlatformSettingsSyslogEmailSetup(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsSyslogEmailSetupChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsSyslogEmailSetupChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_email_setup.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_email_setup.test", "source_email_address", "test@example.com"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_email_setup.test", "destinations.0.email_addresses.0", "recipient@example.com"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_email_setup.test", "destinations.0.logging_level", "ERR"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsSyslogEmailSetup(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsSyslogEmailSetupPrerequisitesConfig = `
@@ -29,16 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsSyslogEventList(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_event_list.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_event_list.test", "name", "my_event_list"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_event_list.test", "event_classes.0.class", "AUTH"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_event_list.test", "event_classes.0.severity", "ERR"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsSyslogEventListPrerequisitesConfig + testAccFmcFTDPlatformSettingsSyslogEventListConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsSyslogEventListChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -49,8 +43,27 @@ func TestAccFmcFTDPlatformSettingsSyslogEventList(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsSyslogEventListChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsSyslogEventListChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_event_list.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_event_list.test", "name", "my_event_list"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_event_list.test", "event_classes.0.class", "AUTH"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_event_list.test", "event_classes.0.severity", "ERR"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsSyslogEventList(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsSyslogEventListPrerequisitesConfig = `
@@ -29,18 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsSyslogLoggingDestination(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_logging_destination.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "logging_destination", "INTERNAL_BUFFER"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "global_event_class_filter_criteria", "SEVERITY"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "global_event_class_filter_value", "CRIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "event_class_filters.0.class", "AUTH"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "event_class_filters.0.severity", "ERR"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsSyslogLoggingDestinationPrerequisitesConfig + testAccFmcFTDPlatformSettingsSyslogLoggingDestinationConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsSyslogLoggingDestinationChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -51,8 +43,29 @@ func TestAccFmcFTDPlatformSettingsSyslogLoggingDestination(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsSyslogLoggingDestinationChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsSyslogLoggingDestinationChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_logging_destination.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "logging_destination", "INTERNAL_BUFFER"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "global_event_class_filter_criteria", "SEVERITY"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "global_event_class_filter_value", "CRIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "event_class_filters.0.class", "AUTH"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_destination.test", "event_class_filters.0.severity", "ERR"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsSyslogLoggingDestination(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsSyslogLoggingDestinationPrerequisitesConfig = `
@@ -29,6 +29,22 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsSyslogLoggingSetup(t *testing.T) {
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFTDPlatformSettingsSyslogLoggingSetupPrerequisitesConfig + testAccFmcFTDPlatformSettingsSyslogLoggingSetupConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsSyslogLoggingSetupChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcFTDPlatformSettingsSyslogLoggingSetupChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsSyslogLoggingSetupChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_logging_setup.test", "type"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_setup.test", "logging_enabled", "true"))
@@ -44,22 +60,19 @@ func TestAccFmcFTDPlatformSettingsSyslogLoggingSetup(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_setup.test", "flash_maximum_space", "3076"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_logging_setup.test", "flash_minimum_free_space", "1024"))
 
-	var steps []resource.TestStep
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcFTDPlatformSettingsSyslogLoggingSetupPrerequisitesConfig + testAccFmcFTDPlatformSettingsSyslogLoggingSetupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsSyslogLoggingSetup(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsSyslogLoggingSetupPrerequisitesConfig = `
@@ -29,17 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsSyslogRateLimit(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_rate_limit.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_rate_limit.test", "rate_limit_type", "LOG_LEVEL"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_rate_limit.test", "rate_limit_value", "ERR"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_rate_limit.test", "number_of_messages", "100"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_rate_limit.test", "interval", "60"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsSyslogRateLimitPrerequisitesConfig + testAccFmcFTDPlatformSettingsSyslogRateLimitConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsSyslogRateLimitChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -50,8 +43,28 @@ func TestAccFmcFTDPlatformSettingsSyslogRateLimit(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsSyslogRateLimitChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsSyslogRateLimitChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_rate_limit.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_rate_limit.test", "rate_limit_type", "LOG_LEVEL"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_rate_limit.test", "rate_limit_value", "ERR"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_rate_limit.test", "number_of_messages", "100"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_rate_limit.test", "interval", "60"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsSyslogRateLimit(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsSyslogRateLimitPrerequisitesConfig = `
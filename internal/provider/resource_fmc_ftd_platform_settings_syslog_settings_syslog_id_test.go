@@ -34,16 +34,10 @@ func TestAccFmcFTDPlatformSettingsSyslogSettingsSyslogID(t *testing.T) {
 	if v := os.Getenv("FMC_VERSION"); v != "" && slices.Contains([]string{"7.7", "CDFMC"}, v) {
 		t.Skip("skipping test for FMC version " + v)
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_settings_syslog_id.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_settings_syslog_id.test", "syslog_id", "106004"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_settings_syslog_id.test", "logging_level", "ERR"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_settings_syslog_id.test", "enabled", "true"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsSyslogSettingsSyslogIDPrerequisitesConfig + testAccFmcFTDPlatformSettingsSyslogSettingsSyslogIDConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsSyslogSettingsSyslogIDChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -54,8 +48,27 @@ func TestAccFmcFTDPlatformSettingsSyslogSettingsSyslogID(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsSyslogSettingsSyslogIDChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsSyslogSettingsSyslogIDChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_syslog_settings_syslog_id.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_settings_syslog_id.test", "syslog_id", "106004"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_settings_syslog_id.test", "logging_level", "ERR"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_syslog_settings_syslog_id.test", "enabled", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsSyslogSettingsSyslogID(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsSyslogSettingsSyslogIDPrerequisitesConfig = `
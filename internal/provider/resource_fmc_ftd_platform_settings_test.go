@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettings(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings.test", "name", "my_ftd_platform_settings"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings.test", "description", "My FTD platform settings"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcFTDPlatformSettings(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_ftd_platform_settings.test",
@@ -58,8 +53,46 @@ func TestAccFmcFTDPlatformSettings(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings.test", "name", "my_ftd_platform_settings"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings.test", "description", "My FTD platform settings"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettings(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFTDPlatformSettingsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcFTDPlatformSettingsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_ftd_platform_settings.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
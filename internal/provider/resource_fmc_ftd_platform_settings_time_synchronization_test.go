@@ -29,14 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsTimeSynchronization(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_time_synchronization.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_time_synchronization.test", "synchronization_mode", "SYNC_VIA_MGMT_CENTER_NTP"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsTimeSynchronizationPrerequisitesConfig + testAccFmcFTDPlatformSettingsTimeSynchronizationConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsTimeSynchronizationChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -47,8 +43,25 @@ func TestAccFmcFTDPlatformSettingsTimeSynchronization(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsTimeSynchronizationChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsTimeSynchronizationChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_time_synchronization.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_time_synchronization.test", "synchronization_mode", "SYNC_VIA_MGMT_CENTER_NTP"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsTimeSynchronization(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsTimeSynchronizationPrerequisitesConfig = `
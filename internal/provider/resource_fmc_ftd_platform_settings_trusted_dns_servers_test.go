@@ -29,18 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcFTDPlatformSettingsTrustedDNSServers(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_trusted_dns_servers.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_any_dns_server", "false"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_dhcp_pool", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_dhcp_relay", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_dhcp_client", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_dns_server_group", "true"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcFTDPlatformSettingsTrustedDNSServersPrerequisitesConfig + testAccFmcFTDPlatformSettingsTrustedDNSServersConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcFTDPlatformSettingsTrustedDNSServersChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -51,8 +43,29 @@ func TestAccFmcFTDPlatformSettingsTrustedDNSServers(t *testing.T) {
 	})
 }
 
+// testAccFmcFTDPlatformSettingsTrustedDNSServersChecks returns the checks of the configuration with all attributes
+func testAccFmcFTDPlatformSettingsTrustedDNSServersChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ftd_platform_settings_trusted_dns_servers.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_any_dns_server", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_dhcp_pool", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_dhcp_relay", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_dhcp_client", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ftd_platform_settings_trusted_dns_servers.test", "trust_dns_server_group", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcFTDPlatformSettingsTrustedDNSServers(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcFTDPlatformSettingsTrustedDNSServersPrerequisitesConfig = `
@@ -34,10 +34,6 @@ func TestAccFmcGeolocation(t *testing.T) {
 	if v := os.Getenv("FMC_VERSION"); v != "" && slices.Contains([]string{"7.2"}, v) {
 		t.Skip("skipping test for FMC version " + v)
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_geolocation.test", "name", "my_geolocation"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_geolocation.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +42,7 @@ func TestAccFmcGeolocation(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcGeolocationPrerequisitesConfig + testAccFmcGeolocationConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcGeolocationChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_geolocation.test",
@@ -61,8 +57,25 @@ func TestAccFmcGeolocation(t *testing.T) {
 	})
 }
 
+// testAccFmcGeolocationChecks returns the checks of the configuration with all attributes
+func testAccFmcGeolocationChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_geolocation.test", "name", "my_geolocation"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_geolocation.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcGeolocation(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcGeolocationPrerequisitesConfig = `
@@ -34,10 +34,6 @@ func TestAccFmcGeolocations(t *testing.T) {
 	if v := os.Getenv("FMC_VERSION"); v != "" && slices.Contains([]string{"7.2"}, v) {
 		t.Skip("skipping test for FMC version " + v)
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_geolocations.test", "items.my_geolocations.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_geolocations.test", "items.my_geolocations.type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +42,7 @@ func TestAccFmcGeolocations(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcGeolocationsPrerequisitesConfig + testAccFmcGeolocationsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcGeolocationsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +53,25 @@ func TestAccFmcGeolocations(t *testing.T) {
 	})
 }
 
+// testAccFmcGeolocationsChecks returns the checks of the configuration with all attributes
+func testAccFmcGeolocationsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_geolocations.test", "items.my_geolocations.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_geolocations.test", "items.my_geolocations.type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcGeolocations(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcGeolocationsPrerequisitesConfig = `
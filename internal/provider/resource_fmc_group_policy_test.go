@@ -30,6 +30,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcGroupPolicy(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcGroupPolicyPrerequisitesConfig + testAccFmcGroupPolicyConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcGroupPolicyPrerequisitesConfig + testAccFmcGroupPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcGroupPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_group_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcGroupPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcGroupPolicyChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_group_policy.test", "name", "my_group_policy"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_group_policy.test", "type"))
@@ -63,31 +88,19 @@ func TestAccFmcGroupPolicy(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_group_policy.test", "idle_timeout", "3600"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_group_policy.test", "idle_timeout_alert_interval", "1"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcGroupPolicyPrerequisitesConfig + testAccFmcGroupPolicyConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcGroupPolicyPrerequisitesConfig + testAccFmcGroupPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_group_policy.test",
-		ImportState:  true,
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcGroupPolicy(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcGroupPolicyPrerequisitesConfig = `
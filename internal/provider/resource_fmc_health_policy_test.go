@@ -29,6 +29,26 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcHealthPolicy(t *testing.T) {
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcHealthPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcHealthPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_health_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcHealthPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcHealthPolicyChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "name", "my_health_policy"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "description", "My health policy"))
@@ -36,25 +56,35 @@ func TestAccFmcHealthPolicy(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "policy_type", "DevicePolicy"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_health_policy.test", "is_default_policy", "false"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcHealthPolicy(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcHealthPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcHealthPolicyChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_health_policy.test",
-		ImportState:  true,
+		ResourceName:      "fmc_health_policy.test",
+		ImportState:       true,
+		ImportStateVerify: true,
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcHost(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "name", "my_host"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_host.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "description", "My Host object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "ip", "10.1.1.1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcHost(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcHostConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcHostChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_host.test",
@@ -60,8 +53,48 @@ func TestAccFmcHost(t *testing.T) {
 	})
 }
 
+// testAccFmcHostChecks returns the checks of the configuration with all attributes
+func testAccFmcHostChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "name", "my_host"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_host.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "description", "My Host object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "ip", "10.1.1.1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_host.test", "overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcHost(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcHostConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcHostConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcHostChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_host.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -19,10 +19,15 @@ package provider
 
 // Section below is generated&owned by "gen/generator.go". //template:begin imports
 import (
+	"context"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tidwall/gjson"
 )
 
 // End of section. //template:end imports
@@ -30,13 +35,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcHosts(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_hosts.test", "items.my_hosts.id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_hosts.test", "items.my_hosts.description", "My Host 1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_hosts.test", "items.my_hosts.overridable", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_hosts.test", "items.my_hosts.ip", "10.1.1.1"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_hosts.test", "items.my_hosts.type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +43,7 @@ func TestAccFmcHosts(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcHostsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcHostsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +54,43 @@ func TestAccFmcHosts(t *testing.T) {
 	})
 }
 
+// testAccFmcHostsChecks returns the checks of the configuration with all attributes
+func testAccFmcHostsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_hosts.test", "items.my_hosts.id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_hosts.test", "items.my_hosts.description", "My Host 1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_hosts.test", "items.my_hosts.overridable", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_hosts.test", "items.my_hosts.ip", "10.1.1.1"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_hosts.test", "items.my_hosts.type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcHosts(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcHostsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcHostsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcHostsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -156,6 +189,225 @@ func TestAccFmcHosts_Sequential(t *testing.T) {
 	})
 }
 
+func TestUnitHostsCreateAdoptExisting(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	r := &HostsResource{client: client}
+	sameId := m.AddObject("/object/hosts", `{"name":"same","value":"10.1.1.1","type":"Host"}`)
+	changedId := m.AddObject("/object/hosts", `{"name":"changed","value":"10.1.1.2","type":"Host"}`)
+
+	item := func(ip string) HostsItems {
+		return HostsItems{
+			Id:          types.StringUnknown(),
+			Description: types.StringNull(),
+			Overridable: types.BoolNull(),
+			Ip:          types.StringValue(ip),
+			Type:        types.StringUnknown(),
+		}
+	}
+	plan := Hosts{
+		Id:            types.StringUnknown(),
+		Domain:        types.StringNull(),
+		AdoptExisting: types.BoolValue(true),
+		Items: map[string]HostsItems{
+			"same":    item("10.1.1.1"),
+			"changed": item("10.9.9.9"),
+			"new":     item("10.1.1.3"),
+		},
+	}
+	state := plan
+	state.Id = types.StringValue("bulk-id")
+	state.Items = map[string]HostsItems{}
+	state, diags := r.createSubresources(ctx, state, plan)
+	if diags.HasError() {
+		t.Fatalf("failed to create objects: %v", diags)
+	}
+
+	if state.Items["same"].Id.ValueString() != sameId || state.Items["changed"].Id.ValueString() != changedId {
+		t.Errorf("expected existing objects to be adopted, got %v", state.Items)
+	}
+	if state.Items["new"].Id.ValueString() == "" || state.Items["new"].Type.ValueString() != "Host" {
+		t.Errorf("expected new object to be created, got %v", state.Items["new"])
+	}
+	if n := m.Count("/object/hosts"); n != 3 {
+		t.Errorf("expected 3 hosts on FMC, got %d", n)
+	}
+	if ip := gjson.Get(m.Object("/object/hosts/"+changedId), "value").String(); ip != "10.9.9.9" {
+		t.Errorf("expected adopted object with differing configuration to be updated, got %q", ip)
+	}
+	var puts []string
+	for _, v := range m.Requests() {
+		if strings.HasPrefix(v, "PUT") {
+			puts = append(puts, v)
+		}
+	}
+	if len(puts) != 1 || !strings.Contains(puts[0], changedId) {
+		t.Errorf("expected only the changed object to be updated, got %v", puts)
+	}
+}
+
+func TestUnitHostsUpdateSubresources(t *testing.T) {
+	ctx := context.Background()
+
+	for _, fmcVersion := range []string{"7.2.0", "7.7.0"} {
+		m := newMockFMC(t)
+		m.Version = fmcVersion
+		client := newMockFMCClient(t, m)
+		r := &HostsResource{client: client}
+
+		state := Hosts{Id: types.StringValue("bulk-id"), Domain: types.StringNull(), Items: map[string]HostsItems{}}
+		plan := state.Clone()
+		for i := range 25 {
+			name := fmt.Sprintf("host%d", i)
+			id := m.AddObject("/object/hosts", fmt.Sprintf(`{"name":%q,"value":"10.1.1.%d","type":"Host"}`, name, i))
+			item := HostsItems{Id: types.StringValue(id), Description: types.StringNull(), Overridable: types.BoolNull(), Ip: types.StringValue(fmt.Sprintf("10.1.1.%d", i)), Type: types.StringValue("Host")}
+			state.Items[name] = item
+			item.Description = types.StringValue("updated")
+			plan.Items[name] = item
+		}
+
+		state, diags := r.updateSubresources(ctx, state, plan)
+		if diags.HasError() {
+			t.Fatalf("%s: failed to update objects: %v", fmcVersion, diags)
+		}
+		var puts, bulkPuts int
+		for _, v := range m.Requests() {
+			if strings.HasPrefix(v, "PUT") {
+				puts++
+				if strings.Contains(v, "bulk=true") {
+					bulkPuts++
+				}
+			}
+		}
+		if fmcVersion == "7.2.0" && (puts != 25 || bulkPuts != 0) || fmcVersion == "7.7.0" && (puts != 1 || bulkPuts != 1) {
+			t.Errorf("%s: unexpected update requests: %d PUT, %d bulk PUT", fmcVersion, puts, bulkPuts)
+		}
+		for k, v := range state.Items {
+			if v.Description.ValueString() != "updated" {
+				t.Errorf("%s: expected %s to be updated in state", fmcVersion, k)
+			}
+			if d := gjson.Get(m.Object("/object/hosts/"+v.Id.ValueString()), "description").String(); d != "updated" {
+				t.Errorf("%s: expected %s to be updated on FMC, got %q", fmcVersion, k, d)
+			}
+		}
+	}
+
+	// One-by-one updates record partial progress. Updates stop at the first failure and items are processed in map
+	// order, so retry until the good object is updated before the missing one fails.
+	for range 100 {
+		m := newMockFMC(t)
+		m.Version = "7.2.0"
+		client := newMockFMCClient(t, m)
+		r := &HostsResource{client: client}
+		id := m.AddObject("/object/hosts", `{"name":"good","value":"10.1.1.1","type":"Host"}`)
+		state := Hosts{Id: types.StringValue("bulk-id"), Domain: types.StringNull(), Items: map[string]HostsItems{
+			"good":    {Id: types.StringValue(id), Description: types.StringNull(), Overridable: types.BoolNull(), Ip: types.StringValue("10.1.1.1"), Type: types.StringValue("Host")},
+			"missing": {Id: types.StringValue("00000000-0000-0000-0000-000000000000"), Description: types.StringNull(), Overridable: types.BoolNull(), Ip: types.StringValue("10.1.1.2"), Type: types.StringValue("Host")},
+		}}
+		plan := state.Clone()
+		for k, v := range plan.Items {
+			v.Description = types.StringValue("updated")
+			plan.Items[k] = v
+		}
+		state, diags := r.updateSubresources(ctx, state, plan)
+		if !diags.HasError() {
+			t.Fatalf("expected error for missing object")
+		}
+		if !state.Items["missing"].Description.IsNull() {
+			t.Errorf("expected failed update not to be saved in state")
+		}
+		if gjson.Get(m.Object("/object/hosts/"+id), "description").String() != "updated" {
+			continue
+		}
+		if state.Items["good"].Description.ValueString() != "updated" {
+			t.Errorf("expected successful update to be saved in state")
+		}
+		return
+	}
+	t.Errorf("expected good object to be updated on FMC before the missing one failed")
+}
+
+func TestUnitHostsDeleteInUse(t *testing.T) {
+	ctx := context.Background()
+
+	for _, fmcVersion := range []string{"7.2.0", "7.7.0"} {
+		m := newMockFMC(t)
+		m.Version = fmcVersion
+		client := newMockFMCClient(t, m)
+		r := &HostsResource{client: client}
+
+		id := m.AddObject("/object/hosts", `{"name":"used","value":"10.1.1.1","type":"Host"}`)
+		m.AddObject("/object/networkgroups", `{"name":"group","type":"NetworkGroup","objects":[{"id":"`+id+`","type":"Host"}]}`)
+		state := Hosts{Id: types.StringValue("bulk-id"), Domain: types.StringNull(), Items: map[string]HostsItems{
+			"used": {Id: types.StringValue(id), Description: types.StringNull(), Overridable: types.BoolNull(), Ip: types.StringValue("10.1.1.1"), Type: types.StringValue("Host")},
+		}}
+		state, diags := r.deleteSubresources(ctx, state, state.Clone())
+		if !diags.HasError() {
+			t.Fatalf("%s: expected delete of referenced object to fail", fmcVersion)
+		}
+		var found bool
+		for _, d := range diags {
+			if d.Summary() == "Objects in use" {
+				found = true
+				if !strings.Contains(d.Detail(), `- used (`+id+`): NetworkGroup "group"`) {
+					t.Errorf("%s: unexpected in use details: %s", fmcVersion, d.Detail())
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected objects in use diagnostic, got %v", fmcVersion, diags)
+		}
+		if _, ok := state.Items["used"]; !ok {
+			t.Errorf("%s: expected referenced object to be kept in state", fmcVersion)
+		}
+	}
+}
+
+func TestUnitHostsDeleteInUseBulk(t *testing.T) {
+	ctx := context.Background()
+
+	for _, withoutIds := range []bool{false, true} {
+		m := newMockFMC(t)
+		m.BulkDeleteErrorsWithoutIds = withoutIds
+		client := newMockFMCClient(t, m)
+		r := &HostsResource{client: client}
+
+		usedId := m.AddObject("/object/hosts", `{"name":"used","value":"10.1.1.1","type":"Host"}`)
+		freeId := m.AddObject("/object/hosts", `{"name":"free","value":"10.1.1.2","type":"Host"}`)
+		m.AddObject("/object/networkgroups", `{"name":"group","type":"NetworkGroup","objects":[{"id":"`+usedId+`","type":"Host"}]}`)
+		state := Hosts{Id: types.StringValue("bulk-id"), Domain: types.StringNull(), Items: map[string]HostsItems{
+			"used": {Id: types.StringValue(usedId), Description: types.StringNull(), Overridable: types.BoolNull(), Ip: types.StringValue("10.1.1.1"), Type: types.StringValue("Host")},
+			"free": {Id: types.StringValue(freeId), Description: types.StringNull(), Overridable: types.BoolNull(), Ip: types.StringValue("10.1.1.2"), Type: types.StringValue("Host")},
+		}}
+		state, diags := r.deleteSubresources(ctx, state, state.Clone())
+		if !diags.HasError() {
+			t.Fatalf("errors without ids %t: expected delete of referenced object to fail", withoutIds)
+		}
+		var found bool
+		for _, d := range diags {
+			if d.Summary() == "Objects in use" {
+				found = true
+				if !strings.Contains(d.Detail(), `- used (`+usedId+`): NetworkGroup "group"`) || strings.Contains(d.Detail(), freeId) {
+					t.Errorf("errors without ids %t: unexpected in use details: %s", withoutIds, d.Detail())
+				}
+			}
+		}
+		if !found {
+			t.Errorf("errors without ids %t: expected objects in use diagnostic, got %v", withoutIds, diags)
+		}
+		if _, ok := state.Items["used"]; !ok {
+			t.Errorf("errors without ids %t: expected referenced object to be kept in state", withoutIds)
+		}
+		if withoutIds {
+			// The objects are deleted one by one, so the free object is gone
+			if _, ok := state.Items["free"]; ok || m.Object("/object/hosts/"+freeId) != "" {
+				t.Errorf("expected free object to be deleted after the bulk delete failed")
+			}
+		}
+	}
+}
+
 // # FMCVERSION <= 7.2
 // This test fails on FMC 7.2, as setting an empty description will set description as a single space.
 // Which will trigger incorrect diff on next plan.
@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcICMPv4s(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv4s.test", "items.my_icmpv4_objects.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv4s.test", "items.my_icmpv4_objects.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4s.test", "items.my_icmpv4_objects.description", "ICMPv4 network unreachable response, type 3, code 0"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4s.test", "items.my_icmpv4_objects.icmp_type", "3"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4s.test", "items.my_icmpv4_objects.code", "0"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4s.test", "items.my_icmpv4_objects.overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcICMPv4s(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcICMPv4sConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcICMPv4sChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +49,44 @@ func TestAccFmcICMPv4s(t *testing.T) {
 	})
 }
 
+// testAccFmcICMPv4sChecks returns the checks of the configuration with all attributes
+func testAccFmcICMPv4sChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv4s.test", "items.my_icmpv4_objects.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv4s.test", "items.my_icmpv4_objects.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4s.test", "items.my_icmpv4_objects.description", "ICMPv4 network unreachable response, type 3, code 0"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4s.test", "items.my_icmpv4_objects.icmp_type", "3"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4s.test", "items.my_icmpv4_objects.code", "0"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv4s.test", "items.my_icmpv4_objects.overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcICMPv4s(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcICMPv4sConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcICMPv4sConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcICMPv4sChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcICMPv6(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6.test", "name", "my_icmpv6_object"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv6.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6.test", "description", "ICMPv6 address unreachable response, type 1, code 3"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6.test", "icmp_type", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6.test", "code", "3"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcICMPv6(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcICMPv6Config_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcICMPv6Checks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_icmpv6.test",
@@ -60,8 +53,48 @@ func TestAccFmcICMPv6(t *testing.T) {
 	})
 }
 
+// testAccFmcICMPv6Checks returns the checks of the configuration with all attributes
+func testAccFmcICMPv6Checks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6.test", "name", "my_icmpv6_object"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv6.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6.test", "description", "ICMPv6 address unreachable response, type 1, code 3"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6.test", "icmp_type", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6.test", "code", "3"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcICMPv6(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcICMPv6Config_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcICMPv6Config_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcICMPv6Checks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_icmpv6.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcICMPv6s(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv6s.test", "items.my_icmpv6_objects.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv6s.test", "items.my_icmpv6_objects.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6s.test", "items.my_icmpv6_objects.description", "ICMPv6 address unreachable response, type 1, code 3"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6s.test", "items.my_icmpv6_objects.icmp_type", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6s.test", "items.my_icmpv6_objects.code", "3"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcICMPv6s(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcICMPv6sConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcICMPv6sChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +49,43 @@ func TestAccFmcICMPv6s(t *testing.T) {
 	})
 }
 
+// testAccFmcICMPv6sChecks returns the checks of the configuration with all attributes
+func testAccFmcICMPv6sChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv6s.test", "items.my_icmpv6_objects.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_icmpv6s.test", "items.my_icmpv6_objects.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6s.test", "items.my_icmpv6_objects.description", "ICMPv6 address unreachable response, type 1, code 3"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6s.test", "items.my_icmpv6_objects.icmp_type", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_icmpv6s.test", "items.my_icmpv6_objects.code", "3"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcICMPv6s(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcICMPv6sConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcICMPv6sConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcICMPv6sChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -34,6 +34,26 @@ func TestAccFmcIdentityPolicy(t *testing.T) {
 	if v := os.Getenv("FMC_VERSION"); v != "" && slices.Contains([]string{"7.2", "7.4", "7.6", "7.7"}, v) {
 		t.Skip("skipping test for FMC version " + v)
 	}
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIdentityPolicyPrerequisitesConfig + testAccFmcIdentityPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIdentityPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_identity_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcIdentityPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcIdentityPolicyChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_identity_policy.test", "name", "my_identity_policy"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_identity_policy.test", "type"))
@@ -65,26 +85,19 @@ func TestAccFmcIdentityPolicy(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_identity_policy.test", "rules.0.destination_port_literals.0.port", "80"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_identity_policy.test", "rules.0.destination_port_literals.0.protocol", "6"))
 
-	var steps []resource.TestStep
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcIdentityPolicyPrerequisitesConfig + testAccFmcIdentityPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_identity_policy.test",
-		ImportState:  true,
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIdentityPolicy(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcIdentityPolicyPrerequisitesConfig = `
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIKEv1IPsecProposals(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.description", "IKEv1 IPsec Proposal 1"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.esp_encryption", "AES-192"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.esp_hash", "SHA"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcIKEv1IPsecProposals(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIKEv1IPsecProposalsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv1IPsecProposalsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +49,43 @@ func TestAccFmcIKEv1IPsecProposals(t *testing.T) {
 	})
 }
 
+// testAccFmcIKEv1IPsecProposalsChecks returns the checks of the configuration with all attributes
+func testAccFmcIKEv1IPsecProposalsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.description", "IKEv1 IPsec Proposal 1"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.esp_encryption", "AES-192"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_ipsec_proposals.test", "items.my_ikev1_ipsec_proposals.esp_hash", "SHA"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIKEv1IPsecProposals(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv1IPsecProposalsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv1IPsecProposalsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv1IPsecProposalsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,6 +30,27 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIKEv1Policies(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcIKEv1PoliciesConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv1PoliciesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv1PoliciesChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcIKEv1PoliciesChecks returns the checks of the configuration with all attributes
+func testAccFmcIKEv1PoliciesChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev1_policies.test", "items.my_ikev1_policies.id"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_policies.test", "items.my_ikev1_policies.description", "IKEv1 Policy 1"))
@@ -41,26 +62,40 @@ func TestAccFmcIKEv1Policies(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_policies.test", "items.my_ikev1_policies.lifetime", "86400"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_policies.test", "items.my_ikev1_policies.authentication_method", "Preshared Key"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIKEv1Policies(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcIKEv1PoliciesConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv1PoliciesConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIKEv1PoliciesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv1PoliciesChecks()...),
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+func TestUnitFmcIKEv1PoliciesUpgradeState(t *testing.T) {
+	// Prior state and expected upgraded state (subset) of every prior schema version
+	testUnitUpgradeState(t, NewIKEv1PoliciesResource(), [][2]string{
+		{`{"id":"1","items":{"p1":{"id":"2","priority":10,"encryption":"AES-192","hash":"SHA","dh_group":"5","lifetime":86400,"authentication_method":"Preshared Key"}}}`, `{"id":"1","items":{"p1":{"priority":10,"encryption_algorithm":"AES-192","hash":"SHA"}}}`},
+	})
+}
+
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -30,6 +30,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIKEv1Policy(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcIKEv1PolicyConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv1PolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv1PolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_ikev1_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcIKEv1PolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcIKEv1PolicyChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_policy.test", "name", "my_ikev1_policy"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_policy.test", "description", "IKEv1 Policy 1"))
@@ -41,30 +66,38 @@ func TestAccFmcIKEv1Policy(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_policy.test", "lifetime", "86400"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev1_policy.test", "authentication_method", "Preshared Key"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIKEv1Policy(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcIKEv1PolicyConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv1PolicyConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIKEv1PolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv1PolicyChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_ikev1_policy.test",
-		ImportState:  true,
+		ResourceName:      "fmc_ikev1_policy.test",
+		ImportState:       true,
+		ImportStateVerify: true,
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
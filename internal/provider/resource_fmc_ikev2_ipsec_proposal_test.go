@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIKEv2IPsecProposal(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_ipsec_proposal.test", "name", "my_ikev2_ipsec_proposal"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_ipsec_proposal.test", "description", "IKEv2 IPsec Proposal 1"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_ipsec_proposal.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcIKEv2IPsecProposal(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIKEv2IPsecProposalConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv2IPsecProposalChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_ikev2_ipsec_proposal.test",
@@ -58,8 +53,46 @@ func TestAccFmcIKEv2IPsecProposal(t *testing.T) {
 	})
 }
 
+// testAccFmcIKEv2IPsecProposalChecks returns the checks of the configuration with all attributes
+func testAccFmcIKEv2IPsecProposalChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_ipsec_proposal.test", "name", "my_ikev2_ipsec_proposal"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_ipsec_proposal.test", "description", "IKEv2 IPsec Proposal 1"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_ipsec_proposal.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIKEv2IPsecProposal(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2IPsecProposalConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2IPsecProposalConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv2IPsecProposalChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_ikev2_ipsec_proposal.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
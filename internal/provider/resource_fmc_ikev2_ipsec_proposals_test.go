@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIKEv2IPsecProposals(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_ipsec_proposals.test", "items.my_ikev2_ipsec_proposals.id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_ipsec_proposals.test", "items.my_ikev2_ipsec_proposals.description", "IKEv2 IPsec Proposal 1"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_ipsec_proposals.test", "items.my_ikev2_ipsec_proposals.type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcIKEv2IPsecProposals(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIKEv2IPsecProposalsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv2IPsecProposalsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -54,8 +49,41 @@ func TestAccFmcIKEv2IPsecProposals(t *testing.T) {
 	})
 }
 
+// testAccFmcIKEv2IPsecProposalsChecks returns the checks of the configuration with all attributes
+func testAccFmcIKEv2IPsecProposalsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_ipsec_proposals.test", "items.my_ikev2_ipsec_proposals.id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_ipsec_proposals.test", "items.my_ikev2_ipsec_proposals.description", "IKEv2 IPsec Proposal 1"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_ipsec_proposals.test", "items.my_ikev2_ipsec_proposals.type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIKEv2IPsecProposals(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2IPsecProposalsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2IPsecProposalsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv2IPsecProposalsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIKEv2Policies(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_policies.test", "items.my_ikev2_policies.id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policies.test", "items.my_ikev2_policies.description", "IKEv2 Policy"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_policies.test", "items.my_ikev2_policies.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policies.test", "items.my_ikev2_policies.priority", "10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policies.test", "items.my_ikev2_policies.lifetime", "86400"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcIKEv2Policies(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIKEv2PoliciesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv2PoliciesChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +49,43 @@ func TestAccFmcIKEv2Policies(t *testing.T) {
 	})
 }
 
+// testAccFmcIKEv2PoliciesChecks returns the checks of the configuration with all attributes
+func testAccFmcIKEv2PoliciesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_policies.test", "items.my_ikev2_policies.id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policies.test", "items.my_ikev2_policies.description", "IKEv2 Policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_policies.test", "items.my_ikev2_policies.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policies.test", "items.my_ikev2_policies.priority", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policies.test", "items.my_ikev2_policies.lifetime", "86400"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIKEv2Policies(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2PoliciesConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2PoliciesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv2PoliciesChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
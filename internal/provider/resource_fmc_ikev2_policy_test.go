@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIKEv2Policy(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "name", "my_ikev2_policy"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "description", "IKEv2 Policy"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_policy.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "priority", "10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "lifetime", "86400"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcIKEv2Policy(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIKEv2PolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv2PolicyChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_ikev2_policy.test",
@@ -60,8 +53,48 @@ func TestAccFmcIKEv2Policy(t *testing.T) {
 	})
 }
 
+// testAccFmcIKEv2PolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcIKEv2PolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "name", "my_ikev2_policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "description", "IKEv2 Policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ikev2_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "priority", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ikev2_policy.test", "lifetime", "86400"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIKEv2Policy(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2PolicyConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIKEv2PolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIKEv2PolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_ikev2_policy.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
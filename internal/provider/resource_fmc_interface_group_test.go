@@ -33,11 +33,6 @@ func TestAccFmcInterfaceGroup(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_interface_group.test", "name", "my_interface_group"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_interface_group.test", "interface_type", "ROUTED"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_interface_group.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +41,7 @@ func TestAccFmcInterfaceGroup(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcInterfaceGroupPrerequisitesConfig + testAccFmcInterfaceGroupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcInterfaceGroupChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_interface_group.test",
@@ -61,8 +56,26 @@ func TestAccFmcInterfaceGroup(t *testing.T) {
 	})
 }
 
+// testAccFmcInterfaceGroupChecks returns the checks of the configuration with all attributes
+func testAccFmcInterfaceGroupChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_interface_group.test", "name", "my_interface_group"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_interface_group.test", "interface_type", "ROUTED"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_interface_group.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcInterfaceGroup(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcInterfaceGroupPrerequisitesConfig = `
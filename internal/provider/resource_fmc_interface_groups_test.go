@@ -33,11 +33,6 @@ func TestAccFmcInterfaceGroups(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_interface_groups.test", "items.my_interface_groups.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_interface_groups.test", "items.my_interface_groups.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_interface_groups.test", "items.my_interface_groups.interface_type", "ROUTED"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +41,7 @@ func TestAccFmcInterfaceGroups(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcInterfaceGroupsPrerequisitesConfig + testAccFmcInterfaceGroupsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcInterfaceGroupsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +52,26 @@ func TestAccFmcInterfaceGroups(t *testing.T) {
 	})
 }
 
+// testAccFmcInterfaceGroupsChecks returns the checks of the configuration with all attributes
+func testAccFmcInterfaceGroupsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_interface_groups.test", "items.my_interface_groups.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_interface_groups.test", "items.my_interface_groups.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_interface_groups.test", "items.my_interface_groups.interface_type", "ROUTED"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcInterfaceGroups(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcInterfaceGroupsPrerequisitesConfig = `
@@ -30,10 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcInternalCertificate(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_internal_certificate.test", "name", "my_internal_certificate"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_internal_certificate.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -42,7 +38,7 @@ func TestAccFmcInternalCertificate(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcInternalCertificatePrerequisitesConfig + testAccFmcInternalCertificateConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcInternalCertificateChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_internal_certificate.test",
@@ -57,8 +53,25 @@ func TestAccFmcInternalCertificate(t *testing.T) {
 	})
 }
 
+// testAccFmcInternalCertificateChecks returns the checks of the configuration with all attributes
+func testAccFmcInternalCertificateChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_internal_certificate.test", "name", "my_internal_certificate"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_internal_certificate.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcInternalCertificate(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcInternalCertificatePrerequisitesConfig = `
@@ -29,15 +29,10 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIntrusionPolicyRuleOverride(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_policy_rule_override.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_policy_rule_override.test", "default_rule_action"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_policy_rule_override.test", "override_rule_action", "BLOCK"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIntrusionPolicyRuleOverridePrerequisitesConfig + testAccFmcIntrusionPolicyRuleOverrideConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIntrusionPolicyRuleOverrideChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -48,8 +43,26 @@ func TestAccFmcIntrusionPolicyRuleOverride(t *testing.T) {
 	})
 }
 
+// testAccFmcIntrusionPolicyRuleOverrideChecks returns the checks of the configuration with all attributes
+func testAccFmcIntrusionPolicyRuleOverrideChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_policy_rule_override.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_policy_rule_override.test", "default_rule_action"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_policy_rule_override.test", "override_rule_action", "BLOCK"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIntrusionPolicyRuleOverride(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcIntrusionPolicyRuleOverridePrerequisitesConfig = `
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIntrusionPolicy(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_policy.test", "name", "my_intrusion_policy"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_policy.test", "description", "My IPS Policy"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_policy.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_policy.test", "inspection_mode", "PREVENTION"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcIntrusionPolicy(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIntrusionPolicyPrerequisitesConfig + testAccFmcIntrusionPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIntrusionPolicyChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_intrusion_policy.test",
@@ -59,8 +53,27 @@ func TestAccFmcIntrusionPolicy(t *testing.T) {
 	})
 }
 
+// testAccFmcIntrusionPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcIntrusionPolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_policy.test", "name", "my_intrusion_policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_policy.test", "description", "My IPS Policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_policy.test", "inspection_mode", "PREVENTION"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIntrusionPolicy(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcIntrusionPolicyPrerequisitesConfig = `
@@ -30,10 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIntrusionRuleGroup(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_rule_group.test", "name", "my_intrusion_rule_group"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_rule_group.test", "description", "My IPS Rule Group"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -42,7 +38,7 @@ func TestAccFmcIntrusionRuleGroup(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIntrusionRuleGroupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIntrusionRuleGroupChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_intrusion_rule_group.test",
@@ -57,8 +53,45 @@ func TestAccFmcIntrusionRuleGroup(t *testing.T) {
 	})
 }
 
+// testAccFmcIntrusionRuleGroupChecks returns the checks of the configuration with all attributes
+func testAccFmcIntrusionRuleGroupChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_rule_group.test", "name", "my_intrusion_rule_group"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_rule_group.test", "description", "My IPS Rule Group"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIntrusionRuleGroup(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIntrusionRuleGroupConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIntrusionRuleGroupConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIntrusionRuleGroupChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_intrusion_rule_group.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
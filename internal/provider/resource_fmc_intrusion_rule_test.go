@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIntrusionRule(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_rule.test", "name"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_rule.test", "rule_data", "alert icmp any any -> any any ( sid:10000301; gid:2000; msg:\"CUSTOM RULE1\"; classtype:icmp-event; rev:1; )"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_rule.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcIntrusionRule(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIntrusionRulePrerequisitesConfig + testAccFmcIntrusionRuleConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIntrusionRuleChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_intrusion_rule.test",
@@ -58,8 +53,26 @@ func TestAccFmcIntrusionRule(t *testing.T) {
 	})
 }
 
+// testAccFmcIntrusionRuleChecks returns the checks of the configuration with all attributes
+func testAccFmcIntrusionRuleChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_rule.test", "name"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_intrusion_rule.test", "rule_data", "alert icmp any any -> any any ( sid:10000301; gid:2000; msg:\"CUSTOM RULE1\"; classtype:icmp-event; rev:1; )"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_intrusion_rule.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIntrusionRule(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcIntrusionRulePrerequisitesConfig = `
@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIPv4AddressPool(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "name", "my_ipv4_address_pool"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv4_address_pool.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "description", "My IPv4 Address Pool object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "range", "10.0.0.10-10.0.0.20"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "netmask", "255.255.255.0"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcIPv4AddressPool(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIPv4AddressPoolConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv4AddressPoolChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_ipv4_address_pool.test",
@@ -61,8 +53,49 @@ func TestAccFmcIPv4AddressPool(t *testing.T) {
 	})
 }
 
+// testAccFmcIPv4AddressPoolChecks returns the checks of the configuration with all attributes
+func testAccFmcIPv4AddressPoolChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "name", "my_ipv4_address_pool"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv4_address_pool.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "description", "My IPv4 Address Pool object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "range", "10.0.0.10-10.0.0.20"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "netmask", "255.255.255.0"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pool.test", "overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIPv4AddressPool(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv4AddressPoolConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv4AddressPoolConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv4AddressPoolChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_ipv4_address_pool.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
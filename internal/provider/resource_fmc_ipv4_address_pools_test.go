@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIPv4AddressPools(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.description", "My IPv4 Address Pool object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.range", "10.0.0.10-10.0.0.20"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.netmask", "255.255.255.0"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcIPv4AddressPools(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIPv4AddressPoolsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv4AddressPoolsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +49,44 @@ func TestAccFmcIPv4AddressPools(t *testing.T) {
 	})
 }
 
+// testAccFmcIPv4AddressPoolsChecks returns the checks of the configuration with all attributes
+func testAccFmcIPv4AddressPoolsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.description", "My IPv4 Address Pool object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.range", "10.0.0.10-10.0.0.20"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.netmask", "255.255.255.0"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_address_pools.test", "items.my_ipv4_address_pools.overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIPv4AddressPools(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv4AddressPoolsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv4AddressPoolsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv4AddressPoolsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
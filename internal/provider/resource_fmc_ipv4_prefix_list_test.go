@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIPv4PrefixList(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "name", "my_ipv4_prefix_list"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv4_prefix_list.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "entries.0.action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "entries.0.prefix", "10.10.10.0/24"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "entries.0.min_prefix_length", "25"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "entries.0.max_prefix_length", "30"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcIPv4PrefixList(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIPv4PrefixListConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv4PrefixListChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_ipv4_prefix_list.test",
@@ -61,8 +53,49 @@ func TestAccFmcIPv4PrefixList(t *testing.T) {
 	})
 }
 
+// testAccFmcIPv4PrefixListChecks returns the checks of the configuration with all attributes
+func testAccFmcIPv4PrefixListChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "name", "my_ipv4_prefix_list"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv4_prefix_list.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "entries.0.action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "entries.0.prefix", "10.10.10.0/24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "entries.0.min_prefix_length", "25"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv4_prefix_list.test", "entries.0.max_prefix_length", "30"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIPv4PrefixList(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv4PrefixListConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv4PrefixListConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv4PrefixListChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_ipv4_prefix_list.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
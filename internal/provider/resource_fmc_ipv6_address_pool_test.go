@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIPv6AddressPool(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "name", "my_ipv6_address_pool"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv6_address_pool.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "description", "My IPv6 Address Pool object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "start_address", "2001:db8::1/64"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "number_of_addresses", "10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcIPv6AddressPool(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIPv6AddressPoolConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv6AddressPoolChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_ipv6_address_pool.test",
@@ -61,8 +53,49 @@ func TestAccFmcIPv6AddressPool(t *testing.T) {
 	})
 }
 
+// testAccFmcIPv6AddressPoolChecks returns the checks of the configuration with all attributes
+func testAccFmcIPv6AddressPoolChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "name", "my_ipv6_address_pool"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv6_address_pool.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "description", "My IPv6 Address Pool object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "start_address", "2001:db8::1/64"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "number_of_addresses", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_address_pool.test", "overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIPv6AddressPool(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv6AddressPoolConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv6AddressPoolConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv6AddressPoolChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_ipv6_address_pool.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcIPv6PrefixLists(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.entries.0.action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.entries.0.prefix", "2001:1::/64"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.entries.0.min_prefix_length", "65"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.entries.0.max_prefix_length", "120"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcIPv6PrefixLists(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcIPv6PrefixListsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv6PrefixListsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +49,44 @@ func TestAccFmcIPv6PrefixLists(t *testing.T) {
 	})
 }
 
+// testAccFmcIPv6PrefixListsChecks returns the checks of the configuration with all attributes
+func testAccFmcIPv6PrefixListsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.entries.0.action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.entries.0.prefix", "2001:1::/64"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.entries.0.min_prefix_length", "65"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ipv6_prefix_lists.test", "items.my_ipv6_prefix_lists.entries.0.max_prefix_length", "120"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcIPv6PrefixLists(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv6PrefixListsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcIPv6PrefixListsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcIPv6PrefixListsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,6 +30,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcKeyChain(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcKeyChainConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcKeyChainConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcKeyChainChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_key_chain.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcKeyChainChecks returns the checks of the configuration with all attributes
+func testAccFmcKeyChainChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_key_chain.test", "name", "my_key_chain"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_key_chain.test", "type"))
@@ -42,30 +67,39 @@ func TestAccFmcKeyChain(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_key_chain.test", "keys.0.send_lifetime_end_type", "DURATION"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_key_chain.test", "keys.0.send_lifetime_end", "172800"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcKeyChain(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcKeyChainConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcKeyChainConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcKeyChainConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcKeyChainChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_key_chain.test",
-		ImportState:  true,
+		ResourceName:            "fmc_key_chain.test",
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"keys.0.key"},
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcNetworkAnalysisPolicy(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_analysis_policy.test", "name", "my_network_analysis_policy"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_analysis_policy.test", "description", "My network analysis policy"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network_analysis_policy.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_analysis_policy.test", "inspection_mode", "PREVENTION"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcNetworkAnalysisPolicy(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcNetworkAnalysisPolicyPrerequisitesConfig + testAccFmcNetworkAnalysisPolicyConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcNetworkAnalysisPolicyChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_network_analysis_policy.test",
@@ -59,8 +53,27 @@ func TestAccFmcNetworkAnalysisPolicy(t *testing.T) {
 	})
 }
 
+// testAccFmcNetworkAnalysisPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcNetworkAnalysisPolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_analysis_policy.test", "name", "my_network_analysis_policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_analysis_policy.test", "description", "My network analysis policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network_analysis_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_analysis_policy.test", "inspection_mode", "PREVENTION"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcNetworkAnalysisPolicy(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcNetworkAnalysisPolicyPrerequisitesConfig = `
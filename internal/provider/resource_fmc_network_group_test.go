@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcNetworkGroup(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "name", "my_network_group"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "description", "My Network Group 1"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network_group.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "literals.0.value", "10.1.1.0/24"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcNetworkGroup(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcNetworkGroupPrerequisitesConfig + testAccFmcNetworkGroupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcNetworkGroupChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_network_group.test",
@@ -59,8 +53,27 @@ func TestAccFmcNetworkGroup(t *testing.T) {
 	})
 }
 
+// testAccFmcNetworkGroupChecks returns the checks of the configuration with all attributes
+func testAccFmcNetworkGroupChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "name", "my_network_group"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "description", "My Network Group 1"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network_group.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_group.test", "literals.0.value", "10.1.1.0/24"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcNetworkGroup(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcNetworkGroupPrerequisitesConfig = `
@@ -31,12 +31,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcNetworkGroups(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network_groups.test", "items.my_network_groups.id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_groups.test", "items.my_network_groups.description", "My Network Group 1"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network_groups.test", "items.my_network_groups.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_groups.test", "items.my_network_groups.literals.0.value", "10.1.1.0/24"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +39,7 @@ func TestAccFmcNetworkGroups(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcNetworkGroupsPrerequisitesConfig + testAccFmcNetworkGroupsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcNetworkGroupsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +50,27 @@ func TestAccFmcNetworkGroups(t *testing.T) {
 	})
 }
 
+// testAccFmcNetworkGroupsChecks returns the checks of the configuration with all attributes
+func testAccFmcNetworkGroupsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network_groups.test", "items.my_network_groups.id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_groups.test", "items.my_network_groups.description", "My Network Group 1"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network_groups.test", "items.my_network_groups.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_groups.test", "items.my_network_groups.literals.0.value", "10.1.1.0/24"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcNetworkGroups(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcNetworkGroupsPrerequisitesConfig = `
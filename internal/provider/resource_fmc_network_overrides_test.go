@@ -33,11 +33,6 @@ func TestAccFmcNetworkOverrides(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_overrides.test", "overrides.0.target_type", "Device"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_overrides.test", "overrides.0.description", "My Network object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_overrides.test", "overrides.0.prefix", "10.1.2.0/24"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +41,7 @@ func TestAccFmcNetworkOverrides(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcNetworkOverridesPrerequisitesConfig + testAccFmcNetworkOverridesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcNetworkOverridesChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_network_overrides.test",
@@ -61,8 +56,26 @@ func TestAccFmcNetworkOverrides(t *testing.T) {
 	})
 }
 
+// testAccFmcNetworkOverridesChecks returns the checks of the configuration with all attributes
+func testAccFmcNetworkOverridesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_overrides.test", "overrides.0.target_type", "Device"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_overrides.test", "overrides.0.description", "My Network object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network_overrides.test", "overrides.0.prefix", "10.1.2.0/24"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcNetworkOverrides(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcNetworkOverridesPrerequisitesConfig = `
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcNetwork(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "name", "my_network"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "description", "My network object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "prefix", "10.1.2.0/24"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcNetwork(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcNetworkConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcNetworkChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_network.test",
@@ -60,8 +53,48 @@ func TestAccFmcNetwork(t *testing.T) {
 	})
 }
 
+// testAccFmcNetworkChecks returns the checks of the configuration with all attributes
+func testAccFmcNetworkChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "name", "my_network"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_network.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "description", "My network object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "prefix", "10.1.2.0/24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_network.test", "overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcNetwork(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcNetworkConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcNetworkConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcNetworkChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_network.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
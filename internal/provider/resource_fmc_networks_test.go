@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcNetworks(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_networks.test", "items.my_networks.id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_networks.test", "items.my_networks.description", "My Network 1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_networks.test", "items.my_networks.prefix", "10.1.1.0/24"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_networks.test", "items.my_networks.type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcNetworks(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcNetworksConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcNetworksChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,42 @@ func TestAccFmcNetworks(t *testing.T) {
 	})
 }
 
+// testAccFmcNetworksChecks returns the checks of the configuration with all attributes
+func testAccFmcNetworksChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_networks.test", "items.my_networks.id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_networks.test", "items.my_networks.description", "My Network 1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_networks.test", "items.my_networks.prefix", "10.1.1.0/24"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_networks.test", "items.my_networks.type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcNetworks(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcNetworksConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcNetworksConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcNetworksChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
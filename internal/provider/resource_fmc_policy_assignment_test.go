@@ -33,15 +33,10 @@ func TestAccFmcPolicyAssignment(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_assignment.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_assignment.test", "policy_name"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_assignment.test", "policy_type", "FTDNatPolicy"))
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcPolicyAssignmentPrerequisitesConfig + testAccFmcPolicyAssignmentConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPolicyAssignmentChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_policy_assignment.test",
@@ -56,8 +51,26 @@ func TestAccFmcPolicyAssignment(t *testing.T) {
 	})
 }
 
+// testAccFmcPolicyAssignmentChecks returns the checks of the configuration with all attributes
+func testAccFmcPolicyAssignmentChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_assignment.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_assignment.test", "policy_name"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_assignment.test", "policy_type", "FTDNatPolicy"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcPolicyAssignment(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcPolicyAssignmentPrerequisitesConfig = `
@@ -33,14 +33,6 @@ func TestAccFmcPolicyList(t *testing.T) {
 	if os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "name", "my_policy_list"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_list.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "match_community_exactly", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "metric", "100"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "tag", "100"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -49,7 +41,7 @@ func TestAccFmcPolicyList(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcPolicyListPrerequisitesConfig + testAccFmcPolicyListConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPolicyListChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_policy_list.test",
@@ -64,8 +56,29 @@ func TestAccFmcPolicyList(t *testing.T) {
 	})
 }
 
+// testAccFmcPolicyListChecks returns the checks of the configuration with all attributes
+func testAccFmcPolicyListChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "name", "my_policy_list"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_list.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "match_community_exactly", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "metric", "100"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_list.test", "tag", "100"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcPolicyList(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcPolicyListPrerequisitesConfig = `
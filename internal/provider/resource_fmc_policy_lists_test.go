@@ -33,14 +33,6 @@ func TestAccFmcPolicyLists(t *testing.T) {
 	if os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_lists.test", "items.my_policy_lists.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_lists.test", "items.my_policy_lists.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_lists.test", "items.my_policy_lists.action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_lists.test", "items.my_policy_lists.match_community_exactly", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_lists.test", "items.my_policy_lists.metric", "100"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_lists.test", "items.my_policy_lists.tag", "100"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -49,7 +41,7 @@ func TestAccFmcPolicyLists(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcPolicyListsPrerequisitesConfig + testAccFmcPolicyListsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPolicyListsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -60,8 +52,29 @@ func TestAccFmcPolicyLists(t *testing.T) {
 	})
 }
 
+// testAccFmcPolicyListsChecks returns the checks of the configuration with all attributes
+func testAccFmcPolicyListsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_lists.test", "items.my_policy_lists.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_policy_lists.test", "items.my_policy_lists.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_lists.test", "items.my_policy_lists.action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_lists.test", "items.my_policy_lists.match_community_exactly", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_lists.test", "items.my_policy_lists.metric", "100"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_policy_lists.test", "items.my_policy_lists.tag", "100"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcPolicyLists(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcPolicyListsPrerequisitesConfig = `
@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcPortGroup(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_port_group.test", "name", "my_port_group"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_port_group.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_port_group.test", "description", "My port group description"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcPortGroup(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcPortGroupPrerequisitesConfig + testAccFmcPortGroupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPortGroupChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_port_group.test",
@@ -58,8 +53,26 @@ func TestAccFmcPortGroup(t *testing.T) {
 	})
 }
 
+// testAccFmcPortGroupChecks returns the checks of the configuration with all attributes
+func testAccFmcPortGroupChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_port_group.test", "name", "my_port_group"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_port_group.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_port_group.test", "description", "My port group description"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcPortGroup(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcPortGroupPrerequisitesConfig = `
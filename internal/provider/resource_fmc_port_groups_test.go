@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcPortGroups(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_port_groups.test", "items.my_port_groups.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_port_groups.test", "items.my_port_groups.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_port_groups.test", "items.my_port_groups.description", "My port group description"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_port_groups.test", "items.my_port_groups.objects.0.type", "ProtocolPortObject"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcPortGroups(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcPortGroupsPrerequisitesConfig + testAccFmcPortGroupsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPortGroupsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,27 @@ func TestAccFmcPortGroups(t *testing.T) {
 	})
 }
 
+// testAccFmcPortGroupsChecks returns the checks of the configuration with all attributes
+func testAccFmcPortGroupsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_port_groups.test", "items.my_port_groups.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_port_groups.test", "items.my_port_groups.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_port_groups.test", "items.my_port_groups.description", "My port group description"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_port_groups.test", "items.my_port_groups.objects.0.type", "ProtocolPortObject"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcPortGroups(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcPortGroupsPrerequisitesConfig = `
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcPort(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_port.test", "name", "my_port"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_port.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_port.test", "protocol", "TCP"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_port.test", "port", "443"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_port.test", "description", "Port TCP/443 (HTTPS)"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcPort(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcPortConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPortChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_port.test",
@@ -60,8 +53,48 @@ func TestAccFmcPort(t *testing.T) {
 	})
 }
 
+// testAccFmcPortChecks returns the checks of the configuration with all attributes
+func testAccFmcPortChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_port.test", "name", "my_port"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_port.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_port.test", "protocol", "TCP"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_port.test", "port", "443"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_port.test", "description", "Port TCP/443 (HTTPS)"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcPort(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcPortConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcPortConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPortChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_port.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
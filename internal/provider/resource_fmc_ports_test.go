@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcPorts(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ports.test", "items.my_ports.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ports.test", "items.my_ports.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ports.test", "items.my_ports.protocol", "TCP"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ports.test", "items.my_ports.port", "443"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ports.test", "items.my_ports.description", "Port TCP/443 (HTTPS)"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcPorts(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcPortsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPortsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +49,43 @@ func TestAccFmcPorts(t *testing.T) {
 	})
 }
 
+// testAccFmcPortsChecks returns the checks of the configuration with all attributes
+func testAccFmcPortsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ports.test", "items.my_ports.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ports.test", "items.my_ports.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ports.test", "items.my_ports.protocol", "TCP"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ports.test", "items.my_ports.port", "443"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ports.test", "items.my_ports.description", "Port TCP/443 (HTTPS)"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcPorts(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcPortsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcPortsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcPortsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -0,0 +1,203 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"context"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tidwall/gjson"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcQoSRules(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcQoSRulesPrerequisitesConfig + testAccFmcQoSRulesConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcQoSRulesPrerequisitesConfig + testAccFmcQoSRulesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcQoSRulesChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcQoSRulesChecks returns the checks of the configuration with all attributes
+func testAccFmcQoSRulesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_qos_rules.test", "items.0.name", "rule_1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_qos_rules.test", "items.0.source_network_literals.0.value", "10.1.1.0/24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_qos_rules.test", "items.0.destination_network_literals.0.value", "10.2.2.0/24"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_qos_rules.test", "items.0.upload_limit", "10.0"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_qos_rules.test", "items.0.download_limit", "50.0"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcQoSRules(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+
+const testAccFmcQoSRulesPrerequisitesConfig = `
+resource "fmc_qos_policy" "test" {
+  name = "qos_rules"
+}
+
+resource "fmc_security_zone" "test" {
+  name           = "fmc_qos_rules_zone"
+  interface_type = "ROUTED"
+}
+
+resource "fmc_network" "test" {
+  name   = "fmc_qos_rules_network"
+  prefix = "10.0.0.0/24"
+}
+`
+
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcQoSRulesConfig_minimum() string {
+	config := `resource "fmc_qos_rules" "test" {` + "\n"
+	config += `	qos_policy_id = fmc_qos_policy.test.id` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcQoSRulesConfig_all() string {
+	config := `resource "fmc_qos_rules" "test" {` + "\n"
+	config += `	qos_policy_id = fmc_qos_policy.test.id` + "\n"
+	config += `	items = [{` + "\n"
+	config += `		name = "rule_1"` + "\n"
+	config += `		enabled = true` + "\n"
+	config += `		source_interfaces = [{` + "\n"
+	config += `			id = fmc_security_zone.test.id` + "\n"
+	config += `			type = fmc_security_zone.test.type` + "\n"
+	config += `		}]` + "\n"
+	config += `		source_network_literals = [{` + "\n"
+	config += `			value = "10.1.1.0/24"` + "\n"
+	config += `		}]` + "\n"
+	config += `		destination_network_literals = [{` + "\n"
+	config += `			value = "10.2.2.0/24"` + "\n"
+	config += `		}]` + "\n"
+	config += `		source_network_objects = [{` + "\n"
+	config += `			id = fmc_network.test.id` + "\n"
+	config += `			type = fmc_network.test.type` + "\n"
+	config += `		}]` + "\n"
+	config += `		upload_limit = 10.0` + "\n"
+	config += `		download_limit = 50.0` + "\n"
+	config += `	}]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
+
+func TestUnitQoSRulesUpdatePrepend(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	r := &QoSRulesResource{client: client}
+	policy := m.AddObject("/policy/qospolicies", `{"name":"qos","type":"QoSPolicy"}`)
+	zone := m.AddObject("/object/securityzones", `{"name":"guest","type":"SecurityZone"}`)
+	rulesPath := "/policy/qospolicies/" + policy + "/qosrules"
+
+	rules := func(items ...string) QoSRules {
+		data := QoSRules{Id: types.StringValue("bulk"), Domain: types.StringNull(), QosPolicyId: types.StringValue(policy)}
+		for _, v := range items {
+			name, limit, _ := strings.Cut(v, ":")
+			upload, _ := strconv.ParseFloat(limit, 64)
+			data.Items = append(data.Items, QoSRulesItems{
+				Id:               types.StringUnknown(),
+				Name:             types.StringValue(name),
+				Description:      types.StringNull(),
+				Enabled:          types.BoolValue(true),
+				SourceInterfaces: []QoSRulesItemsSourceInterfaces{{Id: types.StringValue(zone), Type: types.StringValue("SecurityZone")}},
+				UploadLimit:      types.Float64Value(upload),
+				DownloadLimit:    types.Float64Null(),
+			})
+		}
+		return data
+	}
+
+	state := rules("guest:10", "backup:100")
+	if _, err := createBulkRules(r.bulkRules(ctx, state), state.Items, "", 0); err != nil {
+		t.Fatalf("failed to create rules: %s", err)
+	}
+
+	plan := rules("voice:1", "guest:5", "backup:100")
+	if _, diags := updateBulkRules(r.bulkRules(ctx, plan), state.Items, plan.Items); diags.HasError() {
+		t.Fatalf("failed to update rules: %v", diags)
+	}
+
+	res, err := readRulesByName(client, plan.getPath(), plan.Items, func(v QoSRulesItems) string { return v.Name.ValueString() })
+	if err != nil {
+		t.Fatalf("failed to read rules: %s", err)
+	}
+	var names []string
+	for _, v := range res.Get("items").Array() {
+		names = append(names, v.Get("name").String())
+	}
+	if !slices.Equal(names, []string{"voice", "guest", "backup"}) {
+		t.Errorf("unexpected rule order %v", names)
+	}
+	if plan.Items[1].Id != state.Items[0].Id || plan.Items[2].Id != state.Items[1].Id {
+		t.Errorf("expected existing rules to keep their IDs")
+	}
+	if limit := gjson.Get(m.Object(rulesPath+"/"+state.Items[0].Id.ValueString()), "rateLimit.upload").Float(); limit != 5 {
+		t.Errorf("expected guest rule upload limit to be updated in place, got %v", limit)
+	}
+
+	var read QoSRules
+	read.Items = plan.Items
+	read.fromBody(ctx, res)
+	if read.Items[0].SourceInterfaces[0].Id.ValueString() != zone || read.Items[0].UploadLimit.ValueFloat64() != 1 {
+		t.Errorf("unexpected rule read back: %+v", read.Items[0])
+	}
+}
@@ -50,6 +50,7 @@ import (
 var (
 	_ resource.Resource                = &RadiusServerGroupResource{}
 	_ resource.ResourceWithImportState = &RadiusServerGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &RadiusServerGroupResource{}
 )
 
 func NewRadiusServerGroupResource() resource.Resource {
@@ -57,7 +58,8 @@ func NewRadiusServerGroupResource() resource.Resource {
 }
 
 type RadiusServerGroupResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *RadiusServerGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -78,11 +80,9 @@ func (r *RadiusServerGroupResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Name of the RADIUS Server Group object.").String,
@@ -176,8 +176,24 @@ func (r *RadiusServerGroupResource) Schema(ctx context.Context, req resource.Sch
 						},
 						"key": schema.StringAttribute{
 							MarkdownDescription: helpers.NewAttributeDescription("Shared secret that is used for data encryption.").String,
-							Required:            true,
+							Optional:            true,
 							Sensitive:           true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_wo")),
+							},
+						},
+						"key_wo": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Shared secret that is used for data encryption. Write-only variant of `key`, the value is never stored in the state (requires Terraform 1.11 or later). Change `key_wo_version` to send a new value to FMC.").String,
+							Optional:            true,
+							Sensitive:           true,
+							WriteOnly:           true,
+						},
+						"key_wo_version": schema.Int64Attribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Version of `key_wo`. Any change of this value triggers an update of `key_wo` on FMC.").String,
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("key_wo")),
+							},
 						},
 						"accounting_port": schema.Int64Attribute{
 							MarkdownDescription: helpers.NewAttributeDescription("Port number for the RADIUS accounting services.").AddIntegerRangeDescription(1, 65535).AddDefaultValueDescription("1813").String,
@@ -224,6 +240,11 @@ func (r *RadiusServerGroupResource) Configure(_ context.Context, req resource.Co
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *RadiusServerGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -239,10 +260,20 @@ func (r *RadiusServerGroupResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config RadiusServerGroup
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	for i := range min(len(plan.RadiusServers), len(config.RadiusServers)) {
+		plan.RadiusServers[i].KeyWo = config.RadiusServers[i].KeyWo
+	}
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -282,7 +313,7 @@ func (r *RadiusServerGroupResource) Read(ctx context.Context, req resource.ReadR
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -331,6 +362,16 @@ func (r *RadiusServerGroupResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config RadiusServerGroup
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	for i := range min(len(plan.RadiusServers), len(config.RadiusServers)) {
+		plan.RadiusServers[i].KeyWo = config.RadiusServers[i].KeyWo
+	}
+
 	// Read state
 	diags = req.State.Get(ctx, &state)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
@@ -340,7 +381,7 @@ func (r *RadiusServerGroupResource) Update(ctx context.Context, req resource.Upd
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -375,7 +416,7 @@ func (r *RadiusServerGroupResource) Delete(ctx context.Context, req resource.Del
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -394,11 +435,15 @@ func (r *RadiusServerGroupResource) Delete(ctx context.Context, req resource.Del
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *RadiusServerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<id>[^\s,]+?)$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
@@ -19,10 +19,14 @@ package provider
 
 // Section below is generated&owned by "gen/generator.go". //template:begin imports
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tidwall/gjson"
 )
 
 // End of section. //template:end imports
@@ -30,6 +34,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcRadiusServerGroup(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcRadiusServerGroupConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRadiusServerGroupConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRadiusServerGroupChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_radius_server_group.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcRadiusServerGroupChecks returns the checks of the configuration with all attributes
+func testAccFmcRadiusServerGroupChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_radius_server_group.test", "name", "my_radius_server_group"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_radius_server_group.test", "type"))
@@ -47,30 +76,39 @@ func TestAccFmcRadiusServerGroup(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_radius_server_group.test", "radius_servers.0.timeout", "10"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_radius_server_group.test", "radius_servers.0.use_routing_to_select_interface", "true"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcRadiusServerGroup(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcRadiusServerGroupConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRadiusServerGroupConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcRadiusServerGroupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRadiusServerGroupChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_radius_server_group.test",
-		ImportState:  true,
+		ResourceName:            "fmc_radius_server_group.test",
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"radius_servers.0.key", "radius_servers.0.key_wo", "radius_servers.0.key_wo_version"},
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -116,3 +154,45 @@ func testAccFmcRadiusServerGroupConfig_all() string {
 }
 
 // End of section. //template:end testAccConfigAll
+
+func TestUnitRadiusServerGroupUpdateWriteOnly(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	r := &RadiusServerGroupResource{client: client}
+	id := m.AddObject("/object/radiusservergroups", `{"name":"radius","radiusServers":[{"host":"10.10.10.10","secretKey":"old"}]}`)
+
+	if !testUnitResourceSchema(ctx, r).Attributes["radius_servers"].(schema.ListNestedAttribute).NestedObject.Attributes["key_wo"].IsWriteOnly() {
+		t.Fatalf("expected key_wo to be a write-only attribute")
+	}
+
+	group := func(key, keyWo string, version int64) RadiusServerGroup {
+		server := RadiusServerGroupRadiusServers{
+			Hostname:     types.StringValue("10.10.10.10"),
+			Key:          types.StringNull(),
+			KeyWo:        types.StringNull(),
+			KeyWoVersion: types.Int64Value(version),
+		}
+		if key != "" {
+			server.Key = types.StringValue(key)
+		}
+		if keyWo != "" {
+			server.KeyWo = types.StringValue(keyWo)
+		}
+		return RadiusServerGroup{
+			Id:            types.StringValue(id),
+			Domain:        types.StringValue("Global"),
+			Name:          types.StringValue("radius"),
+			RadiusServers: []RadiusServerGroupRadiusServers{server},
+		}
+	}
+
+	resp := testUnitResourceUpdate(ctx, r, group("", "new", 2), group("", "", 2), group("old", "", 1))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("failed to update object: %v", resp.Diagnostics)
+	}
+
+	if key := gjson.Get(m.Object("/object/radiusservergroups/"+id), "radiusServers.0.secretKey").String(); key != "new" {
+		t.Errorf("expected write-only key to be sent to FMC, got %q", key)
+	}
+}
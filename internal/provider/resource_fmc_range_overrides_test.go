@@ -33,11 +33,6 @@ func TestAccFmcRangeOverrides(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id")
 	}
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_range_overrides.test", "overrides.0.target_type", "Device"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_range_overrides.test", "overrides.0.description", "My Range object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_range_overrides.test", "overrides.0.ip_range", "10.1.0.1-10.1.0.9"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +41,7 @@ func TestAccFmcRangeOverrides(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcRangeOverridesPrerequisitesConfig + testAccFmcRangeOverridesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRangeOverridesChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_range_overrides.test",
@@ -61,8 +56,26 @@ func TestAccFmcRangeOverrides(t *testing.T) {
 	})
 }
 
+// testAccFmcRangeOverridesChecks returns the checks of the configuration with all attributes
+func testAccFmcRangeOverridesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_range_overrides.test", "overrides.0.target_type", "Device"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_range_overrides.test", "overrides.0.description", "My Range object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_range_overrides.test", "overrides.0.ip_range", "10.1.0.1-10.1.0.9"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcRangeOverrides(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcRangeOverridesPrerequisitesConfig = `
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcRange(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_range.test", "name", "my_range"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_range.test", "ip_range", "10.0.0.1-10.0.0.9"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_range.test", "description", "My range"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_range.test", "type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcRange(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcRangeConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRangeChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_range.test",
@@ -59,8 +53,47 @@ func TestAccFmcRange(t *testing.T) {
 	})
 }
 
+// testAccFmcRangeChecks returns the checks of the configuration with all attributes
+func testAccFmcRangeChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_range.test", "name", "my_range"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_range.test", "ip_range", "10.0.0.1-10.0.0.9"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_range.test", "description", "My range"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_range.test", "type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcRange(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRangeConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRangeConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRangeChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_range.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcRanges(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ranges.test", "items.my_ranges.id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ranges.test", "items.my_ranges.description", "My Range 1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ranges.test", "items.my_ranges.overridable", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_ranges.test", "items.my_ranges.ip_range", "10.0.0.1-10.0.0.9"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ranges.test", "items.my_ranges.type"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcRanges(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcRangesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRangesChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +49,43 @@ func TestAccFmcRanges(t *testing.T) {
 	})
 }
 
+// testAccFmcRangesChecks returns the checks of the configuration with all attributes
+func testAccFmcRangesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ranges.test", "items.my_ranges.id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ranges.test", "items.my_ranges.description", "My Range 1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ranges.test", "items.my_ranges.overridable", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_ranges.test", "items.my_ranges.ip_range", "10.0.0.1-10.0.0.9"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_ranges.test", "items.my_ranges.type"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcRanges(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRangesConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRangesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRangesChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
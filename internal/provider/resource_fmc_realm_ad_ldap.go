@@ -48,6 +48,7 @@ import (
 var (
 	_ resource.Resource                = &RealmADLDAPResource{}
 	_ resource.ResourceWithImportState = &RealmADLDAPResource{}
+	_ resource.ResourceWithModifyPlan  = &RealmADLDAPResource{}
 )
 
 func NewRealmADLDAPResource() resource.Resource {
@@ -55,7 +56,8 @@ func NewRealmADLDAPResource() resource.Resource {
 }
 
 type RealmADLDAPResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *RealmADLDAPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,11 +78,9 @@ func (r *RealmADLDAPResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Name of the Realm object.").String,
@@ -134,14 +134,46 @@ func (r *RealmADLDAPResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"ad_join_password_wo": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Password for `ad_join_username` user. Write-only variant of `ad_join_password`, the value is never stored in the state (requires Terraform 1.11 or later). Change `ad_join_password_wo_version` to send a new value to FMC.").String,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ad_join_password")),
+				},
+			},
+			"ad_join_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Version of `ad_join_password_wo`. Any change of this value triggers an update of `ad_join_password_wo` on FMC.").String,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("ad_join_password_wo")),
+				},
+			},
 			"directory_username": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Username used to connect to the directory.").String,
 				Required:            true,
 			},
 			"directory_password": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Password for the `directory_username`.").String,
-				Required:            true,
+				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("directory_password_wo")),
+				},
+			},
+			"directory_password_wo": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Password for the `directory_username`. Write-only variant of `directory_password`, the value is never stored in the state (requires Terraform 1.11 or later). Change `directory_password_wo_version` to send a new value to FMC.").String,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"directory_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Version of `directory_password_wo`. Any change of this value triggers an update of `directory_password_wo` on FMC.").String,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("directory_password_wo")),
+				},
 			},
 			"base_dn": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Directory tree where the search for user data should begin.").String,
@@ -271,6 +303,11 @@ func (r *RealmADLDAPResource) Configure(_ context.Context, req resource.Configur
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *RealmADLDAPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -286,10 +323,19 @@ func (r *RealmADLDAPResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config RealmADLDAP
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.AdJoinPasswordWo = config.AdJoinPasswordWo
+	plan.DirectoryPasswordWo = config.DirectoryPasswordWo
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
@@ -329,7 +375,7 @@ func (r *RealmADLDAPResource) Read(ctx context.Context, req resource.ReadRequest
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -378,6 +424,15 @@ func (r *RealmADLDAPResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config RealmADLDAP
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.AdJoinPasswordWo = config.AdJoinPasswordWo
+	plan.DirectoryPasswordWo = config.DirectoryPasswordWo
+
 	// Read state
 	diags = req.State.Get(ctx, &state)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
@@ -387,7 +442,7 @@ func (r *RealmADLDAPResource) Update(ctx context.Context, req resource.UpdateReq
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -422,7 +477,7 @@ func (r *RealmADLDAPResource) Delete(ctx context.Context, req resource.DeleteReq
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -441,11 +496,15 @@ func (r *RealmADLDAPResource) Delete(ctx context.Context, req resource.DeleteReq
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *RealmADLDAPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<id>[^\s,]+?)$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
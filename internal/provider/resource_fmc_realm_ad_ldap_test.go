@@ -30,6 +30,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcRealmADLDAP(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcRealmADLDAPConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRealmADLDAPConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRealmADLDAPChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_realm_ad_ldap.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcRealmADLDAPChecks returns the checks of the configuration with all attributes
+func testAccFmcRealmADLDAPChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_realm_ad_ldap.test", "name", "my_ldap_realm"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_realm_ad_ldap.test", "enabled", "true"))
@@ -56,30 +81,39 @@ func TestAccFmcRealmADLDAP(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_realm_ad_ldap.test", "directory_servers.0.port", "389"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_realm_ad_ldap.test", "directory_servers.0.use_routing_to_select_interface", "false"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcRealmADLDAP(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcRealmADLDAPConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRealmADLDAPConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcRealmADLDAPConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRealmADLDAPChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_realm_ad_ldap.test",
-		ImportState:  true,
+		ResourceName:            "fmc_realm_ad_ldap.test",
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"ad_join_password", "ad_join_password_wo", "ad_join_password_wo_version", "directory_password", "directory_password_wo", "directory_password_wo_version"},
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcRealmLocal(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_realm_local.test", "name", "my_ldap_realm"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_realm_local.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_realm_local.test", "version"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_realm_local.test", "description", "My realm"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcRealmLocal(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcRealmLocalConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRealmLocalChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_realm_local.test",
@@ -59,8 +53,47 @@ func TestAccFmcRealmLocal(t *testing.T) {
 	})
 }
 
+// testAccFmcRealmLocalChecks returns the checks of the configuration with all attributes
+func testAccFmcRealmLocalChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_realm_local.test", "name", "my_ldap_realm"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_realm_local.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_realm_local.test", "version"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_realm_local.test", "description", "My realm"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcRealmLocal(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRealmLocalConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRealmLocalConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRealmLocalChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_realm_local.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
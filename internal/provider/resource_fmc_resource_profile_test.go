@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcResourceProfile(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profile.test", "name", "my_network"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_resource_profile.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profile.test", "description", "My Resource Profile object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profile.test", "number_of_cpus", "10"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcResourceProfile(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcResourceProfileConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcResourceProfileChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_resource_profile.test",
@@ -59,8 +53,47 @@ func TestAccFmcResourceProfile(t *testing.T) {
 	})
 }
 
+// testAccFmcResourceProfileChecks returns the checks of the configuration with all attributes
+func testAccFmcResourceProfileChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profile.test", "name", "my_network"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_resource_profile.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profile.test", "description", "My Resource Profile object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profile.test", "number_of_cpus", "10"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcResourceProfile(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcResourceProfileConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcResourceProfileConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcResourceProfileChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_resource_profile.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
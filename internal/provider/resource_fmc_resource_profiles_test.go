@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcResourceProfiles(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_resource_profiles.test", "items.my_resource_profiles.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_resource_profiles.test", "items.my_resource_profiles.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profiles.test", "items.my_resource_profiles.description", "My Resource Profile object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profiles.test", "items.my_resource_profiles.number_of_cpus", "10"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcResourceProfiles(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcResourceProfilesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcResourceProfilesChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,42 @@ func TestAccFmcResourceProfiles(t *testing.T) {
 	})
 }
 
+// testAccFmcResourceProfilesChecks returns the checks of the configuration with all attributes
+func testAccFmcResourceProfilesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_resource_profiles.test", "items.my_resource_profiles.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_resource_profiles.test", "items.my_resource_profiles.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profiles.test", "items.my_resource_profiles.description", "My Resource Profile object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_resource_profiles.test", "items.my_resource_profiles.number_of_cpus", "10"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcResourceProfiles(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcResourceProfilesConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcResourceProfilesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcResourceProfilesChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,6 +30,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcRouteMap(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcRouteMapPrerequisitesConfig + testAccFmcRouteMapConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcRouteMapPrerequisitesConfig + testAccFmcRouteMapConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcRouteMapChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_route_map.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcRouteMapChecks returns the checks of the configuration with all attributes
+func testAccFmcRouteMapChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_route_map.test", "name", "my_route_map"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_route_map.test", "type"))
@@ -61,31 +86,19 @@ func TestAccFmcRouteMap(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_route_map.test", "entries.0.set_bgp_weight", "100"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_route_map.test", "entries.0.set_bgp_origin", "LOCAL_IGP"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcRouteMapPrerequisitesConfig + testAccFmcRouteMapConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcRouteMapPrerequisitesConfig + testAccFmcRouteMapConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_route_map.test",
-		ImportState:  true,
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcRouteMap(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcRouteMapPrerequisitesConfig = `
@@ -30,14 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSecureClientCustomAttribute(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "name", "my_secure_client_custom_attribute"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_secure_client_custom_attribute.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "description", "My Secure Client Custom Attribute"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "attribute_type", "USER_DEFINED_CUSTOM_ATTR"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "user_defined_attribute_name", "my_user_defined_attribute"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "user_defined_attribute_value", "my_value"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +38,7 @@ func TestAccFmcSecureClientCustomAttribute(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSecureClientCustomAttributeConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecureClientCustomAttributeChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_secure_client_custom_attribute.test",
@@ -61,8 +53,49 @@ func TestAccFmcSecureClientCustomAttribute(t *testing.T) {
 	})
 }
 
+// testAccFmcSecureClientCustomAttributeChecks returns the checks of the configuration with all attributes
+func testAccFmcSecureClientCustomAttributeChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "name", "my_secure_client_custom_attribute"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_secure_client_custom_attribute.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "description", "My Secure Client Custom Attribute"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "attribute_type", "USER_DEFINED_CUSTOM_ATTR"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "user_defined_attribute_name", "my_user_defined_attribute"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_secure_client_custom_attribute.test", "user_defined_attribute_value", "my_value"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSecureClientCustomAttribute(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecureClientCustomAttributeConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecureClientCustomAttributeConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecureClientCustomAttributeChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_secure_client_custom_attribute.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
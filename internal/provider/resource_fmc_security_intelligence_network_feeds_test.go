@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSecurityIntelligenceNetworkFeeds(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.feed_url", "https://example.com/path/to/feed.txt"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.checksum_url", "https://example.com/path/to/checksum.md5"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.update_frequency", "120"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcSecurityIntelligenceNetworkFeeds(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSecurityIntelligenceNetworkFeedsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecurityIntelligenceNetworkFeedsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -56,8 +49,43 @@ func TestAccFmcSecurityIntelligenceNetworkFeeds(t *testing.T) {
 	})
 }
 
+// testAccFmcSecurityIntelligenceNetworkFeedsChecks returns the checks of the configuration with all attributes
+func testAccFmcSecurityIntelligenceNetworkFeedsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.feed_url", "https://example.com/path/to/feed.txt"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.checksum_url", "https://example.com/path/to/checksum.md5"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_network_feeds.test", "items.my_si_network_feeds.update_frequency", "120"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSecurityIntelligenceNetworkFeeds(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecurityIntelligenceNetworkFeedsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecurityIntelligenceNetworkFeedsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecurityIntelligenceNetworkFeedsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
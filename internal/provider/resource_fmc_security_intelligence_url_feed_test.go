@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSecurityIntelligenceURLFeed(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_url_feed.test", "name", "my_si_url_feed"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_intelligence_url_feed.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_url_feed.test", "feed_url", "https://example.com/path/to/feed.txt"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_url_feed.test", "checksum_url", "https://example.com/path/to/checksum.md5"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_url_feed.test", "update_frequency", "120"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcSecurityIntelligenceURLFeed(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSecurityIntelligenceURLFeedConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecurityIntelligenceURLFeedChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_security_intelligence_url_feed.test",
@@ -60,8 +53,48 @@ func TestAccFmcSecurityIntelligenceURLFeed(t *testing.T) {
 	})
 }
 
+// testAccFmcSecurityIntelligenceURLFeedChecks returns the checks of the configuration with all attributes
+func testAccFmcSecurityIntelligenceURLFeedChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_url_feed.test", "name", "my_si_url_feed"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_intelligence_url_feed.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_url_feed.test", "feed_url", "https://example.com/path/to/feed.txt"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_url_feed.test", "checksum_url", "https://example.com/path/to/checksum.md5"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_intelligence_url_feed.test", "update_frequency", "120"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSecurityIntelligenceURLFeed(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecurityIntelligenceURLFeedConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecurityIntelligenceURLFeedConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecurityIntelligenceURLFeedChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_security_intelligence_url_feed.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSecurityZone(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_zone.test", "name", "my_security_zone"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_zone.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_zone.test", "interface_type", "ROUTED"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcSecurityZone(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSecurityZoneConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecurityZoneChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_security_zone.test",
@@ -58,8 +53,46 @@ func TestAccFmcSecurityZone(t *testing.T) {
 	})
 }
 
+// testAccFmcSecurityZoneChecks returns the checks of the configuration with all attributes
+func testAccFmcSecurityZoneChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_zone.test", "name", "my_security_zone"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_zone.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_zone.test", "interface_type", "ROUTED"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSecurityZone(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecurityZoneConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecurityZoneConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecurityZoneChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_security_zone.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -33,11 +33,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSecurityZones(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_zones.test", "items.my_security_zones.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_zones.test", "items.my_security_zones.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_zones.test", "items.my_security_zones.interface_type", "ROUTED"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -46,7 +41,7 @@ func TestAccFmcSecurityZones(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSecurityZonesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecurityZonesChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -57,8 +52,41 @@ func TestAccFmcSecurityZones(t *testing.T) {
 	})
 }
 
+// testAccFmcSecurityZonesChecks returns the checks of the configuration with all attributes
+func testAccFmcSecurityZonesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_zones.test", "items.my_security_zones.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_security_zones.test", "items.my_security_zones.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_security_zones.test", "items.my_security_zones.interface_type", "ROUTED"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSecurityZones(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecurityZonesConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSecurityZonesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSecurityZonesChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcServiceAccess(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_service_access.test", "name", "my_service_access"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_service_access.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_service_access.test", "default_action", "DENY"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_service_access.test", "rules.0.action", "ALLOW"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcServiceAccess(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcServiceAccessPrerequisitesConfig + testAccFmcServiceAccessConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcServiceAccessChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_service_access.test",
@@ -59,8 +53,27 @@ func TestAccFmcServiceAccess(t *testing.T) {
 	})
 }
 
+// testAccFmcServiceAccessChecks returns the checks of the configuration with all attributes
+func testAccFmcServiceAccessChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_service_access.test", "name", "my_service_access"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_service_access.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_service_access.test", "default_action", "DENY"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_service_access.test", "rules.0.action", "ALLOW"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcServiceAccess(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcServiceAccessPrerequisitesConfig = `
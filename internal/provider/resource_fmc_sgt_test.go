@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSGT(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgt.test", "name", "my_sgt"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sgt.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgt.test", "description", "My SGT object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgt.test", "tag", "11"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcSGT(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSGTConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSGTChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_sgt.test",
@@ -59,8 +53,47 @@ func TestAccFmcSGT(t *testing.T) {
 	})
 }
 
+// testAccFmcSGTChecks returns the checks of the configuration with all attributes
+func testAccFmcSGTChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgt.test", "name", "my_sgt"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sgt.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgt.test", "description", "My SGT object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgt.test", "tag", "11"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSGT(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSGTConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSGTConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSGTChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_sgt.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
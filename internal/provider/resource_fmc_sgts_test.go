@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSGTs(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sgts.test", "items.my_sgts.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sgts.test", "items.my_sgts.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgts.test", "items.my_sgts.description", "My SGT object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgts.test", "items.my_sgts.tag", "11"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcSGTs(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSGTsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSGTsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,42 @@ func TestAccFmcSGTs(t *testing.T) {
 	})
 }
 
+// testAccFmcSGTsChecks returns the checks of the configuration with all attributes
+func testAccFmcSGTsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sgts.test", "items.my_sgts.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sgts.test", "items.my_sgts.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgts.test", "items.my_sgts.description", "My SGT object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sgts.test", "items.my_sgts.tag", "11"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSGTs(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSGTsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcSGTsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSGTsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,18 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSingleSignOnServer(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "name", "my_sso_server"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_single_sign_on_server.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "identity_provider_entity_id_url", "https://idp.example.com/saml"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "sso_url", "https://idp.example.com/sso"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "logout_url", "https://idp.example.com/logout"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "base_url", "https://fmc.example.com/sso"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "request_signature_type", "RSA-SHA256"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "request_timeout", "300"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "identity_provider_accessible_only_on_internal_network", "false"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "request_identity_provider_reauthentication_at_each_login", "false"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -50,7 +38,7 @@ func TestAccFmcSingleSignOnServer(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSingleSignOnServerPrerequisitesConfig + testAccFmcSingleSignOnServerConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSingleSignOnServerChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_single_sign_on_server.test",
@@ -65,8 +53,33 @@ func TestAccFmcSingleSignOnServer(t *testing.T) {
 	})
 }
 
+// testAccFmcSingleSignOnServerChecks returns the checks of the configuration with all attributes
+func testAccFmcSingleSignOnServerChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "name", "my_sso_server"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_single_sign_on_server.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "identity_provider_entity_id_url", "https://idp.example.com/saml"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "sso_url", "https://idp.example.com/sso"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "logout_url", "https://idp.example.com/logout"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "base_url", "https://fmc.example.com/sso"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "request_signature_type", "RSA-SHA256"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "request_timeout", "300"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "identity_provider_accessible_only_on_internal_network", "false"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_single_sign_on_server.test", "request_identity_provider_reauthentication_at_each_login", "false"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSingleSignOnServer(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcSingleSignOnServerPrerequisitesConfig = `
@@ -30,19 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSLAMonitor(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "name", "my_sla_monitor"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sla_monitor.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "description", "My SLA Monitor object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "sla_monitor_id", "10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "timeout", "5000"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "frequency", "60"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "threshold", "5000"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "data_size", "28"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "tos", "20"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "number_of_packets", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "monitor_address", "10.10.10.1"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -51,7 +38,7 @@ func TestAccFmcSLAMonitor(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSLAMonitorPrerequisitesConfig + testAccFmcSLAMonitorConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSLAMonitorChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_sla_monitor.test",
@@ -66,8 +53,34 @@ func TestAccFmcSLAMonitor(t *testing.T) {
 	})
 }
 
+// testAccFmcSLAMonitorChecks returns the checks of the configuration with all attributes
+func testAccFmcSLAMonitorChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "name", "my_sla_monitor"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sla_monitor.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "description", "My SLA Monitor object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "sla_monitor_id", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "timeout", "5000"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "frequency", "60"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "threshold", "5000"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "data_size", "28"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "tos", "20"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "number_of_packets", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitor.test", "monitor_address", "10.10.10.1"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSLAMonitor(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcSLAMonitorPrerequisitesConfig = `
@@ -30,19 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcSLAMonitors(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sla_monitors.test", "items.my_sla_monitors.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sla_monitors.test", "items.my_sla_monitors.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.description", "My SLA Monitor object"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.sla_monitor_id", "10"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.timeout", "5000"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.frequency", "60"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.threshold", "5000"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.data_size", "28"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.tos", "20"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.number_of_packets", "1"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.monitor_address", "10.10.10.1"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -51,7 +38,7 @@ func TestAccFmcSLAMonitors(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcSLAMonitorsPrerequisitesConfig + testAccFmcSLAMonitorsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcSLAMonitorsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -62,8 +49,34 @@ func TestAccFmcSLAMonitors(t *testing.T) {
 	})
 }
 
+// testAccFmcSLAMonitorsChecks returns the checks of the configuration with all attributes
+func testAccFmcSLAMonitorsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sla_monitors.test", "items.my_sla_monitors.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_sla_monitors.test", "items.my_sla_monitors.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.description", "My SLA Monitor object"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.sla_monitor_id", "10"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.timeout", "5000"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.frequency", "60"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.threshold", "5000"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.data_size", "28"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.tos", "20"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.number_of_packets", "1"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_sla_monitors.test", "items.my_sla_monitors.monitor_address", "10.10.10.1"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcSLAMonitors(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcSLAMonitorsPrerequisitesConfig = `
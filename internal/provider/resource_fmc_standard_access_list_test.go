@@ -30,13 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcStandardAccessList(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_access_list.test", "name", "my_standard_acl"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_access_list.test", "description", "My standard ACL"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_standard_access_list.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_access_list.test", "entries.0.action", "DENY"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_access_list.test", "entries.0.literals.0.value", "10.1.1.0/24"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -45,7 +38,7 @@ func TestAccFmcStandardAccessList(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcStandardAccessListConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcStandardAccessListChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_standard_access_list.test",
@@ -60,8 +53,48 @@ func TestAccFmcStandardAccessList(t *testing.T) {
 	})
 }
 
+// testAccFmcStandardAccessListChecks returns the checks of the configuration with all attributes
+func testAccFmcStandardAccessListChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_access_list.test", "name", "my_standard_acl"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_access_list.test", "description", "My standard ACL"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_standard_access_list.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_access_list.test", "entries.0.action", "DENY"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_access_list.test", "entries.0.literals.0.value", "10.1.1.0/24"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcStandardAccessList(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcStandardAccessListConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcStandardAccessListConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcStandardAccessListChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_standard_access_list.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
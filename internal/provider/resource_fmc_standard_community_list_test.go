@@ -30,15 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcStandardCommunityList(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "name", "my_standard_community_list"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_standard_community_list.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.action", "PERMIT"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.communities", "123 456 789"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.internet", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.no_advertise", "true"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.no_export", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -47,7 +38,7 @@ func TestAccFmcStandardCommunityList(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcStandardCommunityListConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcStandardCommunityListChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_standard_community_list.test",
@@ -62,8 +53,50 @@ func TestAccFmcStandardCommunityList(t *testing.T) {
 	})
 }
 
+// testAccFmcStandardCommunityListChecks returns the checks of the configuration with all attributes
+func testAccFmcStandardCommunityListChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "name", "my_standard_community_list"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_standard_community_list.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.action", "PERMIT"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.communities", "123 456 789"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.internet", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.no_advertise", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_list.test", "entries.0.no_export", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcStandardCommunityList(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcStandardCommunityListConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcStandardCommunityListConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcStandardCommunityListChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_standard_community_list.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
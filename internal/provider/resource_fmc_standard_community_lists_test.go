@@ -30,6 +30,27 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcStandardCommunityLists(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcStandardCommunityListsConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcStandardCommunityListsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcStandardCommunityListsChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcStandardCommunityListsChecks returns the checks of the configuration with all attributes
+func testAccFmcStandardCommunityListsChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_standard_community_lists.test", "items.my_standard_community_lists.id"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_standard_community_lists.test", "items.my_standard_community_lists.type"))
@@ -39,26 +60,33 @@ func TestAccFmcStandardCommunityLists(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_lists.test", "items.my_standard_community_lists.entries.0.no_advertise", "true"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_standard_community_lists.test", "items.my_standard_community_lists.entries.0.no_export", "true"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcStandardCommunityLists(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcStandardCommunityListsConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcStandardCommunityListsConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcStandardCommunityListsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcStandardCommunityListsChecks()...),
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -30,6 +30,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcTimeRange(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcTimeRangeConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcTimeRangeConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcTimeRangeChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_time_range.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcTimeRangeChecks returns the checks of the configuration with all attributes
+func testAccFmcTimeRangeChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_time_range.test", "name", "my_time_range"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_time_range.test", "type"))
@@ -42,30 +67,38 @@ func TestAccFmcTimeRange(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_time_range.test", "recurrence_list.0.range_start_day", "MON"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_time_range.test", "recurrence_list.0.range_end_day", "FRI"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcTimeRange(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcTimeRangeConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcTimeRangeConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcTimeRangeConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcTimeRangeChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_time_range.test",
-		ImportState:  true,
+		ResourceName:      "fmc_time_range.test",
+		ImportState:       true,
+		ImportStateVerify: true,
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -30,6 +30,27 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcTimeRanges(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcTimeRangesConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcTimeRangesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcTimeRangesChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcTimeRangesChecks returns the checks of the configuration with all attributes
+func testAccFmcTimeRangesChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_time_ranges.test", "items.my_time_ranges.id"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_time_ranges.test", "items.my_time_ranges.type"))
@@ -42,26 +63,33 @@ func TestAccFmcTimeRanges(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_time_ranges.test", "items.my_time_ranges.recurrence_list.0.range_start_day", "MON"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_time_ranges.test", "items.my_time_ranges.recurrence_list.0.range_end_day", "FRI"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcTimeRanges(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcTimeRangesConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcTimeRangesConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcTimeRangesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcTimeRangesChecks()...),
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
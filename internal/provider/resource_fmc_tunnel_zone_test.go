@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcTunnelZone(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_tunnel_zone.test", "name", "my_tunnel_zone"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_tunnel_zone.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_tunnel_zone.test", "description", "My Tunnel Zone object"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcTunnelZone(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcTunnelZoneConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcTunnelZoneChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_tunnel_zone.test",
@@ -58,8 +53,46 @@ func TestAccFmcTunnelZone(t *testing.T) {
 	})
 }
 
+// testAccFmcTunnelZoneChecks returns the checks of the configuration with all attributes
+func testAccFmcTunnelZoneChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_tunnel_zone.test", "name", "my_tunnel_zone"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_tunnel_zone.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_tunnel_zone.test", "description", "My Tunnel Zone object"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcTunnelZone(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcTunnelZoneConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcTunnelZoneConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcTunnelZoneChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_tunnel_zone.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,11 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcTunnelZones(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_tunnel_zones.test", "items.my_tunnel_zones.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_tunnel_zones.test", "items.my_tunnel_zones.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_tunnel_zones.test", "items.my_tunnel_zones.description", "My Tunnel Zone object"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -43,7 +38,7 @@ func TestAccFmcTunnelZones(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcTunnelZonesConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcTunnelZonesChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -54,8 +49,41 @@ func TestAccFmcTunnelZones(t *testing.T) {
 	})
 }
 
+// testAccFmcTunnelZonesChecks returns the checks of the configuration with all attributes
+func testAccFmcTunnelZonesChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_tunnel_zones.test", "items.my_tunnel_zones.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_tunnel_zones.test", "items.my_tunnel_zones.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_tunnel_zones.test", "items.my_tunnel_zones.description", "My Tunnel Zone object"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcTunnelZones(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcTunnelZonesConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcTunnelZonesConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcTunnelZonesChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -0,0 +1,126 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// Section below is generated&owned by "gen/generator.go". //template:begin imports
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// End of section. //template:end imports
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAcc
+
+func TestAccFmcUmbrellaDNSPolicy(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcUmbrellaDNSPolicyConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcUmbrellaDNSPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcUmbrellaDNSPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_umbrella_dns_policy.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcUmbrellaDNSPolicyChecks returns the checks of the configuration with all attributes
+func testAccFmcUmbrellaDNSPolicyChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_umbrella_dns_policy.test", "name", "my_umbrella_dns_policy"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_umbrella_dns_policy.test", "description", "My Umbrella DNS policy"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_umbrella_dns_policy.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_umbrella_dns_policy.test", "dnscrypt", "true"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_umbrella_dns_policy.test", "idle_timeout", "20"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_umbrella_dns_policy.test", "local_domain_bypass.0", "example.com"))
+
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcUmbrellaDNSPolicy(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcUmbrellaDNSPolicyConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcUmbrellaDNSPolicyConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcUmbrellaDNSPolicyChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_umbrella_dns_policy.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
+// End of section. //template:end testPrerequisites
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigMinimal
+
+func testAccFmcUmbrellaDNSPolicyConfig_minimum() string {
+	config := `resource "fmc_umbrella_dns_policy" "test" {` + "\n"
+	config += `	name = "my_umbrella_dns_policy"` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigMinimal
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testAccConfigAll
+
+func testAccFmcUmbrellaDNSPolicyConfig_all() string {
+	config := `resource "fmc_umbrella_dns_policy" "test" {` + "\n"
+	config += `	name = "my_umbrella_dns_policy"` + "\n"
+	config += `	description = "My Umbrella DNS policy"` + "\n"
+	config += `	dnscrypt = true` + "\n"
+	config += `	idle_timeout = 20` + "\n"
+	config += `	local_domain_bypass = ["example.com"]` + "\n"
+	config += `}` + "\n"
+	return config
+}
+
+// End of section. //template:end testAccConfigAll
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcURLGroup(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_group.test", "name", "my_url_group"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_url_group.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_group.test", "description", "My URL group"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_group.test", "literals.0.url", "https://www.example.com/app"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcURLGroup(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcURLGroupPrerequisitesConfig + testAccFmcURLGroupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcURLGroupChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_url_group.test",
@@ -59,8 +53,27 @@ func TestAccFmcURLGroup(t *testing.T) {
 	})
 }
 
+// testAccFmcURLGroupChecks returns the checks of the configuration with all attributes
+func testAccFmcURLGroupChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_group.test", "name", "my_url_group"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_url_group.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_group.test", "description", "My URL group"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_group.test", "literals.0.url", "https://www.example.com/app"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcURLGroup(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcURLGroupPrerequisitesConfig = `
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcURLGroups(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_url_groups.test", "items.my_url_groups.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_url_groups.test", "items.my_url_groups.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_groups.test", "items.my_url_groups.description", "My URL group"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_groups.test", "items.my_url_groups.literals.0.url", "https://www.example.com/app"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcURLGroups(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcURLGroupsPrerequisitesConfig + testAccFmcURLGroupsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcURLGroupsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,27 @@ func TestAccFmcURLGroups(t *testing.T) {
 	})
 }
 
+// testAccFmcURLGroupsChecks returns the checks of the configuration with all attributes
+func testAccFmcURLGroupsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_url_groups.test", "items.my_url_groups.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_url_groups.test", "items.my_url_groups.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_groups.test", "items.my_url_groups.description", "My URL group"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_url_groups.test", "items.my_url_groups.literals.0.url", "https://www.example.com/app"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcURLGroups(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcURLGroupsPrerequisitesConfig = `
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcURL(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_url.test", "name", "my_url"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_url.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_url.test", "description", "My URL"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_url.test", "url", "https://www.example.com/app"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcURL(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcURLConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcURLChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_url.test",
@@ -59,8 +53,47 @@ func TestAccFmcURL(t *testing.T) {
 	})
 }
 
+// testAccFmcURLChecks returns the checks of the configuration with all attributes
+func testAccFmcURLChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_url.test", "name", "my_url"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_url.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_url.test", "description", "My URL"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_url.test", "url", "https://www.example.com/app"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcURL(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcURLConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcURLConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcURLChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_url.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
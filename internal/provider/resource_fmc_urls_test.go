@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcURLs(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_urls.test", "items.my_urls.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_urls.test", "items.my_urls.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_urls.test", "items.my_urls.description", "My URL"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_urls.test", "items.my_urls.url", "https://www.example.com/app"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcURLs(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcURLsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcURLsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,42 @@ func TestAccFmcURLs(t *testing.T) {
 	})
 }
 
+// testAccFmcURLsChecks returns the checks of the configuration with all attributes
+func testAccFmcURLsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_urls.test", "items.my_urls.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_urls.test", "items.my_urls.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_urls.test", "items.my_urls.description", "My URL"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_urls.test", "items.my_urls.url", "https://www.example.com/app"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcURLs(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcURLsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcURLsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcURLsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVLANTagGroup(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_group.test", "name", "my_vlan_tag_group"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_group.test", "description", "My VLAN Tag Group"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tag_group.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_group.test", "overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcVLANTagGroup(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcVLANTagGroupPrerequisitesConfig + testAccFmcVLANTagGroupConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVLANTagGroupChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_vlan_tag_group.test",
@@ -59,8 +53,27 @@ func TestAccFmcVLANTagGroup(t *testing.T) {
 	})
 }
 
+// testAccFmcVLANTagGroupChecks returns the checks of the configuration with all attributes
+func testAccFmcVLANTagGroupChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_group.test", "name", "my_vlan_tag_group"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_group.test", "description", "My VLAN Tag Group"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tag_group.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_group.test", "overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVLANTagGroup(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcVLANTagGroupPrerequisitesConfig = `
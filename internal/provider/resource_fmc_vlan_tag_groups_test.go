@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVLANTagGroups(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tag_groups.test", "items.vlan_tag_groups.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tag_groups.test", "items.vlan_tag_groups.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_groups.test", "items.vlan_tag_groups.description", "My VLAN Tag Group name"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_groups.test", "items.vlan_tag_groups.overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcVLANTagGroups(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcVLANTagGroupsPrerequisitesConfig + testAccFmcVLANTagGroupsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVLANTagGroupsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,27 @@ func TestAccFmcVLANTagGroups(t *testing.T) {
 	})
 }
 
+// testAccFmcVLANTagGroupsChecks returns the checks of the configuration with all attributes
+func testAccFmcVLANTagGroupsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tag_groups.test", "items.vlan_tag_groups.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tag_groups.test", "items.vlan_tag_groups.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_groups.test", "items.vlan_tag_groups.description", "My VLAN Tag Group name"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag_groups.test", "items.vlan_tag_groups.overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVLANTagGroups(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcVLANTagGroupsPrerequisitesConfig = `
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVLANTag(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag.test", "name", "my_vlan_tag"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tag.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag.test", "description", "My VLAN Tag"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag.test", "overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcVLANTag(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcVLANTagConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVLANTagChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
 		ResourceName: "fmc_vlan_tag.test",
@@ -59,8 +53,47 @@ func TestAccFmcVLANTag(t *testing.T) {
 	})
 }
 
+// testAccFmcVLANTagChecks returns the checks of the configuration with all attributes
+func testAccFmcVLANTagChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag.test", "name", "my_vlan_tag"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tag.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag.test", "description", "My VLAN Tag"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tag.test", "overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVLANTag(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVLANTagConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVLANTagConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVLANTagChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName:      "fmc_vlan_tag.test",
+		ImportState:       true,
+		ImportStateVerify: true,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVLANTags(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tags.test", "items.my_vlan_tags.id"))
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tags.test", "items.my_vlan_tags.type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tags.test", "items.my_vlan_tags.description", "My TAG id"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tags.test", "items.my_vlan_tags.overridable", "true"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcVLANTags(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcVLANTagsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVLANTagsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,42 @@ func TestAccFmcVLANTags(t *testing.T) {
 	})
 }
 
+// testAccFmcVLANTagsChecks returns the checks of the configuration with all attributes
+func testAccFmcVLANTagsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tags.test", "items.my_vlan_tags.id"))
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vlan_tags.test", "items.my_vlan_tags.type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tags.test", "items.my_vlan_tags.description", "My TAG id"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vlan_tags.test", "items.my_vlan_tags.overridable", "true"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVLANTags(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVLANTagsConfig_minimum(),
+	})
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVLANTagsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVLANTagsChecks()...),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
 
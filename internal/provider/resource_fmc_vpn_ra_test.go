@@ -29,6 +29,26 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVPNRA(t *testing.T) {
+	var steps []resource.TestStep
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVPNRAPrerequisitesConfig + testAccFmcVPNRAConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVPNRAChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_vpn_ra.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcVPNRAChecks returns the checks of the configuration with all attributes
+func testAccFmcVPNRAChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_ra.test", "name", "my_ftd_ra_vpn"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_ra.test", "description", "My Remote Access VPN Configuration"))
@@ -47,26 +67,19 @@ func TestAccFmcVPNRA(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_ra.test", "ldap_attribute_map_id"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_ra.test", "ipsec_ike_parameters_id"))
 
-	var steps []resource.TestStep
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcVPNRAPrerequisitesConfig + testAccFmcVPNRAConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_vpn_ra.test",
-		ImportState:  true,
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVPNRA(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcVPNRAPrerequisitesConfig = `
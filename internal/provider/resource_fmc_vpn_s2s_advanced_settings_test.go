@@ -30,6 +30,27 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVPNS2SAdvancedSettings(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcVPNS2SAdvancedSettingsPrerequisitesConfig + testAccFmcVPNS2SAdvancedSettingsConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVPNS2SAdvancedSettingsPrerequisitesConfig + testAccFmcVPNS2SAdvancedSettingsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVPNS2SAdvancedSettingsChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcVPNS2SAdvancedSettingsChecks returns the checks of the configuration with all attributes
+func testAccFmcVPNS2SAdvancedSettingsChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_s2s_advanced_settings.test", "type"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_advanced_settings.test", "ike_keepalive", "ENABLED"))
@@ -53,27 +74,19 @@ func TestAccFmcVPNS2SAdvancedSettings(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_advanced_settings.test", "cert_use_ike_identity_to_determine_tunnel", "false"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_advanced_settings.test", "cert_use_peer_ip_address_to_determine_tunnel", "false"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcVPNS2SAdvancedSettingsPrerequisitesConfig + testAccFmcVPNS2SAdvancedSettingsConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcVPNS2SAdvancedSettingsPrerequisitesConfig + testAccFmcVPNS2SAdvancedSettingsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVPNS2SAdvancedSettings(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcVPNS2SAdvancedSettingsPrerequisitesConfig = `
@@ -33,12 +33,10 @@ func TestAccFmcVPNS2SEndpoints(t *testing.T) {
 	if os.Getenv("TF_VAR_device_id") == "" || os.Getenv("TF_VAR_interface_name") == "" {
 		t.Skip("skipping test, set environment variable TF_VAR_device_id and TF_VAR_interface_name")
 	}
-	var checks []resource.TestCheckFunc
-
 	var steps []resource.TestStep
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcVPNS2SEndpointsPrerequisitesConfig + testAccFmcVPNS2SEndpointsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVPNS2SEndpointsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -49,8 +47,23 @@ func TestAccFmcVPNS2SEndpoints(t *testing.T) {
 	})
 }
 
+// testAccFmcVPNS2SEndpointsChecks returns the checks of the configuration with all attributes
+func testAccFmcVPNS2SEndpointsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVPNS2SEndpoints(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcVPNS2SEndpointsPrerequisitesConfig = `
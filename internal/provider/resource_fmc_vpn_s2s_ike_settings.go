@@ -47,6 +47,7 @@ import (
 var (
 	_ resource.Resource                = &VPNS2SIKESettingsResource{}
 	_ resource.ResourceWithImportState = &VPNS2SIKESettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &VPNS2SIKESettingsResource{}
 )
 
 func NewVPNS2SIKESettingsResource() resource.Resource {
@@ -54,7 +55,8 @@ func NewVPNS2SIKESettingsResource() resource.Resource {
 }
 
 type VPNS2SIKESettingsResource struct {
-	client *fmc.Client
+	client        *fmc.Client
+	defaultDomain string
 }
 
 func (r *VPNS2SIKESettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -75,11 +77,9 @@ func (r *VPNS2SIKESettingsResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "Name of the FMC domain",
+				MarkdownDescription: "Name of the FMC domain. Defaults to the provider `domain`.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:            true,
 			},
 			"vpn_s2s_id": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Id of the parent VPN S2S Topology.").String,
@@ -114,6 +114,22 @@ func (r *VPNS2SIKESettingsResource) Schema(ctx context.Context, req resource.Sch
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"ikev1_manual_pre_shared_key_wo": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Manually configured pre-shared key for IKEv1. Write-only variant of `ikev1_manual_pre_shared_key`, the value is never stored in the state (requires Terraform 1.11 or later). Change `ikev1_manual_pre_shared_key_wo_version` to send a new value to FMC.").String,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ikev1_manual_pre_shared_key")),
+				},
+			},
+			"ikev1_manual_pre_shared_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Version of `ikev1_manual_pre_shared_key_wo`. Any change of this value triggers an update of `ikev1_manual_pre_shared_key_wo` on FMC.").String,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("ikev1_manual_pre_shared_key_wo")),
+				},
+			},
 			"ikev1_certificate_id": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Id of the certificate for certificate-based authentication for IKEv1.").String,
 				Optional:            true,
@@ -153,6 +169,22 @@ func (r *VPNS2SIKESettingsResource) Schema(ctx context.Context, req resource.Sch
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"ikev2_manual_pre_shared_key_wo": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Manually configured pre-shared key for IKEv2. Write-only variant of `ikev2_manual_pre_shared_key`, the value is never stored in the state (requires Terraform 1.11 or later). Change `ikev2_manual_pre_shared_key_wo_version` to send a new value to FMC.").String,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ikev2_manual_pre_shared_key")),
+				},
+			},
+			"ikev2_manual_pre_shared_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Version of `ikev2_manual_pre_shared_key_wo`. Any change of this value triggers an update of `ikev2_manual_pre_shared_key_wo` on FMC.").String,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("ikev2_manual_pre_shared_key_wo")),
+				},
+			},
 			"ikev2_enforce_hex_based_pre_shared_key": schema.BoolAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Enforce use of a hex-based pre-shared key for IKEv2.").String,
 				Optional:            true,
@@ -187,6 +219,11 @@ func (r *VPNS2SIKESettingsResource) Configure(_ context.Context, req resource.Co
 	}
 
 	r.client = req.ProviderData.(*FmcProviderData).Client
+	r.defaultDomain = req.ProviderData.(*FmcProviderData).DefaultDomain
+}
+
+func (r *VPNS2SIKESettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	FMCModifyPlanDomain(ctx, r.client, r.defaultDomain, req, resp)
 }
 
 // End of section. //template:end model
@@ -202,10 +239,19 @@ func (r *VPNS2SIKESettingsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config VPNS2SIKESettings
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Ikev1ManualPreSharedKeyWo = config.Ikev1ManualPreSharedKeyWo
+	plan.Ikev2ManualPreSharedKeyWo = config.Ikev2ManualPreSharedKeyWo
+
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 	//// ID needs to be retrieved from FMC, however we are expecting exactly one object
 	// Get objects from FMC
@@ -266,7 +312,7 @@ func (r *VPNS2SIKESettingsResource) Read(ctx context.Context, req resource.ReadR
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
@@ -315,6 +361,15 @@ func (r *VPNS2SIKESettingsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	// Write-only attributes are never part of the plan, read them from the configuration
+	var config VPNS2SIKESettings
+	diags = req.Config.Get(ctx, &config)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Ikev1ManualPreSharedKeyWo = config.Ikev1ManualPreSharedKeyWo
+	plan.Ikev2ManualPreSharedKeyWo = config.Ikev2ManualPreSharedKeyWo
+
 	// Read state
 	diags = req.State.Get(ctx, &state)
 	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
@@ -324,7 +379,7 @@ func (r *VPNS2SIKESettingsResource) Update(ctx context.Context, req resource.Upd
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !plan.Domain.IsNull() && plan.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(plan.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, plan.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
@@ -358,7 +413,7 @@ func (r *VPNS2SIKESettingsResource) Delete(ctx context.Context, req resource.Del
 	// Set request domain if provided
 	reqMods := [](func(*fmc.Req)){}
 	if !state.Domain.IsNull() && state.Domain.ValueString() != "" {
-		reqMods = append(reqMods, fmc.DomainName(state.Domain.ValueString()))
+		reqMods = append(reqMods, FMCDomainName(r.client, state.Domain.ValueString()))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
@@ -378,11 +433,15 @@ func (r *VPNS2SIKESettingsResource) Delete(ctx context.Context, req resource.Del
 
 // Section below is generated&owned by "gen/generator.go". //template:begin import
 func (r *VPNS2SIKESettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the provider default domain, unless the import ID provides one
+	if r.defaultDomain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), r.defaultDomain)...)
+	}
 	// Parse import ID
 	var inputPattern = regexp.MustCompile(`^(?:(?P<domain>[^\s,]+),)?(?P<vpn_s2s_id>[^\s,]+),(?P<id>[^\s,]+?)$`)
 	match := inputPattern.FindStringSubmatch(req.ID)
 	if match == nil {
-		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<vpn_s2s_id>,<id>\n<domain> is optional. If not provided, `Global` is used implicitly and resource's `domain` attribute is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
+		errMsg := "Failed to parse import parameters.\nPlease provide import string in the following format: <domain>,<vpn_s2s_id>,<id>\n<domain> is optional. If not provided, the provider `domain` is used, or `Global` if it is not set.\n" + fmt.Sprintf("Got: %q", req.ID)
 		resp.Diagnostics.AddError("Import error", errMsg)
 		return
 	}
@@ -30,12 +30,6 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVPNS2SIKESettings(t *testing.T) {
-	var checks []resource.TestCheckFunc
-	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_s2s_ike_settings.test", "type"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ike_settings.test", "ikev1_authentication_type", "MANUAL_PRE_SHARED_KEY"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ike_settings.test", "ikev2_authentication_type", "MANUAL_PRE_SHARED_KEY"))
-	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ike_settings.test", "ikev2_enforce_hex_based_pre_shared_key", "false"))
-
 	var steps []resource.TestStep
 	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
 		steps = append(steps, resource.TestStep{
@@ -44,7 +38,7 @@ func TestAccFmcVPNS2SIKESettings(t *testing.T) {
 	}
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcVPNS2SIKESettingsPrerequisitesConfig + testAccFmcVPNS2SIKESettingsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVPNS2SIKESettingsChecks()...),
 	})
 
 	resource.Test(t, resource.TestCase{
@@ -55,8 +49,27 @@ func TestAccFmcVPNS2SIKESettings(t *testing.T) {
 	})
 }
 
+// testAccFmcVPNS2SIKESettingsChecks returns the checks of the configuration with all attributes
+func testAccFmcVPNS2SIKESettingsChecks() []resource.TestCheckFunc {
+	var checks []resource.TestCheckFunc
+	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_s2s_ike_settings.test", "type"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ike_settings.test", "ikev1_authentication_type", "MANUAL_PRE_SHARED_KEY"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ike_settings.test", "ikev2_authentication_type", "MANUAL_PRE_SHARED_KEY"))
+	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ike_settings.test", "ikev2_enforce_hex_based_pre_shared_key", "false"))
+
+	return checks
+}
+
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVPNS2SIKESettings(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcVPNS2SIKESettingsPrerequisitesConfig = `
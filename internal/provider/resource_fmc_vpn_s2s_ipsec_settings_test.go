@@ -30,6 +30,27 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVPNS2SIPSECSettings(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcVPNS2SIPSECSettingsPrerequisitesConfig + testAccFmcVPNS2SIPSECSettingsConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVPNS2SIPSECSettingsPrerequisitesConfig + testAccFmcVPNS2SIPSECSettingsConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVPNS2SIPSECSettingsChecks()...),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcVPNS2SIPSECSettingsChecks returns the checks of the configuration with all attributes
+func testAccFmcVPNS2SIPSECSettingsChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_s2s_ipsec_settings.test", "type"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ipsec_settings.test", "crypto_map_type", "STATIC"))
@@ -47,27 +68,19 @@ func TestAccFmcVPNS2SIPSECSettings(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ipsec_settings.test", "tfc_payload_bytes", "0"))
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s_ipsec_settings.test", "tfc_timeout", "0"))
 
-	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcVPNS2SIPSECSettingsPrerequisitesConfig + testAccFmcVPNS2SIPSECSettingsConfig_minimum(),
-		})
-	}
-	steps = append(steps, resource.TestStep{
-		Config: testAccFmcVPNS2SIPSECSettingsPrerequisitesConfig + testAccFmcVPNS2SIPSECSettingsConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
-	})
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
-		Steps:                    steps,
-	})
+	return checks
 }
 
 // End of section. //template:end testAcc
 
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVPNS2SIPSECSettings(t *testing.T) {
+	t.Skip("skipping unit test, the test configuration needs prerequisite objects (test_prerequisites)")
+}
+
+// End of section. //template:end testUnit
+
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 
 const testAccFmcVPNS2SIPSECSettingsPrerequisitesConfig = `
@@ -30,6 +30,31 @@ import (
 // Section below is generated&owned by "gen/generator.go". //template:begin testAcc
 
 func TestAccFmcVPNS2S(t *testing.T) {
+	var steps []resource.TestStep
+	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
+		steps = append(steps, resource.TestStep{
+			Config: testAccFmcVPNS2SConfig_minimum(),
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVPNS2SConfig_all(),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVPNS2SChecks()...),
+	})
+	steps = append(steps, resource.TestStep{
+		ResourceName: "fmc_vpn_s2s.test",
+		ImportState:  true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
+		Steps:                    steps,
+	})
+}
+
+// testAccFmcVPNS2SChecks returns the checks of the configuration with all attributes
+func testAccFmcVPNS2SChecks() []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 	checks = append(checks, resource.TestCheckResourceAttr("fmc_vpn_s2s.test", "name", "my_ftd_s2s_vpn"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_s2s.test", "type"))
@@ -41,30 +66,38 @@ func TestAccFmcVPNS2S(t *testing.T) {
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_s2s.test", "ike_policy_id"))
 	checks = append(checks, resource.TestCheckResourceAttrSet("fmc_vpn_s2s.test", "advanced_settings_policy_id"))
 
+	return checks
+}
+
+// End of section. //template:end testAcc
+
+// Section below is generated&owned by "gen/generator.go". //template:begin testUnit
+
+func TestUnitFmcVPNS2S(t *testing.T) {
+	newMockFMC(t).setupProviderEnv(t)
+
 	var steps []resource.TestStep
-	if os.Getenv("SKIP_MINIMUM_TEST") == "" {
-		steps = append(steps, resource.TestStep{
-			Config: testAccFmcVPNS2SConfig_minimum(),
-		})
-	}
+	steps = append(steps, resource.TestStep{
+		Config: testAccFmcVPNS2SConfig_minimum(),
+	})
 	steps = append(steps, resource.TestStep{
 		Config: testAccFmcVPNS2SConfig_all(),
-		Check:  resource.ComposeTestCheckFunc(checks...),
+		Check:  resource.ComposeTestCheckFunc(testAccFmcVPNS2SChecks()...),
 	})
 	steps = append(steps, resource.TestStep{
-		ResourceName: "fmc_vpn_s2s.test",
-		ImportState:  true,
+		ResourceName:      "fmc_vpn_s2s.test",
+		ImportState:       true,
+		ImportStateVerify: true,
 	})
 
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		ErrorCheck:               func(err error) error { return testAccErrorCheck(t, err) },
 		Steps:                    steps,
 	})
 }
 
-// End of section. //template:end testAcc
+// End of section. //template:end testUnit
 
 // Section below is generated&owned by "gen/generator.go". //template:begin testPrerequisites
 // End of section. //template:end testPrerequisites
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"net/url"
 	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -29,9 +31,12 @@ import (
 	"github.com/CiscoDevNet/terraform-provider-fmc/internal/provider/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/go-fmc"
 	"github.com/tidwall/gjson"
@@ -41,10 +46,40 @@ import (
 // Mutex to protect deployments
 var deploymentMu sync.Mutex
 
+// Polling intervals used while waiting for FMC jobs and deployments. The interval starts at the minimum
+// and doubles after every poll until it reaches the maximum.
+const (
+	jobPollMinInterval time.Duration = 2 * time.Second
+	jobPollMaxInterval time.Duration = 30 * time.Second
+	// Used if the context has no deadline (e.g. the resource does not support timeouts)
+	jobDefaultMaxWait time.Duration = 15 * time.Minute
+)
+
+// jobPoller waits between polls with exponential backoff, honouring the context deadline.
+type jobPoller struct {
+	interval time.Duration
+}
+
+// wait blocks for the current interval and increases it. An error is returned if the context is done.
+func (p *jobPoller) wait(ctx context.Context) error {
+	if p.interval == 0 {
+		p.interval = jobPollMinInterval
+	}
+	err := helpers.SleepWithContext(ctx, p.interval)
+	p.interval = min(p.interval*2, jobPollMaxInterval)
+	return err
+}
+
+// withDefaultDeadline returns ctx with the default maximum wait time applied, unless ctx already has a deadline.
+func withDefaultDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, jobDefaultMaxWait)
+}
+
 func FMCWaitForJobToFinish(ctx context.Context, client *fmc.Client, jobId string, reqMods [](func(*fmc.Req))) diag.Diagnostics {
 	var diags diag.Diagnostics
-	const atom time.Duration = 10 * time.Second
-	const maxWait time.Duration = 15 * time.Minute
 	var task gjson.Result
 	var err error
 
@@ -53,48 +88,57 @@ func FMCWaitForJobToFinish(ctx context.Context, client *fmc.Client, jobId string
 		return diags
 	}
 
+	ctx, cancel := withDefaultDeadline(ctx)
+	defer cancel()
+
 	var taskUrl string = "/api/fmc_config/v1/domain/{DOMAIN_UUID}/job/taskstatuses/" + url.QueryEscape(jobId)
+	var poller jobPoller
+	started := false
 
-	for i := time.Duration(0); i < maxWait; i += atom {
+	for {
 		task, err = client.Get(taskUrl, reqMods...)
+		if err != nil && started {
+			diags.AddError("Client Error", fmt.Sprintf("Failed to get task (id %s) status, got error: %s, %s", jobId, err, task.String()))
+			return diags
+		}
 		if err == nil {
+			started = true
+			stat := strings.ToUpper(task.Get("status").String())
+			if stat == "FAILED" {
+				diags.AddError("Client Error", fmt.Sprintf("Task failed with: %s, %s", task.Get("message"), task.Get("description")))
+				return diags
+			}
+			if stat != "PENDING" && stat != "RUNNING" && stat != "IN_PROGRESS" && stat != "DEPLOYING" && stat != "UNKNOWN" {
+				return diags
+			}
+			tflog.Debug(ctx, fmt.Sprintf("Task %s is in %s state. Waiting %s.", jobId, stat, poller.interval))
+		}
+
+		if poller.wait(ctx) != nil {
 			break
 		}
 	}
 
-	if err != nil {
-		diags.AddError("Client Error", fmt.Sprintf("Failed to get task (id %s) status after %v minutes. Seems that the task did not start, got error: %s, %s", jobId, maxWait.Minutes(), err, task.String()))
+	if !started {
+		diags.AddError("Timeout Error", fmt.Sprintf("Failed to get task (id %s) status before the timeout. Seems that the task did not start, got error: %s, %s", jobId, err, task.String()))
 		return diags
 	}
-
-	for i := time.Duration(0); i < maxWait; i += atom {
-		task, err = client.Get(taskUrl, reqMods...)
-		if err != nil {
-			diags.AddError("Client Error", fmt.Sprintf("Failed to get task (id %s) status, got error: %s, %s", jobId, err, task.String()))
-			return diags
-		}
-		stat := strings.ToUpper(task.Get("status").String())
-		if stat == "FAILED" {
-			diags.AddError("Client Error", fmt.Sprintf("Task failed with: %s, %s", task.Get("message"), task.Get("description")))
-			return diags
-		}
-		if stat != "PENDING" && stat != "RUNNING" && stat != "IN_PROGRESS" && stat != "DEPLOYING" && stat != "UNKNOWN" {
-			return diags
-		}
-		time.Sleep(atom)
-	}
-	diags.AddError("Timeout Error", fmt.Sprintf("Task %s did not complete within the expected time", jobId))
+	diags.AddError("Timeout Error", fmt.Sprintf("Task %s did not complete within the expected time (last status: %s)", jobId, task.Get("status").String()))
 	return diags
 }
 
 func FMCWaitForDeploymentToFinish(ctx context.Context, client *fmc.Client, deviceIds []string, reqMods [](func(*fmc.Req))) diag.Diagnostics {
 	var diags diag.Diagnostics
-	const atom time.Duration = 5 * time.Second
 	var devicesUnderDeploymentIds []string
 	query := "items.#.deviceList.#.deviceUUID"
 
+	ctx, cancel := withDefaultDeadline(ctx)
+	defer cancel()
+
+	var poller jobPoller
+
 Outerloop:
-	for i := time.Duration(0); i < 15*time.Minute; i += atom {
+	for {
 		underDeployment, err := client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}/deployment/jobhistories?filter=jobType:DEPLOYMENT;status:DEPLOYING&expanded=true", reqMods...)
 		if err != nil {
 			diags.AddError("Client Error", fmt.Sprintf("Failed to read object (GET), got error: %s, %s", err, underDeployment.String()))
@@ -120,7 +164,10 @@ Outerloop:
 		for _, deviceId := range deviceIds {
 			if slices.Contains(devicesUnderDeploymentIds, deviceId) {
 				tflog.Debug(ctx, fmt.Sprintf("Device %s is still under deployment. Waiting.", deviceId))
-				time.Sleep(atom)
+				if poller.wait(ctx) != nil {
+					diags.AddError("Timeout Error", fmt.Sprintf("Device %s is still under deployment after the timeout", deviceId))
+					return diags
+				}
 				continue Outerloop
 			}
 		}
@@ -128,7 +175,6 @@ Outerloop:
 		// If none of the devices from provided list is under deployment, exit
 		return diags
 	}
-	return diags
 }
 
 // FMCDeviceDeploy is a wrapper function that retries the deployment if requested by API response
@@ -150,7 +196,9 @@ func FMCDeviceDeploy(ctx context.Context, client *fmc.Client, plan DeviceDeploy,
 
 		tflog.Debug(ctx, fmt.Sprintf("%s: retrying deployment (attempt %d): %s", plan.Id.ValueString(), i, errorList.String()))
 		errorList.Reset()
-		time.Sleep(5 * time.Second)
+		if err := helpers.SleepWithContext(ctx, 5*time.Second); err != nil {
+			break
+		}
 	}
 
 	return diags
@@ -224,8 +272,12 @@ func fmcDeviceDeploy(ctx context.Context, client *fmc.Client, plan DeviceDeploy,
 		}
 
 		// Give time for the deployment to settle in and unlock the mutex
-		time.Sleep(15 * time.Second)
+		err = helpers.SleepWithContext(ctx, 15*time.Second)
 		deploymentMu.Unlock()
+		if err != nil {
+			diags.AddError("Timeout Error", fmt.Sprintf("Deployment did not complete before the timeout: %s", err))
+			return diags
+		}
 
 		if res.Get("metadata.task.id").Exists() {
 			taskID := res.Get("metadata.task.id").String()
@@ -247,6 +299,46 @@ func fmcDeviceDeploy(ctx context.Context, client *fmc.Client, plan DeviceDeploy,
 	return diags
 }
 
+// Locks of device group records, keyed by device group ID. FMC replaces the whole member list on PUT,
+// so concurrent membership changes of the same group would otherwise overwrite each other.
+var deviceGroupMu helpers.KeyedMutex
+
+// FMCModifyDeviceGroup reads the device group, applies modify to the request body and writes it back,
+// while holding the lock of the device group. The request body passed to modify contains id, type, name and members.
+func FMCModifyDeviceGroup(ctx context.Context, client *fmc.Client, deviceGroupId string, modify func(request string) string, reqMods [](func(*fmc.Req))) diag.Diagnostics {
+	deviceGroupMu.Lock(deviceGroupId)
+	defer deviceGroupMu.Unlock(deviceGroupId)
+
+	urlPath := "/api/fmc_config/v1/domain/{DOMAIN_UUID}/devicegroups/devicegrouprecords/" + url.QueryEscape(deviceGroupId)
+	res, err := client.Get(urlPath, reqMods...)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to get device group (GET), got error: %s, %s", err, res.String()))}
+	}
+
+	// Device Group endpoint returns 'metadata' twice in the response, which breaks sjson.Delete. Hence we copy needed fields to a new JSON.
+	var request = ""
+	resString := res.String()
+	request, _ = sjson.Set(request, "id", gjson.Get(resString, "id").String())
+	request, _ = sjson.Set(request, "type", gjson.Get(resString, "type").String())
+	request, _ = sjson.Set(request, "name", gjson.Get(resString, "name").String())
+	if members := gjson.Get(resString, "members"); members.Exists() {
+		request, _ = sjson.SetRaw(request, "members", members.Raw)
+	} else {
+		request, _ = sjson.SetRaw(request, "members", "[]")
+	}
+
+	request = modify(request)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Updating device group", deviceGroupId))
+
+	res, err = client.Put(urlPath, request, reqMods...)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to update device group (PUT), got error: %s, %s", err, res.String()))}
+	}
+
+	return nil
+}
+
 func FMCupdateDeviceGroup(ctx context.Context, client *fmc.Client, device basetypes.StringValue, plan tfsdk.Plan, state tfsdk.State, reqMods [](func(*fmc.Req))) diag.Diagnostics {
 	deviceId := device.ValueString()
 
@@ -264,65 +356,35 @@ func FMCupdateDeviceGroup(ctx context.Context, client *fmc.Client, device basety
 	// When device group changes, we need to remove the device from the current group and add it to the new one
 
 	if !stateDeviceGroup.IsNull() {
-		// Get Device Group to which device currently belongs
-		res, err := client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}/devicegroups/devicegrouprecords/"+url.QueryEscape(stateDeviceGroup.ValueString()), reqMods...)
-		if err != nil {
-			return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to get current device group (GET), got error: %s, %s", err, res.String()))}
-		}
-
 		// Remove device from current device group
-		// Device Group endpoint returns 'metadata' twice in the response, which breaks sjson.Delete. Hence we copy needed fields to a new JSON.
-		var request = ""
-		resString := res.String()
-		request, _ = sjson.Set(request, "id", gjson.Get(resString, "id").String())
-		request, _ = sjson.Set(request, "type", gjson.Get(resString, "type").String())
-		request, _ = sjson.Set(request, "name", gjson.Get(resString, "name").String())
-
-		// Get all members
-		members := gjson.Get(resString, "members").Array()
-		filteredMembers := []string{}
-
-		// Filter out the device to be removed
-		for _, member := range members {
-			if member.Get("id").String() != deviceId {
-				filteredMembers = append(filteredMembers, member.Raw)
+		diags := FMCModifyDeviceGroup(ctx, client, stateDeviceGroup.ValueString(), func(request string) string {
+			filteredMembers := []string{}
+			for _, member := range gjson.Get(request, "members").Array() {
+				if member.Get("id").String() != deviceId {
+					filteredMembers = append(filteredMembers, member.Raw)
+				}
 			}
-		}
-
-		// Update request
-		request, _ = sjson.SetRaw(request, "members", fmt.Sprintf("[%s]", strings.Join(filteredMembers, ",")))
-
-		// Make the PUT request
-		res, err = client.Put("/api/fmc_config/v1/domain/{DOMAIN_UUID}/devicegroups/devicegrouprecords/"+url.QueryEscape(stateDeviceGroup.ValueString()), request, reqMods...)
-		if err != nil {
-			return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to remove (PUT) device from current device group, got error: %s, %s", err, res.String()))}
+			request, _ = sjson.SetRaw(request, "members", fmt.Sprintf("[%s]", strings.Join(filteredMembers, ",")))
+			return request
+		}, reqMods)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
 	if !planDeviceGroup.IsNull() {
-		// Get Device Group to which device needs to be assigned
-		res, err := client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}/devicegroups/devicegrouprecords/"+url.QueryEscape(planDeviceGroup.ValueString()), reqMods...)
-		if err != nil {
-			return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to get destination device group (GET), got error: %s, %s", err, res.String()))}
-		}
-
 		// Put device to new device group
-		// Device Group endpoint returns 'metadata' twice in the response, which breaks sjson.Delete. Hence we copy needed fields to a new JSON.
-		var request = ""
-		resString := res.String()
-		request, _ = sjson.Set(request, "id", gjson.Get(resString, "id").String())
-		request, _ = sjson.Set(request, "type", gjson.Get(resString, "type").String())
-		request, _ = sjson.Set(request, "name", gjson.Get(resString, "name").String())
-		members := gjson.Get(resString, "members")
-		if members.Exists() {
-			request, _ = sjson.SetRaw(request, "members", members.Raw)
-		} else {
-			request, _ = sjson.SetRaw(request, "members", "[]")
-		}
-		request, _ = sjson.SetRaw(request, "members.-1", fmt.Sprintf(`{"id":"%s"}`, deviceId))
-		res, err = client.Put("/api/fmc_config/v1/domain/{DOMAIN_UUID}/devicegroups/devicegrouprecords/"+url.QueryEscape(planDeviceGroup.ValueString()), request, reqMods...)
-		if err != nil {
-			return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to add (PUT) device to new device group, got error: %s, %s", err, res.String()))}
+		diags := FMCModifyDeviceGroup(ctx, client, planDeviceGroup.ValueString(), func(request string) string {
+			for _, member := range gjson.Get(request, "members").Array() {
+				if member.Get("id").String() == deviceId {
+					return request
+				}
+			}
+			request, _ = sjson.SetRaw(request, "members.-1", fmt.Sprintf(`{"id":"%s"}`, deviceId))
+			return request
+		}, reqMods)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
@@ -344,3 +406,649 @@ func FMCIsDeviceMultiInstance(ctx context.Context, client *fmc.Client, deviceId
 	}
 	return false, diags
 }
+
+// FMCModifyPlanDomain sets the planned `domain` to the effective domain of the resource, which is the configured
+// domain or else the provider default domain. Replacement is only required if the effective domain changes, so that
+// moving `domain` between the resource and the provider configuration does not recreate objects.
+func FMCModifyPlanDomain(ctx context.Context, client *fmc.Client, defaultDomain string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configDomain, stateDomain types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domain"), &configDomain)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("domain"), &stateDomain)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planDomain := configDomain
+	if planDomain.IsNull() && defaultDomain != "" {
+		planDomain = types.StringValue(defaultDomain)
+	}
+
+	if !req.State.Raw.IsNull() {
+		if planDomain.IsUnknown() || fmcDomainUUID(client, stateDomain) != fmcDomainUUID(client, planDomain) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("domain"))
+		} else if configDomain.IsNull() {
+			// Same domain, possibly under a different name (e.g. unset vs. `Global`), keep the state as is
+			planDomain = stateDomain
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Planned domain: %s", planDomain.String()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("domain"), planDomain)...)
+}
+
+// fmcDomainUUID returns the UUID of the domain, or the domain name itself if the UUID is not known.
+// Empty domain resolves to the login domain of the user.
+func fmcDomainUUID(client *fmc.Client, domain types.String) string {
+	if client == nil {
+		return domain.ValueString()
+	}
+	if domain.ValueString() == "" {
+		return client.DomainUUID
+	}
+	if uuid, ok := fmcDomains(client)[domain.ValueString()]; ok {
+		return uuid
+	}
+	return domain.ValueString()
+}
+
+// Domains reloaded by FMCRefreshDomains, keyed by client. The Domains map of the client is read by go-fmc without
+// synchronization whenever a request is created, so it is never modified after login. Values are never modified
+// either, refreshes store a new map.
+var fmcRefreshedDomains sync.Map
+
+// fmcDomains returns the domains known to the client, mapping fully qualified domain names to UUIDs.
+func fmcDomains(client *fmc.Client) map[string]string {
+	if domains, ok := fmcRefreshedDomains.Load(client); ok {
+		return domains.(map[string]string)
+	}
+	return client.Domains
+}
+
+// FMCDomainName returns a request modifier selecting the domain by name, like fmc.DomainName. Unlike fmc.DomainName,
+// it also resolves domains created or renamed during the apply.
+func FMCDomainName(client *fmc.Client, name string) func(*fmc.Req) {
+	uuid, ok := fmcDomains(client)[name]
+	if !ok {
+		// Let the client report the unknown domain
+		return fmc.DomainName(name)
+	}
+	return func(req *fmc.Req) {
+		req.DomainName = ""
+		req.HttpReq.URL.Path = strings.ReplaceAll(req.HttpReq.URL.Path, "{DOMAIN_UUID}", uuid)
+	}
+}
+
+// FMCRefreshDomains reloads the domains known to the client, so that domains created or deleted during the apply
+// can be referenced by name in the `domain` attribute of other resources (see FMCDomainName).
+func FMCRefreshDomains(ctx context.Context, client *fmc.Client) diag.Diagnostics {
+	// FMCBUG: Before FMC 10.0.0 pagination in not handled correctly, so we need to make it manually
+	limit := 40
+	domains := make(map[string]string)
+	for offset := 0; ; offset += limit {
+		res, err := client.Get(fmt.Sprintf("/api/fmc_platform/v1/info/domain?expanded=true&offset=%d&limit=%d", offset, limit))
+		if err != nil {
+			return diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to retrieve domains, got error: %s, %s", err, res.String()))}
+		}
+		res.Get("items").ForEach(func(_, v gjson.Result) bool {
+			domains[v.Get("name").String()] = v.Get("uuid").String()
+			return true
+		})
+		if !res.Get("paging.next.0").Exists() {
+			break
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Refreshed domains: %v", domains))
+
+	fmcRefreshedDomains.Store(client, domains)
+	return nil
+}
+
+// FMCDomainFullName returns the fully qualified name of the domain with the given ID, e.g. `Global/EMEA`.
+// Domains are refreshed if the ID is not known to the client yet. Empty string is returned if the domain does not exist.
+func FMCDomainFullName(ctx context.Context, client *fmc.Client, id string) (string, diag.Diagnostics) {
+	for refreshed := false; ; refreshed = true {
+		for name, uuid := range fmcDomains(client) {
+			if strings.EqualFold(uuid, id) {
+				return name, nil
+			}
+		}
+		if refreshed {
+			return "", nil
+		}
+		if diags := FMCRefreshDomains(ctx, client); diags.HasError() {
+			return "", diags
+		}
+	}
+}
+
+// fmcObjectReference is an object referencing (using) another object, as returned by FMCObjectUsage. Parent is set
+// for objects that are part of another object, e.g. for a rule it is the policy of the rule.
+type fmcObjectReference struct {
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	ParentId   string `json:"parentId,omitempty"`
+	ParentName string `json:"parentName,omitempty"`
+	ParentType string `json:"parentType,omitempty"`
+}
+
+// FMCObjectUsage returns objects referencing the object with the given ID and type (e.g. `Host`), using the
+// object usage (where used) endpoint.
+func FMCObjectUsage(ctx context.Context, client *fmc.Client, id, objectType string, reqMods [](func(*fmc.Req))) ([]fmcObjectReference, diag.Diagnostics) {
+	filter := url.QueryEscape(fmt.Sprintf("uuid:%s;type:%s", id, objectType))
+	res, err := client.Get("/api/fmc_config/v1/domain/{DOMAIN_UUID}/object/operational/usage?expanded=true&filter="+filter, reqMods...)
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to retrieve usage of object %s, got error: %s, %s", id, err, res.String()))}
+	}
+
+	references := []fmcObjectReference{}
+	parents := map[string]gjson.Result{}
+	for _, item := range res.Get("items").Array() {
+		reference := fmcObjectReference{
+			Id:   item.Get("id").String(),
+			Name: item.Get("name").String(),
+			Type: item.Get("type").String(),
+		}
+		if parentPath := fmcParentPath(item.Get("links.self").String()); parentPath != "" {
+			parent, ok := parents[parentPath]
+			if !ok {
+				parent, err = client.Get(parentPath, reqMods...)
+				if err != nil {
+					return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to retrieve parent of object %s, got error: %s, %s", reference.Id, err, parent.String()))}
+				}
+				parents[parentPath] = parent
+			}
+			reference.ParentId = parent.Get("id").String()
+			reference.ParentName = parent.Get("name").String()
+			reference.ParentType = parent.Get("type").String()
+		}
+		references = append(references, reference)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Object %s is referenced by %d objects", id, len(references)))
+	return references, nil
+}
+
+// fmcParentPath returns the API path of the parent of an object, given the link to the object. Objects directly
+// under a collection (e.g. `object/hosts/<id>`) have no parent, while e.g. `policy/accesspolicies/<id>/accessrules/<id>`
+// has the policy as parent.
+func fmcParentPath(link string) string {
+	i := strings.Index(link, "/api/")
+	if i < 0 {
+		return ""
+	}
+	segments := strings.Split(strings.Trim(link[i:], "/"), "/")
+	// api, fmc_config, v1, domain, <domain uuid>, <category>, <collection>, <id>, <subcollection>, <id>
+	if len(segments) < 10 || segments[3] != "domain" {
+		return ""
+	}
+	return "/" + strings.Join(segments[:8], "/")
+}
+
+// fmcObjectUsageMaxLookups limits the number of objects, whose references are looked up after a failed delete.
+const fmcObjectUsageMaxLookups = 10
+
+// fmcObjectUsageQuery identifies an object, whose references should be looked up. Name is used in diagnostics.
+type fmcObjectUsageQuery struct {
+	Name string
+	Id   string
+	Type string
+}
+
+// FMCObjectsInUseDiagnostics looks up references of objects, that failed to be deleted, and returns an error
+// diagnostic listing them, so that it is clear which policies, rules or groups block the delete. Lookup failures are
+// ignored, as the delete error itself is reported separately.
+func FMCObjectsInUseDiagnostics(ctx context.Context, client *fmc.Client, objects []fmcObjectUsageQuery, reqMods [](func(*fmc.Req))) diag.Diagnostics {
+	var details []string
+	var skipped int
+	for i, object := range objects {
+		if i == fmcObjectUsageMaxLookups {
+			skipped = len(objects) - i
+			tflog.Debug(ctx, fmt.Sprintf("Usage of %d more objects not looked up", skipped))
+			break
+		}
+		if object.Id == "" || object.Type == "" {
+			continue
+		}
+		references, diags := FMCObjectUsage(ctx, client, object.Id, object.Type, reqMods)
+		if diags.HasError() || len(references) == 0 {
+			continue
+		}
+		var used []string
+		for _, r := range references {
+			s := fmt.Sprintf("%s %q", r.Type, r.Name)
+			if r.ParentId != "" {
+				s += fmt.Sprintf(" in %s %q", r.ParentType, r.ParentName)
+			}
+			used = append(used, s)
+		}
+		details = append(details, fmt.Sprintf("- %s (%s): %s", object.Name, object.Id, strings.Join(used, ", ")))
+	}
+	if skipped > 0 {
+		details = append(details, fmt.Sprintf("References of %d more objects, that failed to be deleted, were not looked up.", skipped))
+	} else if len(details) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{diag.NewErrorDiagnostic("Objects in use",
+		"The following objects are referenced by other objects and cannot be deleted, until the references are removed:\n"+strings.Join(details, "\n"))}
+}
+
+// fmcFailedObjects returns the objects of a failed bulk delete, whose ID or name is mentioned in the error
+// messages of the FMC response. It returns nil if the response does not mention any of them.
+func fmcFailedObjects(res gjson.Result, objects []fmcObjectUsageQuery) []fmcObjectUsageQuery {
+	var messages []string
+	for _, m := range res.Get("error.messages.#.description").Array() {
+		messages = append(messages, strings.ToLower(m.String()))
+	}
+	message := strings.Join(messages, "\n")
+	if message == "" {
+		return nil
+	}
+
+	var failed []fmcObjectUsageQuery
+	for _, object := range objects {
+		if object.Id != "" && strings.Contains(message, strings.ToLower(object.Id)) ||
+			object.Name != "" && strings.Contains(message, strings.ToLower(strconv.Quote(object.Name))) {
+			failed = append(failed, object)
+		}
+	}
+	return failed
+}
+
+// fmcDeleteObjectsOneByOne deletes the objects of a failed bulk delete one by one, so that the objects that block
+// the delete are known. It returns the names of deleted objects and the objects that failed to be deleted.
+// Objects that no longer exist are treated as deleted.
+func fmcDeleteObjectsOneByOne(ctx context.Context, client *fmc.Client, path string, objects []fmcObjectUsageQuery, reqMods [](func(*fmc.Req))) ([]string, []fmcObjectUsageQuery) {
+	var deleted []string
+	var failed []fmcObjectUsageQuery
+	for _, object := range objects {
+		_, err := client.Delete(path+"/"+url.QueryEscape(object.Id), reqMods...)
+		if err != nil && !strings.Contains(err.Error(), "StatusCode 404") {
+			tflog.Debug(ctx, fmt.Sprintf("Failed to delete object %s (%s): %s", object.Name, object.Id, err))
+			failed = append(failed, object)
+			continue
+		}
+		deleted = append(deleted, object.Name)
+	}
+	return deleted, failed
+}
+
+// keptRules matches rules in plan to rules in state by name. For every rule in plan it returns the index
+// of the state rule that is kept (and updated in place if needed), or -1 if the rule has to be created.
+// Kept rules are the longest subsequence of state that keeps its relative order in plan, so none of them need
+// to be moved and the number of rules that are deleted and re-created is minimal.
+func keptRules[T any](state, plan []T, name func(T) string) []int {
+	stateIndex := make(map[string]int, len(state))
+	for i, v := range state {
+		stateIndex[name(v)] = i
+	}
+
+	// Longest increasing subsequence of state indexes, in plan order.
+	// tails[k] is the plan index that ends the best subsequence of length k+1, prev links the subsequences.
+	match := make([]int, len(plan))
+	prev := make([]int, len(plan))
+	var tails []int
+	for i, v := range plan {
+		match[i], prev[i] = -1, -1
+		s, ok := stateIndex[name(v)]
+		if !ok {
+			continue
+		}
+		match[i] = s
+		k := sort.Search(len(tails), func(k int) bool { return match[tails[k]] >= s })
+		if k > 0 {
+			prev[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	kept := make([]int, len(plan))
+	for i := range kept {
+		kept[i] = -1
+	}
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			kept[i] = match[i]
+		}
+	}
+	return kept
+}
+
+// readRulesByName reads all rules at path (e.g. rules of a policy) in their order and returns the ones with names of
+// items, as `items` of the result. It is used by bulk rule resources, that do not own all rules of the policy.
+func readRulesByName[T any](client *fmc.Client, path string, items []T, name func(T) string, reqMods ...func(*fmc.Req)) (gjson.Result, error) {
+	names := make(map[string]bool, len(items))
+	for _, v := range items {
+		names[name(v)] = true
+	}
+
+	body := `{"items":[]}`
+	for offset, limit := 0, 1000; ; offset += limit {
+		res, err := client.Get(path+fmt.Sprintf("?expanded=true&limit=%d&offset=%d", limit, offset), reqMods...)
+		if err != nil {
+			return res, fmt.Errorf("%w, %s", err, res.String())
+		}
+		for _, v := range res.Get("items").Array() {
+			if names[v.Get("name").String()] {
+				body, _ = sjson.SetRaw(body, "items.-1", v.Raw)
+			}
+		}
+		if !res.Get("paging.next.0").Exists() {
+			break
+		}
+	}
+	return gjson.Parse(body), nil
+}
+
+// deleteRulesById deletes rules at path (e.g. rules of a policy) with bulk requests.
+func deleteRulesById(client *fmc.Client, path string, ids []string, reqMods ...func(*fmc.Req)) error {
+	var b strings.Builder
+	var bulks []string
+
+	for _, id := range ids {
+		if b.Len() != 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(id)
+		if b.Len() >= maxUrlParamLength {
+			bulks = append(bulks, b.String())
+			b.Reset()
+		}
+	}
+	if b.Len() > 0 {
+		bulks = append(bulks, b.String())
+	}
+
+	for _, bulk := range bulks {
+		res, err := client.Delete(path+"?bulk=true&filter=ids:"+url.QueryEscape(bulk), reqMods...)
+		if err != nil {
+			return fmt.Errorf("failed to bulk-delete rules, got error: %v, %s", err, res.String())
+		}
+	}
+	return nil
+}
+
+// bulkRules describes the items of a bulk rule resource (e.g. fmc_access_rules) to updateBulkRules and
+// createBulkRules.
+type bulkRules[T any] struct {
+	client *fmc.Client
+	// path of the rules, e.g. rules of a policy
+	path string
+	// urlParams are added to bulk POST requests, e.g. category of access rules
+	urlParams string
+	name      func(T) string
+	id        func(T) types.String
+	setId     func(*T, types.String)
+	// toBody returns the body of items, as `items` of the result
+	toBody func([]T) string
+	// fromBodyUnknowns sets unknown values of items (e.g. IDs) from the bulk POST response
+	fromBodyUnknowns func([]T, gjson.Result)
+	// deleteRules deletes rules by ID. If nil, deleteRulesById is used.
+	deleteRules func(ids []string, reqMods ...func(*fmc.Req)) error
+}
+
+// updateBulkRules applies the difference between state and plan: rules no longer present or out of order are deleted,
+// kept rules are updated in place if they have changed and new rules are inserted next to the kept ones.
+// IDs of the rules are set in plan. It returns the rules that exist on FMC in their order, which on error can be
+// used to save the partial progress. The caller is expected to hold the create mutex of the resource.
+func updateBulkRules[T any](b bulkRules[T], state, plan []T, reqMods ...func(*fmc.Req)) ([]T, diag.Diagnostics) {
+	kept := keptRules(state, plan, b.name)
+
+	// Delete rules that are no longer present or that are out of order
+	isKept := make([]bool, len(state))
+	for _, s := range kept {
+		if s >= 0 {
+			isKept[s] = true
+		}
+	}
+	var ids []string
+	for i, v := range state {
+		if !isKept[i] {
+			ids = append(ids, b.id(v).ValueString())
+		}
+	}
+	deleteRules := b.deleteRules
+	if deleteRules == nil {
+		deleteRules = func(ids []string, reqMods ...func(*fmc.Req)) error {
+			return deleteRulesById(b.client, b.path, ids, reqMods...)
+		}
+	}
+	if len(ids) > 0 {
+		if err := deleteRules(ids, reqMods...); err != nil {
+			return state, diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to delete object, got error: %v", err))}
+		}
+	}
+
+	// done tracks plan rules that are configured on FMC, the remaining kept rules are still as in state
+	done := make([]bool, len(plan))
+	current := func() []T {
+		var items []T
+		for i, s := range kept {
+			if done[i] {
+				items = append(items, plan[i])
+			} else if s >= 0 {
+				items = append(items, state[s])
+			}
+		}
+		return items
+	}
+
+	// Update kept rules in place, only if they have changed
+	for i, s := range kept {
+		if s < 0 {
+			continue
+		}
+		b.setId(&plan[i], b.id(state[s]))
+		body := b.toBody(plan[i : i+1])
+		if body != b.toBody(state[s:s+1]) {
+			res, err := b.client.Put(b.path+"/"+url.QueryEscape(b.id(plan[i]).ValueString()), gjson.Get(body, "items.0").String(), reqMods...)
+			if err != nil {
+				return current(), diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))}
+			}
+		}
+		done[i] = true
+	}
+
+	// Insert new rules next to the kept ones
+	for i := 0; i < len(plan); {
+		if kept[i] >= 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(plan) && kept[j] < 0 {
+			j++
+		}
+
+		position, anchor := "", ""
+		if i > 0 {
+			position, anchor = "insertAfter", b.id(plan[i-1]).ValueString()
+		} else if j < len(plan) {
+			position, anchor = "insertBefore", b.id(plan[j]).ValueString()
+		}
+		var index int64
+		if anchor != "" {
+			res, err := b.client.Get(b.path+"/"+url.QueryEscape(anchor), reqMods...)
+			if err != nil {
+				return current(), diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))}
+			}
+			index = res.Get("metadata.ruleIndex").Int()
+		}
+
+		n, err := createBulkRules(b, plan[i:j], position, index, reqMods...)
+		for k := i; k < i+n; k++ {
+			done[k] = true
+		}
+		if err != nil {
+			return current(), diag.Diagnostics{diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %v", err))}
+		}
+		i = j
+	}
+
+	return plan, nil
+}
+
+// createBulkRules creates items in bulks and sets their IDs. If position is "insertBefore" or "insertAfter",
+// rules are placed relative to the rule with the given index, otherwise they are appended. It returns the number
+// of items created. The caller is expected to hold the create mutex of the resource.
+func createBulkRules[T any](b bulkRules[T], items []T, position string, index int64, reqMods ...func(*fmc.Req)) (int, error) {
+	for start := 0; start < len(items); start += bulkSizeCreate {
+		bulk := items[start:min(start+bulkSizeCreate, len(items))]
+
+		body := gjson.Get(b.toBody(bulk), "items").String()
+
+		urlParams := "?bulk=true" + b.urlParams
+		if position != "" {
+			urlParams += fmt.Sprintf("&%s=%d", position, index)
+		}
+
+		res, err := b.client.Post(b.path+urlParams, body, reqMods...)
+		if err != nil {
+			return start, fmt.Errorf("%v, %s", err, res.String())
+		}
+
+		// Read result and save it to items. Next bulk goes right after this one.
+		b.fromBodyUnknowns(bulk, res)
+		index += int64(len(bulk))
+	}
+	return len(items), nil
+}
+
+// fmcObjectForDevice reads the object at path. If deviceId is set and the object is overridable, the override
+// for the device is returned instead, if there is one.
+func fmcObjectForDevice(client *fmc.Client, path, deviceId string, reqMods ...func(*fmc.Req)) (gjson.Result, error) {
+	res, err := client.Get(path, reqMods...)
+	if err != nil {
+		return res, err
+	}
+	if deviceId == "" || !res.Get("overridable").Bool() {
+		return res, nil
+	}
+	overrides, err := client.Get(path+"/overrides?expanded=true", reqMods...)
+	if err != nil {
+		return res, fmt.Errorf("overrides: %s", err)
+	}
+	for _, v := range overrides.Get("items").Array() {
+		if strings.EqualFold(v.Get("overrides.target.id").String(), deviceId) {
+			return v, nil
+		}
+	}
+	return res, nil
+}
+
+// Models are shared by a resource and its data source, while some attributes (e.g. `timeouts`) exist in the resource
+// schema only. getDataSourceConfig and setDataSourceState read and write such models through the schema of the
+// resource r and convert the value to the data source schema, where resource-only attributes are null or dropped.
+
+// getDataSourceConfig reads the data source configuration into model, resource-only attributes are null.
+func getDataSourceConfig(ctx context.Context, config tfsdk.Config, r resource.Resource, model any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	s := fmcResourceSchema(ctx, r)
+	raw, err := fmcConvertValue(config.Raw, s.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Failed to convert data source configuration, got error: %s", err))
+		return diags
+	}
+	return tfsdk.Config{Schema: s, Raw: raw}.Get(ctx, model)
+}
+
+// setDataSourceState sets model as the data source state, resource-only attributes are dropped.
+func setDataSourceState(ctx context.Context, state *tfsdk.State, r resource.Resource, model any) diag.Diagnostics {
+	full := tfsdk.State{Schema: fmcResourceSchema(ctx, r)}
+	diags := full.Set(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+	raw, err := fmcConvertValue(full.Raw, state.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Failed to convert data source state, got error: %s", err))
+		return diags
+	}
+	state.Raw = raw
+	return diags
+}
+
+func fmcResourceSchema(ctx context.Context, r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// fmcConvertValue converts value to typ. Object attributes missing in value are added as null and the ones missing
+// in typ are dropped, at any level of nesting.
+func fmcConvertValue(value tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+	switch t := typ.(type) {
+	case tftypes.Object:
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return value, err
+		}
+		converted := make(map[string]tftypes.Value, len(t.AttributeTypes))
+		for name, attributeType := range t.AttributeTypes {
+			v, ok := attributes[name]
+			if !ok {
+				converted[name] = tftypes.NewValue(attributeType, nil)
+				continue
+			}
+			c, err := fmcConvertValue(v, attributeType)
+			if err != nil {
+				return value, fmt.Errorf("%s: %w", name, err)
+			}
+			converted[name] = c
+		}
+		return tftypes.NewValue(t, converted), nil
+	case tftypes.List:
+		return fmcConvertElements(value, t, t.ElementType)
+	case tftypes.Set:
+		return fmcConvertElements(value, t, t.ElementType)
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return value, err
+		}
+		for k := range elements {
+			c, err := fmcConvertValue(elements[k], t.ElementType)
+			if err != nil {
+				return value, err
+			}
+			elements[k] = c
+		}
+		return tftypes.NewValue(t, elements), nil
+	}
+	return value, nil
+}
+
+// fmcConvertElements converts the elements of the list or set value to elementType.
+func fmcConvertElements(value tftypes.Value, typ, elementType tftypes.Type) (tftypes.Value, error) {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return value, err
+	}
+	for i := range elements {
+		c, err := fmcConvertValue(elements[i], elementType)
+		if err != nil {
+			return value, err
+		}
+		elements[i] = c
+	}
+	return tftypes.NewValue(typ, elements), nil
+}
@@ -0,0 +1,257 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/netascode/go-fmc"
+	"github.com/tidwall/gjson"
+)
+
+func TestUnitFMCModifyPlanDomain(t *testing.T) {
+	ctx := context.Background()
+	client := &fmc.Client{
+		DomainUUID: "global-uuid",
+		Domains:    map[string]string{"Global": "global-uuid", "Global/Leaf": "leaf-uuid"},
+	}
+
+	hostSchema := testUnitResourceSchema(ctx, NewHostResource())
+
+	host := func(domain types.String) Host {
+		return Host{Id: types.StringValue("123"), Name: types.StringValue("host1"), Ip: types.StringValue("10.0.0.1"), Domain: domain}
+	}
+	null := types.StringNull()
+	leaf := types.StringValue("Global/Leaf")
+	global := types.StringValue("Global")
+
+	cases := []struct {
+		name            string
+		state           *Host
+		config          types.String
+		defaultDomain   string
+		expectedDomain  types.String
+		expectedReplace bool
+	}{
+		{"create with provider default", nil, null, "Global/Leaf", leaf, false},
+		{"create without any domain", nil, null, "", null, false},
+		{"create with resource domain", nil, global, "Global/Leaf", global, false},
+		{"domain moved to provider", &Host{Domain: leaf}, null, "Global/Leaf", leaf, false},
+		{"provider default is the login domain", &Host{Domain: null}, null, "Global", null, false},
+		{"explicit login domain", &Host{Domain: null}, global, "", global, false},
+		{"provider default changed", &Host{Domain: null}, null, "Global/Leaf", leaf, true},
+		{"resource domain removed", &Host{Domain: leaf}, null, "", null, true},
+		{"resource domain overrides provider default", &Host{Domain: leaf}, leaf, "Global", leaf, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := tfsdk.State{Schema: hostSchema}
+			if diags := config.Set(ctx, host(c.config)); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			state := tfsdk.State{Schema: hostSchema}
+			if c.state != nil {
+				if diags := state.Set(ctx, host(c.state.Domain)); diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+			} else {
+				state.RemoveResource(ctx)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: hostSchema, Raw: config.Raw},
+				State:  state,
+				Plan:   tfsdk.Plan{Schema: hostSchema, Raw: config.Raw},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			FMCModifyPlanDomain(ctx, client, c.defaultDomain, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var planned Host
+			resp.Plan.Get(ctx, &planned)
+			if !planned.Domain.Equal(c.expectedDomain) {
+				t.Errorf("expected planned domain %s, got %s", c.expectedDomain, planned.Domain)
+			}
+			if replace := len(resp.RequiresReplace) > 0; replace != c.expectedReplace {
+				t.Errorf("expected replace %t, got %t", c.expectedReplace, replace)
+			}
+		})
+	}
+}
+
+func TestUnitFMCModifyPlanDomainFullName(t *testing.T) {
+	ctx := context.Background()
+	client := &fmc.Client{
+		DomainUUID: "global-uuid",
+		Domains:    map[string]string{"Global": "global-uuid", "Global/tenant1": "tenant1-uuid"},
+	}
+
+	domainSchema := testUnitResourceSchema(ctx, NewDomainResource())
+	hostSchema := testUnitResourceSchema(ctx, NewHostResource())
+	tenant := types.StringValue("Global/tenant1")
+
+	domainState := Domain{
+		Id:          types.StringValue("tenant1-uuid"),
+		Domain:      types.StringNull(),
+		Name:        types.StringValue("tenant1"),
+		Type:        types.StringValue("Domain"),
+		Description: types.StringValue("old"),
+		FullName:    tenant,
+	}
+
+	// planFullName runs the plan modifiers of `full_name`, which Terraform marks unknown on any in-place update
+	planFullName := func(plan Domain) types.String {
+		plan.FullName = types.StringUnknown()
+		state := tfsdk.State{Schema: domainSchema}
+		state.Set(ctx, domainState)
+		planned := tfsdk.State{Schema: domainSchema}
+		planned.Set(ctx, plan)
+
+		req := planmodifier.StringRequest{
+			Path:        path.Root("full_name"),
+			State:       state,
+			Plan:        tfsdk.Plan(planned),
+			StateValue:  domainState.FullName,
+			PlanValue:   plan.FullName,
+			ConfigValue: types.StringNull(),
+		}
+		resp := planmodifier.StringResponse{PlanValue: req.PlanValue}
+		for _, m := range domainSchema.Attributes["full_name"].(schema.StringAttribute).PlanModifiers {
+			m.PlanModifyString(ctx, req, &resp)
+		}
+		return resp.PlanValue
+	}
+
+	// replaceHost plans a host in the domain with `domain = fmc_domain.tenant1.full_name`
+	replaceHost := func(domain types.String) bool {
+		host := Host{Id: types.StringValue("123"), Name: types.StringValue("host1"), Ip: types.StringValue("10.0.0.1"), Domain: domain}
+		config := tfsdk.State{Schema: hostSchema}
+		config.Set(ctx, host)
+		host.Domain = tenant
+		state := tfsdk.State{Schema: hostSchema}
+		state.Set(ctx, host)
+
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: hostSchema, Raw: config.Raw},
+			State:  state,
+			Plan:   tfsdk.Plan{Schema: hostSchema, Raw: config.Raw},
+		}
+		resp := resource.ModifyPlanResponse{Plan: req.Plan}
+		FMCModifyPlanDomain(ctx, client, "", req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		return len(resp.RequiresReplace) > 0
+	}
+
+	plan := domainState
+	plan.Description = types.StringValue("new")
+	if fullName := planFullName(plan); !fullName.Equal(tenant) {
+		t.Errorf("expected full name to be kept on description change, got %s", fullName)
+	} else if replaceHost(fullName) {
+		t.Error("expected objects in the domain not to be replaced on description change")
+	}
+
+	plan = domainState
+	plan.Name = types.StringValue("tenant2")
+	if fullName := planFullName(plan); !fullName.IsUnknown() {
+		t.Errorf("expected full name to be unknown on rename, got %s", fullName)
+	} else if !replaceHost(fullName) {
+		t.Error("expected objects in the domain to be replaced on rename")
+	}
+}
+
+func TestUnitFMCUpdateDeviceGroupConcurrent(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+	groupA := m.AddObject("/devicegroups/devicegrouprecords", `{"name":"group_a"}`)
+	groupB := m.AddObject("/devicegroups/devicegrouprecords", `{"name":"group_b","members":[{"id":"device0"}]}`)
+
+	deviceSchema := testUnitResourceSchema(ctx, NewDeviceResource())
+
+	// Move devices from group B to group A and add new devices to group A, all at the same time
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			plan := tfsdk.Plan{Schema: deviceSchema, Raw: tftypes.NewValue(deviceSchema.Type().TerraformType(ctx), nil)}
+			plan.SetAttribute(ctx, path.Root("device_group_id"), groupA)
+			state := tfsdk.State{Schema: deviceSchema, Raw: plan.Raw.Copy()}
+			if i == 0 {
+				state.SetAttribute(ctx, path.Root("device_group_id"), groupB)
+			} else {
+				state.SetAttribute(ctx, path.Root("device_group_id"), types.StringNull())
+			}
+			if diags := FMCupdateDeviceGroup(ctx, client, types.StringValue(fmt.Sprintf("device%d", i)), plan, state, nil); diags.HasError() {
+				t.Errorf("failed to update device group: %v", diags)
+			}
+		}()
+	}
+	wg.Wait()
+
+	members := gjson.Get(m.Object("/devicegroups/devicegrouprecords/"+groupA), "members.#.id").Array()
+	if len(members) != 10 {
+		t.Errorf("expected 10 members in group A, got %v", members)
+	}
+	if members := gjson.Get(m.Object("/devicegroups/devicegrouprecords/"+groupB), "members").Array(); len(members) != 0 {
+		t.Errorf("expected group B to be empty, got %v", members)
+	}
+}
+
+func TestUnitFMCObjectsInUseDiagnosticsTruncated(t *testing.T) {
+	ctx := context.Background()
+	m := newMockFMC(t)
+	client := newMockFMCClient(t, m)
+
+	var objects []fmcObjectUsageQuery
+	var members []string
+	for i := range fmcObjectUsageMaxLookups + 2 {
+		name := fmt.Sprintf("host%d", i)
+		id := m.AddObject("/object/hosts", `{"name":"`+name+`","value":"10.1.1.1","type":"Host"}`)
+		objects = append(objects, fmcObjectUsageQuery{Name: name, Id: id, Type: "Host"})
+		members = append(members, `{"id":"`+id+`","type":"Host"}`)
+	}
+	m.AddObject("/object/networkgroups", `{"name":"group","type":"NetworkGroup","objects":[`+strings.Join(members, ",")+`]}`)
+
+	diags := FMCObjectsInUseDiagnostics(ctx, client, objects, nil)
+	if len(diags) != 1 {
+		t.Fatalf("expected one diagnostic, got %v", diags)
+	}
+	detail := diags[0].Detail()
+	if strings.Count(detail, `NetworkGroup "group"`) != fmcObjectUsageMaxLookups {
+		t.Errorf("expected references of %d objects, got: %s", fmcObjectUsageMaxLookups, detail)
+	}
+	if !strings.Contains(detail, "References of 2 more objects, that failed to be deleted, were not looked up.") {
+		t.Errorf("expected truncation to be reported, got: %s", detail)
+	}
+}